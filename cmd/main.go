@@ -12,22 +12,32 @@ import (
 	"time"
 
 	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	_ "go.uber.org/automaxprocs"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	adminnetworkpolicycontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/adminnetworkpolicy"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/drift"
+	gatewaycontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/gateway"
+	ingresscontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/ingress"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/inventory"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/ipaddressallocation"
 	namespacecontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/namespace"
@@ -78,12 +88,18 @@ var (
 	restoreMode          = false
 )
 
+// driftEventsBufferSize is the number of the drifted CRs which can wait to be requeued in each controller.
+const driftEventsBufferSize = 1024
+
 func init() {
 	var err error
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(crdv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	utilruntime.Must(vmv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(policyv1alpha1.AddToScheme(scheme))
 	config.AddFlags()
 
 	cf, err = config.NewNSXOperatorConfigFromFile()
@@ -240,18 +256,28 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 
 		// Create controllers which only supports VPC
 		subnetSetReconcile = subnetset.NewSubnetSetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService)
+		subnetReconcile := subnet.NewSubnetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService)
+		ipAddressAllocationReconcile := ipaddressallocation.NewIPAddressAllocationReconciler(mgr, ipAddressAllocationService, vpcService)
+		subnetPortReconcile := subnetport.NewSubnetPortReconciler(mgr, subnetPortService, subnetService, vpcService, ipAddressAllocationService, nodeService)
+		podReconcile := pod.NewPodReconciler(mgr, subnetPortService, subnetService, vpcService, nodeService)
+		if cf.K8sConfig.DriftAuditInterval > 0 {
+			startDriftAuditor(mgr, subnetService, subnetPortService, ipAddressAllocationService, subnetReconcile, subnetSetReconcile, subnetPortReconcile, podReconcile, ipAddressAllocationReconcile)
+		}
+		if cf.K8sConfig.OrphanGCInterval > 0 {
+			startOrphanCollector(mgr, subnetService, subnetPortService, ipAddressAllocationService, staticRouteService, subnetBindingService, subnetIPReservationService)
+		}
 		reconcilerList = append(
 			reconcilerList,
 			networkinfocontroller.NewNetworkInfoReconciler(mgr, vpcService, ipblocksInfoService, dnsRecordService),
 			namespacecontroller.NewNamespaceReconciler(mgr, cf, vpcService, subnetService, subnetPortService),
-			subnet.NewSubnetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService),
+			subnetReconcile,
 			subnetSetReconcile,
 			node.NewNodeReconciler(mgr, nodeService),
 			staticroutecontroller.NewStaticRouteReconciler(mgr, staticRouteService),
 			// SubnetPort may use IPAddressAllocation for AddressBinding, reconcile IPAddressAllocation first
-			ipaddressallocation.NewIPAddressAllocationReconciler(mgr, ipAddressAllocationService, vpcService),
-			subnetport.NewSubnetPortReconciler(mgr, subnetPortService, subnetService, vpcService, ipAddressAllocationService),
-			pod.NewPodReconciler(mgr, subnetPortService, subnetService, vpcService, nodeService),
+			ipAddressAllocationReconcile,
+			subnetPortReconcile,
+			podReconcile,
 			networkpolicycontroller.NewNetworkPolicyReconciler(mgr, commonService, vpcService),
 			subnetbindingcontroller.NewReconciler(mgr, subnetService, subnetBindingService),
 			subnetipreservationcontroller.NewReconciler(mgr, subnetIPReservationService, subnetService),
@@ -259,6 +285,11 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 		if lbReconciler := service.NewServiceLbReconciler(mgr, commonService, dnsRecordService); lbReconciler != nil {
 			reconcilerList = append(reconcilerList, lbReconciler)
 		}
+		reconcilerList = append(reconcilerList, adminnetworkpolicycontroller.NewAdminNetworkPolicyReconcilers(mgr, commonService, vpcService)...)
+		reconcilerList = append(reconcilerList, gatewaycontroller.NewGatewayReconcilers(mgr, commonService.NSXConfig, dnsRecordService)...)
+		if ingressReconciler := ingresscontroller.NewIngressReconciler(mgr, commonService.NSXConfig, dnsRecordService); ingressReconciler != nil {
+			reconcilerList = append(reconcilerList, ingressReconciler)
+		}
 		// StatefulSet controller is always registered so that after NSX upgrades (e.g. to 9.2.0+)
 		// replica/GC logic can run without restarting the operator. Reconcile and CollectGarbage
 		// no-op until NSX version supports STS pods and vpc_wcp_enhance=true in config; delete cleanup still runs.
@@ -324,6 +355,208 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 	}()
 }
 
+// startDriftAuditor adds the auditor of the Subnets, SubnetPorts and IPAddressAllocations to the manager, the
+// reconcilers watch the drifted CRs to repair them if drift_audit_requeue is true.
+func startDriftAuditor(mgr manager.Manager, subnetService *subnetservice.SubnetService, subnetPortService *subnetportservice.SubnetPortService,
+	ipAddressAllocationService *ipaddressallocationservice.IPAddressAllocationService, subnetReconcile *subnet.SubnetReconciler,
+	subnetSetReconcile *subnetset.SubnetSetReconciler, subnetPortReconcile *subnetport.SubnetPortReconciler, podReconcile *pod.PodReconciler,
+	ipAddressAllocationReconcile *ipaddressallocation.IPAddressAllocationReconciler) {
+	if cf.K8sConfig.DriftAuditRequeue {
+		subnetReconcile.DriftEvents = make(chan event.GenericEvent, driftEventsBufferSize)
+		subnetSetReconcile.DriftEvents = make(chan event.GenericEvent, driftEventsBufferSize)
+		subnetPortReconcile.DriftEvents = make(chan event.GenericEvent, driftEventsBufferSize)
+		podReconcile.DriftEvents = make(chan event.GenericEvent, driftEventsBufferSize)
+		ipAddressAllocationReconcile.DriftEvents = make(chan event.GenericEvent, driftEventsBufferSize)
+	}
+	auditor := &drift.Auditor{
+		Recorder: mgr.GetEventRecorderFor("nsx-drift-auditor"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+		Interval: time.Duration(cf.K8sConfig.DriftAuditInterval) * time.Second,
+		Requeue:  cf.K8sConfig.DriftAuditRequeue,
+		Targets: []drift.Target{
+			{
+				ResourceType: subnetservice.ResourceTypeSubnet,
+				Detect: func() ([]common.Drift, error) {
+					return subnetService.DetectDrift(subnetService.DriftTarget())
+				},
+				Refresh: subnetService.DriftTarget().Refresh,
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeSubnetCRName, UIDScope: common.TagScopeSubnetCRUID, New: func() client.Object { return &crdv1alpha1.Subnet{} }, Events: subnetReconcile.DriftEvents},
+					{NameScope: common.TagScopeSubnetSetCRName, UIDScope: common.TagScopeSubnetSetCRUID, New: func() client.Object { return &crdv1alpha1.SubnetSet{} }, Events: subnetSetReconcile.DriftEvents},
+				},
+			},
+			{
+				ResourceType: subnetportservice.ResourceTypeSubnetPort,
+				Detect: func() ([]common.Drift, error) {
+					return subnetPortService.DetectDrift(subnetPortService.DriftTarget())
+				},
+				Refresh: subnetPortService.DriftTarget().Refresh,
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeSubnetPortCRName, UIDScope: common.TagScopeSubnetPortCRUID, New: func() client.Object { return &crdv1alpha1.SubnetPort{} }, Events: subnetPortReconcile.DriftEvents},
+					{NameScope: common.TagScopePodName, UIDScope: common.TagScopePodUID, New: func() client.Object { return &corev1.Pod{} }, Events: podReconcile.DriftEvents},
+				},
+			},
+			{
+				ResourceType: ipaddressallocationservice.ResourceTypeIPAddressAllocation,
+				Detect: func() ([]common.Drift, error) {
+					return ipAddressAllocationService.DetectDrift(ipAddressAllocationService.DriftTarget())
+				},
+				Refresh: ipAddressAllocationService.DriftTarget().Refresh,
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeIPAddressAllocationCRName, UIDScope: common.TagScopeIPAddressAllocationCRUID, New: func() client.Object { return &crdv1alpha1.IPAddressAllocation{} }, Events: ipAddressAllocationReconcile.DriftEvents},
+				},
+			},
+		},
+	}
+	if err := mgr.Add(auditor); err != nil {
+		log.Error(err, "Failed to add the NSX resource drift auditor")
+		os.Exit(1)
+	}
+}
+
+// inspectPathAndTags adapts the Inspect of the drift target to the orphan target.
+func inspectPathAndTags(target common.DriftTarget) func(obj interface{}) (string, []model.Tag) {
+	return func(obj interface{}) (string, []model.Tag) {
+		path, tags, _ := target.Inspect(obj)
+		return path, tags
+	}
+}
+
+// startOrphanCollector adds the collector of the orphan NSX resources to the manager, the orphans are deleted only if
+// orphan_gc_delete is true. Only the NSX resources owned by a single CR identified by its name and UID tags are
+// collected, the other types are excluded:
+//   - SecurityPolicies, Rules, Groups, Shares and PolicyContextProfiles are created for SecurityPolicies,
+//     NetworkPolicies and AdminNetworkPolicies, the Groups and Shares may be referenced across the policies. Deleting
+//     one of them alone breaks the policy, they are deleted as a whole by the garbage collectors of the policy
+//     controllers.
+//   - DNS records may be contributed by several Gateways, Routes, Ingresses and Services, deleting the record of a
+//     removed owner promotes another contributor, which is done by the DNS garbage collectors of the owner controllers.
+//   - VPCs and the VPC-level resources, e.g. the LB Services and the VPC attachments, are owned by the Namespaces or
+//     shared by several Namespaces, they are deleted with the Namespace by the NetworkInfo and Namespace controllers.
+func startOrphanCollector(mgr manager.Manager, subnetService *subnetservice.SubnetService, subnetPortService *subnetportservice.SubnetPortService,
+	ipAddressAllocationService *ipaddressallocationservice.IPAddressAllocationService, staticRouteService *staticroute.StaticRouteService,
+	subnetBindingService *subnetbindingservice.BindingService, subnetIPReservationService *subnetipreservationservice.IPReservationService) {
+	subnetIPReservationOwners := []drift.Owner{
+		{NameScope: common.TagScopeSubnetIPReservationCRName, UIDScope: common.TagScopeSubnetIPReservationCRUID, New: func() client.Object { return &crdv1alpha1.SubnetIPReservation{} }},
+	}
+	subnetTarget := subnetService.DriftTarget()
+	subnetPortTarget := subnetPortService.DriftTarget()
+	ipAddressAllocationTarget := ipAddressAllocationService.DriftTarget()
+	collector := &drift.OrphanCollector{
+		Client:      mgr.GetAPIReader(),
+		Interval:    time.Duration(cf.K8sConfig.OrphanGCInterval) * time.Second,
+		Delete:      cf.K8sConfig.OrphanGCDelete,
+		GracePeriod: time.Duration(cf.K8sConfig.OrphanGCGracePeriod) * time.Second,
+		Targets: []drift.OrphanTarget{
+			{
+				ResourceType: subnetTarget.ResourceType,
+				Store:        subnetTarget.Store,
+				Inspect:      inspectPathAndTags(subnetTarget),
+				Delete: func(obj interface{}) error {
+					return subnetService.DeleteSubnet(*obj.(*model.VpcSubnet))
+				},
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeSubnetCRName, UIDScope: common.TagScopeSubnetCRUID, New: func() client.Object { return &crdv1alpha1.Subnet{} }},
+					{NameScope: common.TagScopeSubnetSetCRName, UIDScope: common.TagScopeSubnetSetCRUID, New: func() client.Object { return &crdv1alpha1.SubnetSet{} }},
+				},
+			},
+			{
+				ResourceType: subnetPortTarget.ResourceType,
+				Store:        subnetPortTarget.Store,
+				// The released sticky SubnetPorts are deleted by the Pod controller after the sticky grace period.
+				Inspect: subnetportservice.InspectOrphanSubnetPort,
+				Delete: func(obj interface{}) error {
+					return subnetPortService.DeleteSubnetPort(obj.(*model.VpcSubnetPort))
+				},
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeSubnetPortCRName, UIDScope: common.TagScopeSubnetPortCRUID, New: func() client.Object { return &crdv1alpha1.SubnetPort{} }},
+					// The SubnetPorts of the StatefulSet Pods are kept when the Pods are recreated.
+					{NameScope: common.TagScopeStatefulSetName, UIDScope: common.TagScopeStatefulSetUID, New: func() client.Object { return &appsv1.StatefulSet{} }},
+					{NameScope: common.TagScopePodName, UIDScope: common.TagScopePodUID, New: func() client.Object { return &corev1.Pod{} }},
+				},
+			},
+			{
+				ResourceType: ipAddressAllocationTarget.ResourceType,
+				Store:        ipAddressAllocationTarget.Store,
+				Inspect:      inspectPathAndTags(ipAddressAllocationTarget),
+				Delete: func(obj interface{}) error {
+					return ipAddressAllocationService.DeleteIPAddressAllocationByNSXResource(obj.(*model.VpcIpAddressAllocation))
+				},
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeIPAddressAllocationCRName, UIDScope: common.TagScopeIPAddressAllocationCRUID, New: func() client.Object { return &crdv1alpha1.IPAddressAllocation{} }},
+				},
+			},
+			{
+				ResourceType: common.ResourceTypeStaticRoutes,
+				Store:        &staticRouteService.StaticRouteStore.ResourceStore,
+				Inspect: func(obj interface{}) (string, []model.Tag) {
+					route := obj.(*model.StaticRoutes)
+					if route.Path == nil {
+						return "", nil
+					}
+					return *route.Path, route.Tags
+				},
+				Delete: func(obj interface{}) error {
+					return staticRouteService.DeleteStaticRoute(obj.(*model.StaticRoutes))
+				},
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeStaticRouteCRName, UIDScope: common.TagScopeStaticRouteCRUID, New: func() client.Object { return &crdv1alpha1.StaticRoute{} }},
+				},
+			},
+			{
+				ResourceType: common.ResourceTypeSubnetConnectionBindingMap,
+				Store:        &subnetBindingService.BindingStore.ResourceStore,
+				Inspect: func(obj interface{}) (string, []model.Tag) {
+					bindingMap := obj.(*model.SubnetConnectionBindingMap)
+					if bindingMap.Path == nil {
+						return "", nil
+					}
+					return *bindingMap.Path, bindingMap.Tags
+				},
+				Delete: func(obj interface{}) error {
+					return subnetBindingService.DeleteSubnetConnectionBindingMap(obj.(*model.SubnetConnectionBindingMap))
+				},
+				Owners: []drift.Owner{
+					{NameScope: common.TagScopeSubnetBindingCRName, UIDScope: common.TagScopeSubnetBindingCRUID, New: func() client.Object { return &crdv1alpha1.SubnetConnectionBindingMap{} }},
+				},
+			},
+			{
+				ResourceType: subnetipreservationservice.ResourceTypeDynamicSubnetIPReservation,
+				Store:        &subnetIPReservationService.DynamicIPReservationStore.ResourceStore,
+				Inspect: func(obj interface{}) (string, []model.Tag) {
+					ipReservation := obj.(*model.DynamicIpAddressReservation)
+					if ipReservation.Path == nil {
+						return "", nil
+					}
+					return *ipReservation.Path, ipReservation.Tags
+				},
+				Delete: func(obj interface{}) error {
+					return subnetIPReservationService.DeleteDynamicIPReservation(obj.(*model.DynamicIpAddressReservation))
+				},
+				Owners: subnetIPReservationOwners,
+			},
+			{
+				ResourceType: subnetipreservationservice.ResourceTypeStaticSubnetIPReservation,
+				Store:        &subnetIPReservationService.StaticIPReservationStore.ResourceStore,
+				Inspect: func(obj interface{}) (string, []model.Tag) {
+					ipReservation := obj.(*model.StaticIpAddressReservation)
+					if ipReservation.Path == nil {
+						return "", nil
+					}
+					return *ipReservation.Path, ipReservation.Tags
+				},
+				Delete: func(obj interface{}) error {
+					return subnetIPReservationService.DeleteStaticIPReservation(obj.(*model.StaticIpAddressReservation))
+				},
+				Owners: subnetIPReservationOwners,
+			},
+		},
+	}
+	if err := mgr.Add(collector); err != nil {
+		log.Error(err, "Failed to add the orphan NSX resource collector")
+		os.Exit(1)
+	}
+}
+
 func electMaster(mgr manager.Manager, nsxClient *nsx.Client) {
 	log.Info("I'm trying to be elected as master")
 	<-mgr.Elected()
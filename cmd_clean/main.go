@@ -5,8 +5,11 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -28,6 +31,18 @@ import (
 // envoy thumbprint mode:
 //
 //	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -envoyhost=localhost -envoyport=1080 -log-level=1 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868
+//
+// dry-run mode, print the NSX resources which would be deleted without deleting them:
+//
+//	./clean -dry-run -output=json -output-file=./plan.json ...
+//
+// scoped mode, only clean up the resources in the given namespaces, VPCs or of the given kinds:
+//
+//	./clean -namespaces=ns-1,ns-2 -vpc-paths=/orgs/default/projects/p1/vpcs/vpc-1 -resource-kinds=subnetports,staticroutes ...
+//
+// resumable mode, persist the progress to the journal file and resume at the first unfinished step on rerun:
+//
+//	./clean -journal-file=./clean-journal.json ...
 var (
 	log         logger.CustomLogger
 	cf          *config.NSXOperatorConfig
@@ -44,6 +59,13 @@ var (
 	cluster     string
 	envoyHost   string
 	envoyPort   int
+	dryRun      bool
+	output      string
+	outputFile  string
+	namespaces  string
+	vpcPaths    string
+	kinds       string
+	journalFile string
 )
 
 func main() {
@@ -61,8 +83,20 @@ func main() {
 	flag.StringVar(&envoyHost, "envoyhost", "", "envoy host")
 	flag.IntVar(&envoyPort, "envoyport", 0, "envoy port")
 	flag.IntVar(&config.LogLevel, "log-level", 2, "Use zap-core log system.")
+	flag.BoolVar(&dryRun, "dry-run", false, "print the nsx resources to be deleted without deleting them")
+	flag.StringVar(&output, "output", "text", "output format of dry-run mode, text or json")
+	flag.StringVar(&outputFile, "output-file", "", "file to write the dry-run result, the logs are also printed to stdout if not set")
+	flag.StringVar(&namespaces, "namespaces", "", "comma separated namespaces to clean up, all namespaces if not set")
+	flag.StringVar(&vpcPaths, "vpc-paths", "", "comma separated VPC paths to clean up, all VPCs if not set")
+	flag.StringVar(&kinds, "resource-kinds", "", "comma separated resource kinds to clean up, all kinds if not set")
+	flag.StringVar(&journalFile, "journal-file", "", "file to persist the cleanup progress, a rerun resumes from it if set")
 	flag.Parse()
 
+	if output != "text" && output != "json" {
+		fmt.Fprintf(os.Stderr, "invalid output format %q, it should be text or json\n", output)
+		os.Exit(1)
+	}
+
 	cf = config.NewNSXOpertorConfig()
 	cf.NsxApiManagers = []string{mgrIp}
 	cf.VCUser = vcUser
@@ -83,10 +117,60 @@ func main() {
 	log = logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
 	logger.Log = log
 	logf.SetLogger(log.Logger)
-	err := clean.Clean(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel)
+	if dryRun {
+		if err := printPlan(ctx); err != nil {
+			log.Error(err, "Failed to plan nsx resources cleanup")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	err := clean.CleanWithOptions(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel, clean.Options{Scope: buildScope(), JournalPath: journalFile})
 	if err != nil {
 		log.Error(err, "Failed to clean nsx resources")
 		os.Exit(1)
 	}
 	os.Exit(0)
 }
+
+func printPlan(ctx context.Context) error {
+	plan, err := clean.Plan(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel, buildScope())
+	if err != nil {
+		return err
+	}
+	w := os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if output == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(plan)
+	}
+	return plan.WriteText(w)
+}
+
+func buildScope() *clean.Scope {
+	if namespaces == "" && vpcPaths == "" && kinds == "" {
+		return nil
+	}
+	return &clean.Scope{
+		Namespaces:    splitList(namespaces),
+		VPCPaths:      splitList(vpcPaths),
+		ResourceKinds: splitList(kinds),
+	}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
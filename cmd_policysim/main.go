@@ -0,0 +1,133 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	"github.com/vmware-tanzu/nsx-operator/pkg/policysim"
+	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// scheme holds the types which the endpoints are resolved from: Pods, and SubnetPorts for VMs.
+var scheme = runtime.NewScheme()
+
+var (
+	src      string
+	dst      string
+	protocol string
+	port     int
+	output   string
+	timeout  time.Duration
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(vpcv1alpha1.AddToScheme(scheme))
+
+	flag.StringVar(&src, "src", "", "source endpoint: pod:<namespace>/<name>, vm:<namespace>/<name> or an IP address")
+	flag.StringVar(&dst, "dst", "", "destination endpoint: pod:<namespace>/<name>, vm:<namespace>/<name> or an IP address")
+	flag.StringVar(&protocol, "protocol", "TCP", "L4 protocol of the traffic: TCP, UDP or SCTP")
+	flag.IntVar(&port, "port", 0, "destination port of the traffic")
+	flag.StringVar(&output, "o", "text", "output format: text or json")
+	flag.DurationVar(&timeout, "timeout", 2*time.Minute, "timeout of the simulation")
+}
+
+func main() {
+	// Flags of the simulation are registered in init, config.AddFlags registers the
+	// remaining flags (including -nsxconfig and -log-level) and calls flag.Parse().
+	config.AddFlags()
+
+	if src == "" || dst == "" {
+		fmt.Fprintln(os.Stderr, "Both -src and -dst are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+	if output != "text" && output != "json" {
+		fmt.Fprintf(os.Stderr, "Unsupported output format %q\n", output)
+		os.Exit(2)
+	}
+
+	cf, err := config.NewNSXOperatorConfigFromFile()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read config: %v\n", err)
+		os.Exit(1)
+	}
+
+	log := logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
+	logger.Log = log
+	logf.SetLogger(log.Logger)
+
+	nsxClient := nsx.GetClient(cf)
+	if nsxClient == nil {
+		log.Error(nil, "Failed to get NSX client")
+		os.Exit(1)
+	}
+	cfg, err := pkgutil.GetConfig()
+	if err != nil {
+		log.Error(err, "Failed to get k8s REST config")
+		os.Exit(1)
+	}
+	client, err := k8sclient.New(cfg, k8sclient.Options{Scheme: scheme})
+	if err != nil {
+		log.Error(err, "Failed to create k8s client")
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result, err := policysim.Run(ctx, nsxClient, client, policysim.Request{
+		Source:      src,
+		Destination: dst,
+		Protocol:    protocol,
+		Port:        port,
+	})
+	if err != nil {
+		log.Error(err, "Failed to simulate traffic", "src", src, "dst", dst)
+		os.Exit(1)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			log.Error(err, "Failed to encode the simulation result")
+			os.Exit(1)
+		}
+		return
+	}
+	fmt.Printf("Traffic %s (%s) -> %s (%s) %s/%d: %s\n", src, result.SourceIP, dst, result.DestinationIP, protocol, port, result.Verdict)
+	printMatch("Egress", result.Egress)
+	printMatch("Ingress", result.Ingress)
+}
+
+func printMatch(direction string, match *securitypolicy.RuleMatch) {
+	if match == nil {
+		fmt.Printf("  %s: no rule matched, the default rule applies\n", direction)
+		return
+	}
+	fmt.Printf("  %s: %s by rule %s\n", direction, match.Action, match.RulePath)
+	if match.Indeterminate != "" {
+		fmt.Printf("    indeterminate as %s\n", match.Indeterminate)
+	}
+	if match.Owner != "" {
+		fmt.Printf("    created for %s\n", match.Owner)
+	}
+}
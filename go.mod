@@ -54,8 +54,10 @@ require (
 
 require (
 	github.com/gofrs/uuid v4.4.0+incompatible
+	github.com/prometheus/client_model v0.6.2
 	go.uber.org/mock v0.6.0
 	sigs.k8s.io/gateway-api v1.5.1
+	sigs.k8s.io/network-policy-api v0.1.7
 )
 
 require (
@@ -114,7 +116,6 @@ require (
 	github.com/mxk/go-flowrate v0.0.0-20140419014527-cca7078d478f // indirect
 	github.com/onsi/ginkgo/v2 v2.28.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
-	github.com/prometheus/client_model v0.6.2 // indirect
 	github.com/prometheus/common v0.67.5 // indirect
 	github.com/prometheus/procfs v0.20.1 // indirect
 	github.com/spf13/cobra v1.10.0 // indirect
@@ -0,0 +1,114 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EffectiveSection is an NSX SecurityPolicy generated for the policy.
+type EffectiveSection struct {
+	// NSX SecurityPolicy ID.
+	Name string `json:"name"`
+	// NSX SecurityPolicy path.
+	Path string `json:"path,omitempty"`
+	// Sequence number of the NSX SecurityPolicy.
+	SequenceNumber int64 `json:"sequenceNumber,omitempty"`
+	// Paths of the groups the NSX SecurityPolicy is applied to.
+	// +listType=atomic
+	AppliedTo []string `json:"appliedTo,omitempty"`
+	// Realized state of the NSX SecurityPolicy, e.g. REALIZED, IN_PROGRESS or ERROR.
+	RealizedState string `json:"realizedState,omitempty"`
+}
+
+// EffectiveRule is an NSX rule generated for the policy.
+type EffectiveRule struct {
+	// NSX rule ID.
+	Name string `json:"name"`
+	// NSX rule display name.
+	DisplayName string `json:"displayName,omitempty"`
+	// NSX rule path.
+	Path string `json:"path,omitempty"`
+	// Action of the rule, e.g. ALLOW, DROP or REJECT.
+	Action string `json:"action,omitempty"`
+	// Direction of the rule, IN or OUT.
+	Direction string `json:"direction,omitempty"`
+	// Sequence number of the rule.
+	SequenceNumber int64 `json:"sequenceNumber,omitempty"`
+	// Paths of the source groups.
+	// +listType=atomic
+	SourceGroups []string `json:"sourceGroups,omitempty"`
+	// Paths of the destination groups.
+	// +listType=atomic
+	DestinationGroups []string `json:"destinationGroups,omitempty"`
+	// Paths of the groups the rule is applied to.
+	// +listType=atomic
+	AppliedTo []string `json:"appliedTo,omitempty"`
+	// Service entries of the rule in JSON.
+	// +listType=atomic
+	ServiceEntries []string `json:"serviceEntries,omitempty"`
+}
+
+// EffectiveGroup is an NSX group generated for the policy.
+type EffectiveGroup struct {
+	// NSX group ID.
+	Name string `json:"name"`
+	// NSX group path.
+	Path string `json:"path,omitempty"`
+	// Membership expressions of the group in JSON.
+	// +listType=atomic
+	Expressions []string `json:"expressions,omitempty"`
+	// IP addresses of the effective members of the group.
+	// +listType=atomic
+	MemberIPs []string `json:"memberIPs,omitempty"`
+}
+
+// EffectiveShare is an NSX share generated for the policy.
+type EffectiveShare struct {
+	// NSX share ID.
+	Name string `json:"name"`
+	// NSX share path.
+	Path string `json:"path,omitempty"`
+	// Paths of the projects or VPCs the resources are shared with.
+	// +listType=atomic
+	SharedWith []string `json:"sharedWith,omitempty"`
+}
+
+// +genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// EffectiveSecurityPolicy previews the NSX resources generated for a SecurityPolicy or NetworkPolicy.
+// The EffectiveSecurityPolicy name should be the same as the SecurityPolicy or NetworkPolicy name.
+type EffectiveSecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Kind of the policy, SecurityPolicy or NetworkPolicy.
+	PolicyType string `json:"policyType"`
+	// NSX SecurityPolicies generated for the policy.
+	// +listType=atomic
+	Sections []EffectiveSection `json:"sections,omitempty"`
+	// NSX rules generated for the policy.
+	// +listType=atomic
+	Rules []EffectiveRule `json:"rules,omitempty"`
+	// NSX groups generated for the policy.
+	// +listType=atomic
+	Groups []EffectiveGroup `json:"groups,omitempty"`
+	// NSX shares generated for the policy.
+	// +listType=atomic
+	Shares []EffectiveShare `json:"shares,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EffectiveSecurityPolicyList contains a list of EffectiveSecurityPolicy.
+type EffectiveSecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EffectiveSecurityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EffectiveSecurityPolicy{}, &EffectiveSecurityPolicyList{})
+}
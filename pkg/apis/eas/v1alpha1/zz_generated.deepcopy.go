@@ -62,6 +62,191 @@ func (in *DHCPIPPoolUsage) DeepCopy() *DHCPIPPoolUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveGroup) DeepCopyInto(out *EffectiveGroup) {
+	*out = *in
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberIPs != nil {
+		in, out := &in.MemberIPs, &out.MemberIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveGroup.
+func (in *EffectiveGroup) DeepCopy() *EffectiveGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveRule) DeepCopyInto(out *EffectiveRule) {
+	*out = *in
+	if in.SourceGroups != nil {
+		in, out := &in.SourceGroups, &out.SourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationGroups != nil {
+		in, out := &in.DestinationGroups, &out.DestinationGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppliedTo != nil {
+		in, out := &in.AppliedTo, &out.AppliedTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceEntries != nil {
+		in, out := &in.ServiceEntries, &out.ServiceEntries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveRule.
+func (in *EffectiveRule) DeepCopy() *EffectiveRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveSection) DeepCopyInto(out *EffectiveSection) {
+	*out = *in
+	if in.AppliedTo != nil {
+		in, out := &in.AppliedTo, &out.AppliedTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveSection.
+func (in *EffectiveSection) DeepCopy() *EffectiveSection {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveSection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveSecurityPolicy) DeepCopyInto(out *EffectiveSecurityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Sections != nil {
+		in, out := &in.Sections, &out.Sections
+		*out = make([]EffectiveSection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]EffectiveRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]EffectiveGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Shares != nil {
+		in, out := &in.Shares, &out.Shares
+		*out = make([]EffectiveShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveSecurityPolicy.
+func (in *EffectiveSecurityPolicy) DeepCopy() *EffectiveSecurityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveSecurityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EffectiveSecurityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveSecurityPolicyList) DeepCopyInto(out *EffectiveSecurityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EffectiveSecurityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveSecurityPolicyList.
+func (in *EffectiveSecurityPolicyList) DeepCopy() *EffectiveSecurityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveSecurityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EffectiveSecurityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveShare) DeepCopyInto(out *EffectiveShare) {
+	*out = *in
+	if in.SharedWith != nil {
+		in, out := &in.SharedWith, &out.SharedWith
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveShare.
+func (in *EffectiveShare) DeepCopy() *EffectiveShare {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlockUsage) DeepCopyInto(out *IPBlockUsage) {
 	*out = *in
@@ -75,6 +75,25 @@ type SecurityPolicyRule struct {
 	Ports []SecurityPolicyPort `json:"ports,omitempty"`
 	// Name is the display name of this rule.
 	Name string `json:"name,omitempty"`
+	// Logged enables NSX firewall logging of the traffic matching this rule.
+	Logged bool `json:"logged,omitempty"`
+	// LogLabel is the label of this rule in the NSX firewall logs.
+	// +kubebuilder:validation:MaxLength=32
+	LogLabel string `json:"logLabel,omitempty"`
+	// Tags are the additional NSX tags of this rule.
+	// +kubebuilder:validation:MaxItems=10
+	Tags []RuleTag `json:"tags,omitempty"`
+}
+
+// RuleTag defines an NSX tag of a SecurityPolicy rule.
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || !self.scope.startsWith('nsx-op/')", message="Tag scope prefix nsx-op/ is reserved"
+type RuleTag struct {
+	// Scope is the scope of the tag.
+	// +kubebuilder:validation:MaxLength=128
+	Scope string `json:"scope,omitempty"`
+	// Tag is the value of the tag.
+	// +kubebuilder:validation:MaxLength=256
+	Tag string `json:"tag"`
 }
 
 // SecurityPolicyTarget defines the target endpoints to apply SecurityPolicy.
@@ -95,6 +114,12 @@ type SecurityPolicyPeer struct {
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 	// IPBlocks is a list of IP CIDRs.
 	IPBlocks []IPBlock `json:"ipBlocks,omitempty"`
+	// FQDNs is a list of domain names, e.g. "www.example.com" or "*.example.com".
+	// It is only supported in the destinations of egress rules, and can't be used with other peer fields.
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^(\*\.)?([a-zA-Z0-9]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`
+	FQDNs []string `json:"fqdns,omitempty"`
 }
 
 // IPBlock describes a particular CIDR that is allowed or denied to/from the workloads matched by an AppliedTo.
@@ -121,6 +146,9 @@ type SecurityPolicyPort struct {
 type SecurityPolicyStatus struct {
 	// Conditions describes current state of security policy.
 	Conditions []Condition `json:"conditions"`
+	// RuleCount is the number of NSX rules the security policy is expanded to, a rule with named ports may be
+	// expanded to multiple NSX rules.
+	RuleCount int `json:"ruleCount,omitempty"`
 }
 
 // +genclient
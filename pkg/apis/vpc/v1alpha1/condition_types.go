@@ -16,6 +16,7 @@ const (
 	ExternalIPBlocksConfigured ConditionType = "ExternalIPBlocksConfigured"
 	DeleteFailure              ConditionType = "DeletionFailed"
 	UpdateFailure              ConditionType = "UpdateFailed"
+	SubnetExpanded             ConditionType = "SubnetExpanded"
 )
 
 // Condition defines condition of custom resource.
@@ -27,6 +27,10 @@ type NetworkInfo struct {
 	VPCs []VPCState `json:"vpcs"`
 	// AllowedDNSDomains specifies the permitted DNS domain names in the namespace.
 	AllowedDNSDomains []string `json:"allowedDNSDomains,omitempty"`
+	// DNSConflicts lists the FQDNs requested by the resources in the namespace which are rejected
+	// because the DNS records are owned by other resources.
+	// +listType=atomic
+	DNSConflicts []DNSConflict `json:"dnsConflicts,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +61,35 @@ type VPCState struct {
 	NetworkStack NetworkStackType `json:"networkStack,omitempty"`
 }
 
+// DNSConflict is an FQDN contested by the resources in the namespace.
+type DNSConflict struct {
+	// FQDN is the contested DNS name.
+	FQDN string `json:"fqdn"`
+	// RecordType is the DNS record type, e.g. A, AAAA or CNAME.
+	RecordType string `json:"recordType"`
+	// DNSZone is the NSX path of the DNS zone of the FQDN.
+	DNSZone string `json:"dnsZone,omitempty"`
+	// Owner is the resource which owns the DNS record.
+	Owner DNSRecordOwner `json:"owner"`
+	// Claimants are the resources in the namespace whose DNS records are rejected.
+	// +listType=atomic
+	Claimants []DNSRecordOwner `json:"claimants"`
+	// Reason is why the DNS records of the claimants are rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DNSRecordOwner identifies a resource which owns or claims a DNS record.
+type DNSRecordOwner struct {
+	// Kind of the resource, e.g. Service, Gateway or HTTPRoute.
+	Kind string `json:"kind,omitempty"`
+	// Namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Cluster which the resource belongs to.
+	Cluster string `json:"cluster,omitempty"`
+}
+
 func init() {
 	SchemeBuilder.Register(&NetworkInfo{}, &NetworkInfoList{})
 }
@@ -57,8 +57,9 @@ type SubnetSpec struct {
 	// +kubebuilder:default=IPv4
 	IPAddressType IPAddressType `json:"ipAddressType,omitempty"`
 	// Size of IPv4 Subnet based upon estimated workload count.
+	// It can be increased to expand a realized Subnet in place, the expansion progress is reported
+	// through the SubnetExpanded condition. It can be reverted to the realized size after a failed expansion.
 	// +kubebuilder:validation:Maximum:=65536
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	IPv4SubnetSize int `json:"ipv4SubnetSize,omitempty"`
 	// IPv6 prefix length for the Subnet (e.g. 64 means /64).
 	// +kubebuilder:validation:Minimum:=2
@@ -52,10 +52,22 @@ type SubnetInfo struct {
 	DHCPServerAddresses []string `json:"DHCPServerAddresses,omitempty"`
 }
 
+// IPUtilization defines the IPv4 utilization of the Subnets of a SubnetSet.
+type IPUtilization struct {
+	// Number of the IPv4 addresses allocated from the Subnets.
+	UsedIPs int `json:"usedIPs"`
+	// Number of the IPv4 addresses which can be allocated from the Subnets.
+	TotalIPs int `json:"totalIPs"`
+	// Percentage of the allocated IPv4 addresses.
+	Percentage int `json:"percentage"`
+}
+
 // SubnetSetStatus defines the observed state of SubnetSet.
 type SubnetSetStatus struct {
 	Conditions []Condition  `json:"conditions,omitempty"`
 	Subnets    []SubnetInfo `json:"subnets,omitempty"`
+	// IPv4 utilization of the Subnets created for the SubnetSet.
+	IPUtilization *IPUtilization `json:"ipUtilization,omitempty"`
 }
 
 // +genclient
@@ -69,6 +81,7 @@ type SubnetSetStatus struct {
 // +kubebuilder:printcolumn:name="IPv4SubnetSize",type=string,JSONPath=`.spec.ipv4SubnetSize`,description="Size of IPv4 Subnet"
 // +kubebuilder:printcolumn:name="IPv6PrefixLength",type=string,JSONPath=`.spec.ipv6PrefixLength`,description="Prefix length of IPv6 Subnet"
 // +kubebuilder:printcolumn:name="NetworkAddresses",type=string,JSONPath=`.status.subnets[*].networkAddresses[*]`,description="CIDRs for the SubnetSet"
+// +kubebuilder:printcolumn:name="IPUtilization",type=integer,JSONPath=`.status.ipUtilization.percentage`,description="IPv4 utilization in percent of the SubnetSet",priority=1
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.spec) || has(self.spec)", message="spec is required once set"
 type SubnetSet struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -164,6 +164,42 @@ func (in *DHCPv6ServerAdditionalConfig) DeepCopy() *DHCPv6ServerAdditionalConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSConflict) DeepCopyInto(out *DNSConflict) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Claimants != nil {
+		in, out := &in.Claimants, &out.Claimants
+		*out = make([]DNSRecordOwner, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSConflict.
+func (in *DNSConflict) DeepCopy() *DNSConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordOwner) DeepCopyInto(out *DNSRecordOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordOwner.
+func (in *DNSRecordOwner) DeepCopy() *DNSRecordOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAddressAllocation) DeepCopyInto(out *IPAddressAllocation) {
 	*out = *in
@@ -367,6 +403,21 @@ func (in *IPPoolRange) DeepCopy() *IPPoolRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPUtilization) DeepCopyInto(out *IPUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPUtilization.
+func (in *IPUtilization) DeepCopy() *IPUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(IPUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
 	*out = *in
@@ -384,6 +435,13 @@ func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSConflicts != nil {
+		in, out := &in.DNSConflicts, &out.DNSConflicts
+		*out = make([]DNSConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInfo.
@@ -516,6 +574,21 @@ func (in *PortAttachment) DeepCopy() *PortAttachment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTag) DeepCopyInto(out *RuleTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTag.
+func (in *RuleTag) DeepCopy() *RuleTag {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicy) DeepCopyInto(out *SecurityPolicy) {
 	*out = *in
@@ -598,6 +671,11 @@ func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 		*out = make([]IPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPeer.
@@ -679,6 +757,11 @@ func (in *SecurityPolicyRule) DeepCopyInto(out *SecurityPolicyRule) {
 		*out = make([]SecurityPolicyPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]RuleTag, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRule.
@@ -1478,6 +1561,11 @@ func (in *SubnetSetStatus) DeepCopyInto(out *SubnetSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IPUtilization != nil {
+		in, out := &in.IPUtilization, &out.IPUtilization
+		*out = new(IPUtilization)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSetStatus.
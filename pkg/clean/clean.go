@@ -48,68 +48,109 @@ var Backoff = wait.Backoff{
 // InitCleanupServiceFailed 	indicate that error happened when trying to initialize cleanup service
 // CleanupResourceFailed    	indicate that the cleanup operation failed at some services, the detailed will in the service logs
 func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger, debug bool, logLevel int) error {
+	return CleanWithOptions(ctx, cf, log, debug, logLevel, Options{})
+}
+
+// Options customizes CleanWithOptions.
+type Options struct {
+	// Scope selects the NSX resources to clean up, e.g. when retiring a namespace or recovering a broken VPC. A nil
+	// Scope selects all the resources.
+	Scope *Scope
+	// JournalPath is the local file to persist the cleanup progress. If it is set, a rerun with the same cluster and
+	// scope resumes at the first unfinished VPC or infra step, and the file is removed after a successful cleanup.
+	JournalPath string
+}
+
+// CleanWithOptions is the same as Clean, but cleans up the NSX resources with the options.
+func CleanWithOptions(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger, debug bool, logLevel int, opts Options) error {
 	// Clean needs to support many instances which each have its own logger
 	if log == nil {
 		logg := logger.ZapCustomLogger(debug, logLevel).Logger
 		log = &logg
 	}
 
+	var journal *Journal
+	if opts.JournalPath != "" {
+		var err error
+		if journal, err = LoadJournal(opts.JournalPath, cf.Cluster, opts.Scope); err != nil {
+			return errors.Join(nsxutil.ValidationFailed, err)
+		}
+		doneSteps, doneVPCs, _ := journal.Summary()
+		log.Info("Loaded cleanup journal", "path", opts.JournalPath, "doneSteps", doneSteps, "deletedVPCs", doneVPCs)
+	}
+
 	log.Info("Starting NSX cleanup")
+	cleanupService, err := initCleanupService(ctx, cf, log, opts.Scope)
+	if err != nil {
+		return err
+	}
+	cleanupService.journal = journal
+
+	if err := cleanupService.cleanup(ctx); err != nil {
+		cleanupService.logJournalSummary()
+		return errors.Join(nsxutil.CleanupResourceFailed, err)
+	}
+	cleanupService.logJournalSummary()
+	if err := journal.Remove(); err != nil {
+		log.Error(err, "Failed to remove cleanup journal", "path", opts.JournalPath)
+	}
+
+	log.Info("Cleanup NSX resources successfully")
+	return nil
+}
+
+// initCleanupService validates the config and initializes the CleanupService within the deadline of ctx, it is shared by
+// Clean and Plan.
+func initCleanupService(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger, scope *Scope) (*CleanupService, error) {
 	if err := cf.ValidateConfigFromCmd(); err != nil {
-		return errors.Join(nsxutil.ValidationFailed, err)
+		return nil, errors.Join(nsxutil.ValidationFailed, err)
+	}
+	if err := scope.Validate(); err != nil {
+		return nil, errors.Join(nsxutil.ValidationFailed, err)
 	}
 	cf.LibMode = true
 	nsxClient := nsx.GetClient(cf)
 	if nsxClient == nil {
-		return nsxutil.GetNSXClientFailed
+		return nil, nsxutil.GetNSXClientFailed
 	}
 	// add timeout for initialization
 	errChan := make(chan error)
 	var cleanupService *CleanupService
 	var err error
 	go func() {
-		cleanupService, err = InitializeCleanupService(cf, nsxClient, log)
+		cleanupService, err = InitializeCleanupService(cf, nsxClient, log, scope)
 		errChan <- err
 	}()
 
 	select {
 	case err := <-errChan:
 		if err != nil {
-			return errors.Join(nsxutil.InitCleanupServiceFailed, err)
+			return nil, errors.Join(nsxutil.InitCleanupServiceFailed, err)
 		}
 	case <-ctx.Done():
-		return errors.Join(nsxutil.TimeoutFailed, ctx.Err())
+		return nil, errors.Join(nsxutil.TimeoutFailed, ctx.Err())
 	}
 
 	if cleanupService.svcErr != nil {
-		return errors.Join(nsxutil.InitCleanupServiceFailed, cleanupService.svcErr)
+		return nil, errors.Join(nsxutil.InitCleanupServiceFailed, cleanupService.svcErr)
 	}
 
 	cleanupService.log = log
-
-	if err := cleanupService.cleanupVPCResources(ctx); err != nil {
-		return errors.Join(nsxutil.CleanupResourceFailed, err)
-	}
-
-	if err := cleanupService.cleanupInfraResources(ctx); err != nil {
-		return errors.Join(nsxutil.CleanupResourceFailed, err)
-	}
-
-	if err := cleanupService.cleanupHealthResources(ctx); err != nil {
-		return errors.Join(nsxutil.CleanupResourceFailed, err)
-	}
-
-	log.Info("Cleanup NSX resources successfully")
-	return nil
+	cleanupService.resolveScopedVPCs()
+	return cleanupService, nil
 }
 
-// InitializeCleanupService initializes all the CR services
-func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Client, log *logr.Logger) (*CleanupService, error) {
+// InitializeCleanupService initializes all the CR services, only the cleaners of the resource kinds selected by the
+// scope are registered.
+func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Client, log *logr.Logger, scope *Scope) (*CleanupService, error) {
 	cleanupService := NewCleanupService()
+	cleanupService.scope = scope
+	cleanupService.filter = scope.cleanupFilter()
 
 	commonService := common.Service{
-		NSXClient: nsxClient,
-		NSXConfig: cf,
+		NSXClient:     nsxClient,
+		NSXConfig:     cf,
+		CleanupFilter: cleanupService.filter,
 	}
 	vpcService, vpcErr := vpc.InitializeVPC(commonService)
 	ipAddressAllocationService, err := ipaddressallocation.InitializeIPAddressAllocation(commonService, vpcService, true)
@@ -201,7 +242,11 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 
 	cleanupService.vpcService = vpcService
 	// TODO: initialize other CR services
-	loggedAdd := func(name string, f cleanupFunc) {
+	loggedAdd := func(kind string, name string, f cleanupFunc) {
+		if !scope.selects(kind) {
+			log.Info("Skipping service out of the cleanup scope", "service", name, "kind", kind)
+			return
+		}
 		if cleanupService.svcErr != nil {
 			log.Info("Skipping service initialization due to previous error", "service", name, "error", cleanupService.svcErr)
 			return
@@ -215,19 +260,19 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 		}
 	}
 
-	loggedAdd("SubnetPort", wrapInitializeSubnetPort(commonService))
-	loggedAdd("SubnetBinding", wrapInitializeSubnetBinding(commonService))
-	loggedAdd("SubnetIPReservation", wrapInitializeSubnetIPReservation(commonService))
-	loggedAdd("SubnetService", wrapInitializeSubnetService(commonService))
-	loggedAdd("SecurityPolicy", wrapInitializeSecurityPolicy(commonService))
-	loggedAdd("StaticRoute", wrapInitializeStaticRoute(commonService))
-	loggedAdd("VPC", wrapInitializeVPC(commonService))
-	loggedAdd("IPAddressAllocation", wrapInitializeIPAddressAllocation(commonService))
-	loggedAdd("DNSRecord", wrapInitializeDNSRecordService(commonService))
-	loggedAdd("Inventory", wrapInitializeInventory(commonService))
-	loggedAdd("LBInfraCleaner", wrapInitializeLBInfraCleaner(commonService))
-	loggedAdd("HealthCleaner", wrapInitializeHealthCleaner(commonService))
-	loggedAdd("NSXServiceAccount", wrapInitializeNSXServiceAccount(commonService))
+	loggedAdd(ResourceKindSubnetPort, "SubnetPort", wrapInitializeSubnetPort(commonService))
+	loggedAdd(ResourceKindSubnetBinding, "SubnetBinding", wrapInitializeSubnetBinding(commonService))
+	loggedAdd(ResourceKindSubnetIPReservation, "SubnetIPReservation", wrapInitializeSubnetIPReservation(commonService))
+	loggedAdd(ResourceKindSubnet, "SubnetService", wrapInitializeSubnetService(commonService))
+	loggedAdd(ResourceKindSecurityPolicy, "SecurityPolicy", wrapInitializeSecurityPolicy(commonService))
+	loggedAdd(ResourceKindStaticRoute, "StaticRoute", wrapInitializeStaticRoute(commonService))
+	loggedAdd(ResourceKindVPC, "VPC", wrapInitializeVPC(commonService))
+	loggedAdd(ResourceKindIPAddressAllocation, "IPAddressAllocation", wrapInitializeIPAddressAllocation(commonService))
+	loggedAdd(ResourceKindDNSRecord, "DNSRecord", wrapInitializeDNSRecordService(commonService))
+	loggedAdd(ResourceKindInventory, "Inventory", wrapInitializeInventory(commonService))
+	loggedAdd(ResourceKindLBInfra, "LBInfraCleaner", wrapInitializeLBInfraCleaner(commonService))
+	loggedAdd(ResourceKindHealth, "HealthCleaner", wrapInitializeHealthCleaner(commonService))
+	loggedAdd(ResourceKindNSXServiceAccount, "NSXServiceAccount", wrapInitializeNSXServiceAccount(commonService))
 
 	log.Info("Cleanup service initialization summary",
 		"vpcPreCleaners", len(cleanupService.vpcPreCleaners),
@@ -28,11 +28,13 @@ func NewHealthCleaner(service common.Service, log *logr.Logger, nsxClient *nsx.C
 	}
 }
 
+const healthStatusURLFormat = "api/v1/systemhealth/container-cluster/%s/ncp/status"
+
 // CleanupHealthResources deletes the health status resource from NSX
 func (h *HealthCleaner) CleanupHealthResources(_ context.Context) error {
 	// Delete the health status resource from NSX
 	if h.nsxClient != nil && h.clusterID != "" {
-		url := fmt.Sprintf("api/v1/systemhealth/container-cluster/%s/ncp/status", h.clusterID)
+		url := fmt.Sprintf(healthStatusURLFormat, h.clusterID)
 		if err := h.nsxClient.Cluster.HttpDelete(url); err != nil {
 			h.log.Error(err, "Failed to delete health status resource from NSX", "clusterID", h.clusterID, "url", url)
 			return err
@@ -43,3 +45,13 @@ func (h *HealthCleaner) CleanupHealthResources(_ context.Context) error {
 	}
 	return nil
 }
+
+// PlanCleanupHealthResources returns the API path of the health status resource which CleanupHealthResources would delete.
+func (h *HealthCleaner) PlanCleanupHealthResources(_ context.Context) (map[string][]string, error) {
+	if h.nsxClient == nil || h.clusterID == "" {
+		return nil, nil
+	}
+	return map[string][]string{
+		"HealthStatus": {fmt.Sprintf(healthStatusURLFormat, h.clusterID)},
+	}, nil
+}
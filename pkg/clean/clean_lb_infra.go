@@ -433,3 +433,42 @@ func (s *LBInfraCleaner) cleanupLBMonitorProfiles(ctx context.Context) error {
 	s.log.Info("Completed to clean up NCP created lbMonitorProfiles", "successCount", successCount, "failedCount", failedCount)
 	return nil
 }
+
+// PlanCleanupInfraResources returns the paths of the LB related resources under /infra which CleanupInfraResources
+// would delete, grouped by the resource kind.
+func (s *LBInfraCleaner) PlanCleanupInfraResources(_ context.Context) (map[string][]string, error) {
+	queries := []struct {
+		kind          string
+		resourceTypes []string
+		bindingType   bindings.BindingType
+		dlbOnly       bool
+	}{
+		{kind: common.ResourceTypeLBVirtualServer, resourceTypes: []string{common.ResourceTypeLBVirtualServer}, bindingType: model.LBVirtualServerBindingType(), dlbOnly: true},
+		{kind: common.ResourceTypeShare, resourceTypes: []string{common.ResourceTypeShare}, bindingType: model.ShareBindingType()},
+		{kind: common.ResourceTypeLBPool, resourceTypes: []string{common.ResourceTypeLBPool}, bindingType: model.LBPoolBindingType(), dlbOnly: true},
+		{kind: common.ResourceTypeLBService, resourceTypes: []string{common.ResourceTypeLBService}, bindingType: model.LBServiceBindingType(), dlbOnly: true},
+		{kind: common.ResourceTypeGroup, resourceTypes: []string{common.ResourceTypeGroup}, bindingType: model.GroupBindingType(), dlbOnly: true},
+		{kind: common.ResourceTypeTlsCertificate, resourceTypes: []string{common.ResourceTypeTlsCertificate}, bindingType: model.TlsCertificateBindingType()},
+		{kind: "LBAppProfile", resourceTypes: []string{common.ResourceTypeLBHttpProfile, common.ResourceTypeLBFastTcpProfile, common.ResourceTypeLBFastUdpProfile}, bindingType: model.LBAppProfileBindingType()},
+		{kind: "LBPersistenceProfile", resourceTypes: []string{common.ResourceTypeLBCookiePersistenceProfile, common.ResourceTypeLBSourceIpPersistenceProfile}, bindingType: model.LBPersistenceProfileBindingType()},
+		{kind: "LBMonitorProfile", resourceTypes: []string{common.ResourceTypeLBHttpMonitorProfile, common.ResourceTypeLBTcpMonitorProfile}, bindingType: model.LBMonitorProfileBindingType()},
+		{kind: common.ResourceTypeDomain, resourceTypes: []string{common.ResourceTypeDomain}, bindingType: model.DomainBindingType()},
+	}
+
+	plan := make(map[string][]string)
+	for _, q := range queries {
+		var store *ResourceStore
+		var err error
+		if q.dlbOnly {
+			store, err = s.queryDLBResources(q.resourceTypes, q.bindingType)
+		} else {
+			store, err = s.queryNCPCreatedResources(q.resourceTypes, q.bindingType, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The store is keyed by the resource path, see keyFunc.
+		plan[q.kind] = append(plan[q.kind], store.ListKeys()...)
+	}
+	return plan, nil
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
@@ -19,6 +20,12 @@ import (
 type CleanupService struct {
 	log *logr.Logger
 
+	// scope and filter select the subset of the resources to clean up, they are nil when cleaning up all resources.
+	scope  *Scope
+	filter *common.CleanupFilter
+	// journal records the progress to resume the cleanup in the next run, it is nil if not enabled.
+	journal *Journal
+
 	vpcService          *vpc.VPCService
 	vpcPreCleaners      []vpcPreCleaner
 	vpcChildrenCleaners []vpcChildrenCleaner
@@ -158,6 +165,9 @@ func (c *CleanupService) vpcWorker(ctx context.Context, queue workqueue.TypedRat
 	} else {
 		c.log.Info("Successfully deleted VPC", "vpcPath", vpcPath, "vpcID", vpcID)
 	}
+	if journalErr := c.journal.RecordVPC(vpcPath, err); journalErr != nil {
+		c.log.Error(journalErr, "Failed to record VPC cleanup in the journal", "vpcPath", vpcPath)
+	}
 
 	completedVPCs.Insert(vpcPath)
 	if potentialVPCs.Equal(completedVPCs) {
@@ -180,7 +190,14 @@ func (c *CleanupService) cleanupAutoCreatedVPCs(ctx context.Context) error {
 	queue := workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
 	defer queue.ShutDown()
 
-	autoCreatedVPCs := c.vpcService.ListAutoCreatedVPCPaths()
+	// The VPC store is rebuilt from NSX in each run, so the journal is only trusted for the VPCs absent from the store.
+	// A VPC recorded as deleted but still found on NSX is deleted again.
+	autoCreatedVPCs := c.listAutoCreatedVPCPaths()
+	for vpcPath := range autoCreatedVPCs {
+		if c.journal.IsVPCDone(vpcPath) {
+			c.log.Info("Deleting VPC again which was deleted in the previous run but still exists on NSX", "vpcPath", vpcPath)
+		}
+	}
 	if autoCreatedVPCs.Len() == 0 {
 		return nil
 	}
@@ -211,18 +228,56 @@ func (c *CleanupService) cleanupAutoCreatedVPCs(ctx context.Context) error {
 	return nil
 }
 
+// runJournaledStep runs the cleanup step unless it is finished in the previous run, and records the result in the journal.
+func (c *CleanupService) runJournaledStep(ctx context.Context, step string, cleanup func(ctx context.Context) error) error {
+	if c.journal.IsStepDone(step) {
+		c.log.Info("Skipping cleanup step finished in the previous run", "step", step)
+		return nil
+	}
+	err := cleanup(ctx)
+	if journalErr := c.journal.RecordStep(step, err); journalErr != nil {
+		c.log.Error(journalErr, "Failed to record cleanup step in the journal", "step", step)
+	}
+	return err
+}
+
+// cleanup runs all the cleanup steps in order, the steps finished in the previous run are skipped if the journal is
+// enabled.
+func (c *CleanupService) cleanup(ctx context.Context) error {
+	if err := c.cleanupVPCResources(ctx); err != nil {
+		return err
+	}
+	if err := c.runJournaledStep(ctx, journalStepInfra, c.cleanupInfraResources); err != nil {
+		return err
+	}
+	return c.runJournaledStep(ctx, journalStepHealth, c.cleanupHealthResources)
+}
+
+// logJournalSummary logs the progress recorded in the journal, including why each failed step or VPC failed.
+func (c *CleanupService) logJournalSummary() {
+	if c.journal == nil {
+		return
+	}
+	doneSteps, doneVPCs, failed := c.journal.Summary()
+	c.log.Info("Cleanup journal summary", "doneSteps", doneSteps, "deletedVPCs", doneVPCs, "failed", len(failed))
+	failures := c.journal.Failures()
+	for _, key := range failed {
+		c.log.Info("Cleanup failed", "stepOrVPC", key, "error", failures[key])
+	}
+}
+
 // cleanupVPCResources cleans up the VPCs and their children resources created by nsx-operator.
 func (c *CleanupService) cleanupVPCResources(ctx context.Context) error {
 	// Clean up the indirect VPC children resources before deleting the VPCs, otherwise, it may block VPC deletion request
 	resourceCount := c.getPreVPCDeletionResourceCount()
-	if err := c.cleanupBeforeVPCDeletion(ctx); err != nil {
+	if err := c.runJournaledStep(ctx, journalStepBeforeVPCDeletion, c.cleanupBeforeVPCDeletion); err != nil {
 		c.log.Error(err, "Failed to delete the resources before deleting VPCs", "resourceCount", resourceCount)
 		return err
 	}
 	c.log.Info("Successfully deleted resources before deleting VPCs", "resourceCount", resourceCount)
 
 	// Clean up the auto-created VPC and its children resources
-	autoCreatedVPCCount := c.vpcService.ListAutoCreatedVPCPaths().Len()
+	autoCreatedVPCCount := c.listAutoCreatedVPCPaths().Len()
 	if err := c.cleanupAutoCreatedVPCs(ctx); err != nil {
 		c.log.Error(err, "Failed to delete the auto created VPCs and their child resources", "vpcCount", autoCreatedVPCCount)
 		return err
@@ -230,7 +285,7 @@ func (c *CleanupService) cleanupVPCResources(ctx context.Context) error {
 	c.log.Info("Successfully deleted auto created VPCs and their child resources", "vpcCount", autoCreatedVPCCount)
 
 	// Clean up the resources in pre-created VPC.
-	if err := c.cleanPreCreatedVPCs(ctx); err != nil {
+	if err := c.runJournaledStep(ctx, journalStepPreCreatedVPCs, c.cleanPreCreatedVPCs); err != nil {
 		c.log.Error(err, "Failed to delete the pre-created VPCs' child resources")
 		return err
 	}
@@ -82,7 +82,7 @@ func TestClean_InitError(t *testing.T) {
 		return &nsx.Client{}
 	})
 
-	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger) (*CleanupService, error) {
+	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger, _ *Scope) (*CleanupService, error) {
 		return nil, errors.New("init cleanup service failed")
 	})
 
@@ -113,7 +113,7 @@ func TestClean_Cleanup(t *testing.T) {
 		return clean, nil
 	})
 
-	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger) (*CleanupService, error) {
+	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger, _ *Scope) (*CleanupService, error) {
 		return cleanupService, nil
 	})
 	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
@@ -214,7 +214,7 @@ func TestInitializeCleanupService_Success(t *testing.T) {
 		}
 	})
 
-	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log)
+	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, cleanupService)
 	// vpcPreCleaners: SubnetPort, SubnetBinding, SubnetIPReservation, Inventory, SecurityPolicy, LBInfraCleaner, NSXServiceAccount, HealthCleaner = 8
@@ -259,7 +259,7 @@ func TestInitializeCleanupService_VPCError(t *testing.T) {
 		return &subnetipreservation.IPReservationService{}, nil
 	})
 
-	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log)
+	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, cleanupService)
 	// Note, the services added after VPCService should fail because of the error returned in `InitializeVPC`.
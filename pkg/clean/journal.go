@@ -0,0 +1,209 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package clean
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// The cleanup steps recorded in the Journal, the auto-created VPCs are recorded by their paths separately.
+const (
+	journalStepBeforeVPCDeletion = "BeforeVPCDeletion"
+	journalStepPreCreatedVPCs    = "PreCreatedVPCs"
+	journalStepInfra             = "Infra"
+	journalStepHealth            = "Health"
+
+	journalStatusDone   = "Done"
+	journalStatusFailed = "Failed"
+)
+
+// JournalEntry is the result of a cleanup step or an auto-created VPC.
+type JournalEntry struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Journal persists the cleanup progress to a local file, so that a rerun after a failure skips the finished steps
+// and VPCs. A nil Journal records nothing.
+type Journal struct {
+	mu   sync.Mutex
+	path string
+
+	Cluster string                   `json:"cluster"`
+	Scope   *Scope                   `json:"scope,omitempty"`
+	Steps   map[string]*JournalEntry `json:"steps"`
+	VPCs    map[string]*JournalEntry `json:"vpcs"`
+}
+
+// LoadJournal loads the journal from the file, a new journal is returned if the file doesn't exist or the journal was
+// written for another cluster or scope.
+func LoadJournal(path string, cluster string, scope *Scope) (*Journal, error) {
+	journal := &Journal{
+		path:    path,
+		Cluster: cluster,
+		Scope:   scope,
+		Steps:   map[string]*JournalEntry{},
+		VPCs:    map[string]*JournalEntry{},
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return journal, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cleanup journal %s: %w", path, err)
+	}
+	previous := &Journal{}
+	if err := json.Unmarshal(content, previous); err != nil {
+		return nil, fmt.Errorf("failed to parse cleanup journal %s: %w", path, err)
+	}
+	if previous.Cluster != cluster || !reflect.DeepEqual(previous.Scope, scope) {
+		return journal, nil
+	}
+	if previous.Steps != nil {
+		journal.Steps = previous.Steps
+	}
+	if previous.VPCs != nil {
+		journal.VPCs = previous.VPCs
+	}
+	return journal, nil
+}
+
+// IsStepDone returns true if the step has been finished in a previous run.
+func (j *Journal) IsStepDone(step string) bool {
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry, ok := j.Steps[step]
+	return ok && entry.Status == journalStatusDone
+}
+
+// IsVPCDone returns true if the auto-created VPC has been deleted in a previous run.
+func (j *Journal) IsVPCDone(vpcPath string) bool {
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry, ok := j.VPCs[vpcPath]
+	return ok && entry.Status == journalStatusDone
+}
+
+// RecordStep records the result of the step and saves the journal.
+func (j *Journal) RecordStep(step string, err error) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.Steps[step] = newJournalEntry(err)
+	return j.save()
+}
+
+// RecordVPC records the result of deleting the auto-created VPC and saves the journal.
+func (j *Journal) RecordVPC(vpcPath string, err error) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.VPCs[vpcPath] = newJournalEntry(err)
+	return j.save()
+}
+
+// Failures returns the error of each failed step or VPC, keyed by the step name or the VPC path.
+func (j *Journal) Failures() map[string]string {
+	failures := map[string]string{}
+	if j == nil {
+		return failures
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, entries := range []map[string]*JournalEntry{j.Steps, j.VPCs} {
+		for key, entry := range entries {
+			if entry.Status == journalStatusFailed {
+				failures[key] = entry.Error
+			}
+		}
+	}
+	return failures
+}
+
+// Summary returns the numbers of the finished steps and VPCs, and the sorted keys of the failed ones.
+func (j *Journal) Summary() (doneSteps int, doneVPCs int, failed []string) {
+	if j == nil {
+		return 0, 0, nil
+	}
+	for key := range j.Failures() {
+		failed = append(failed, key)
+	}
+	sort.Strings(failed)
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, entry := range j.Steps {
+		if entry.Status == journalStatusDone {
+			doneSteps++
+		}
+	}
+	for _, entry := range j.VPCs {
+		if entry.Status == journalStatusDone {
+			doneVPCs++
+		}
+	}
+	return doneSteps, doneVPCs, failed
+}
+
+// Remove deletes the journal file, it is called after all the resources are cleaned up.
+func (j *Journal) Remove() error {
+	if j == nil {
+		return nil
+	}
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// save writes the journal to a temporary file and renames it, so an interrupted run never leaves a partial journal.
+func (j *Journal) save() error {
+	content, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(j.path), filepath.Base(j.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to save cleanup journal %s: %w", j.path, err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(content); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to save cleanup journal %s: %w", j.path, err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to save cleanup journal %s: %w", j.path, err)
+	}
+	if err := os.Rename(tmpFile.Name(), j.path); err != nil {
+		return fmt.Errorf("failed to save cleanup journal %s: %w", j.path, err)
+	}
+	return nil
+}
+
+func newJournalEntry(err error) *JournalEntry {
+	entry := &JournalEntry{Status: journalStatusDone, UpdatedAt: time.Now()}
+	if err != nil {
+		entry.Status = journalStatusFailed
+		entry.Error = err.Error()
+	}
+	return entry
+}
@@ -0,0 +1,122 @@
+package clean
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+)
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	scope := &Scope{Namespaces: []string{"ns-1"}}
+	vpcPath := "/orgs/default/projects/p1/vpcs/vpc-1"
+
+	journal, err := LoadJournal(path, "cluster-1", scope)
+	require.NoError(t, err)
+	assert.False(t, journal.IsStepDone(journalStepBeforeVPCDeletion))
+
+	require.NoError(t, journal.RecordStep(journalStepBeforeVPCDeletion, nil))
+	require.NoError(t, journal.RecordVPC(vpcPath, errors.New("vpc is in use")))
+	require.NoError(t, journal.RecordVPC("/orgs/default/projects/p1/vpcs/vpc-2", nil))
+
+	t.Run("resume", func(t *testing.T) {
+		resumed, err := LoadJournal(path, "cluster-1", &Scope{Namespaces: []string{"ns-1"}})
+		require.NoError(t, err)
+		assert.True(t, resumed.IsStepDone(journalStepBeforeVPCDeletion))
+		assert.False(t, resumed.IsStepDone(journalStepInfra))
+		assert.False(t, resumed.IsVPCDone(vpcPath))
+		assert.True(t, resumed.IsVPCDone("/orgs/default/projects/p1/vpcs/vpc-2"))
+		assert.Equal(t, map[string]string{vpcPath: "vpc is in use"}, resumed.Failures())
+
+		doneSteps, doneVPCs, failed := resumed.Summary()
+		assert.Equal(t, 1, doneSteps)
+		assert.Equal(t, 1, doneVPCs)
+		assert.Equal(t, []string{vpcPath}, failed)
+	})
+
+	t.Run("another scope", func(t *testing.T) {
+		restarted, err := LoadJournal(path, "cluster-1", nil)
+		require.NoError(t, err)
+		assert.False(t, restarted.IsStepDone(journalStepBeforeVPCDeletion))
+		assert.Empty(t, restarted.Failures())
+	})
+
+	t.Run("another cluster", func(t *testing.T) {
+		restarted, err := LoadJournal(path, "cluster-2", scope)
+		require.NoError(t, err)
+		assert.False(t, restarted.IsStepDone(journalStepBeforeVPCDeletion))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := filepath.Join(t.TempDir(), "journal.json")
+		require.NoError(t, os.WriteFile(corrupted, []byte("{"), 0o600))
+		_, err := LoadJournal(corrupted, "cluster-1", scope)
+		assert.ErrorContains(t, err, "failed to parse cleanup journal")
+	})
+
+	require.NoError(t, journal.Remove())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, journal.Remove())
+
+	var nilJournal *Journal
+	assert.False(t, nilJournal.IsVPCDone(vpcPath))
+	assert.NoError(t, nilJournal.RecordStep(journalStepInfra, nil))
+}
+
+func TestCleanWithOptions_Resume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	vpcPath := "/orgs/default/projects/p1/vpcs/vpc-1"
+	journal, err := LoadJournal(path, cf.Cluster, nil)
+	require.NoError(t, err)
+	require.NoError(t, journal.RecordStep(journalStepBeforeVPCDeletion, nil))
+	require.NoError(t, journal.RecordVPC(vpcPath, nil))
+	require.NoError(t, journal.RecordVPC("/orgs/default/projects/p1/vpcs/vpc-2", nil))
+	require.NoError(t, journal.RecordStep(journalStepPreCreatedVPCs, errors.New("timeout")))
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(cf.NsxConfig), "ValidateConfigFromCmd", func(_ *config.NsxConfig) error {
+		return nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nsx.GetClient, func(_ *config.NSXOperatorConfig) *nsx.Client {
+		return &nsx.Client{}
+	})
+
+	cleanupService := &CleanupService{
+		vpcService: &vpc.VPCService{},
+	}
+	clean := &MockCleanup{}
+	cleanupService.AddCleanupService(func() (interface{}, error) {
+		return clean, nil
+	})
+	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger, _ *Scope) (*CleanupService, error) {
+		return cleanupService, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
+		return sets.New[string](vpcPath)
+	})
+
+	log := logr.Discard()
+	err = CleanWithOptions(context.Background(), cf, &log, false, 0, Options{JournalPath: path})
+	require.NoError(t, err)
+	// The finished step is skipped, the failed step is retried. The VPC recorded as deleted but still found on NSX is
+	// deleted again.
+	assert.False(t, clean.vpcPreCleanupCalled)
+	assert.Equal(t, []string{vpcPath, ""}, clean.cleanedVPCs)
+	assert.True(t, clean.infraCleanupCalled)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
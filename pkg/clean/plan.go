@@ -0,0 +1,265 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package clean
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// ResourcePaths groups the NSX resource paths by the NSX resource type.
+type ResourcePaths map[string][]string
+
+func (r ResourcePaths) merge(other map[string][]string) {
+	for resourceType, paths := range other {
+		if len(paths) == 0 {
+			continue
+		}
+		r[resourceType] = append(r[resourceType], paths...)
+	}
+}
+
+// Count returns the number of resources in all the resource types.
+func (r ResourcePaths) Count() int {
+	count := 0
+	for _, paths := range r {
+		count += len(paths)
+	}
+	return count
+}
+
+// excludeVPCs returns the resources which are not in any of the given VPCs.
+func (r ResourcePaths) excludeVPCs(vpcPaths sets.Set[string]) ResourcePaths {
+	excluded := ResourcePaths{}
+	for resourceType, paths := range r {
+		for _, path := range paths {
+			if !inVPCs(path, vpcPaths) {
+				excluded[resourceType] = append(excluded[resourceType], path)
+			}
+		}
+	}
+	return excluded
+}
+
+func inVPCs(path string, vpcPaths sets.Set[string]) bool {
+	for vpcPath := range vpcPaths {
+		if path == vpcPath || strings.HasPrefix(path, vpcPath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r ResourcePaths) sort() {
+	for _, paths := range r {
+		sort.Strings(paths)
+	}
+}
+
+// CleanupPlan is the inventory of the NSX resources which Clean would delete, grouped by the cleanup step in the same
+// order as Clean runs them.
+type CleanupPlan struct {
+	// BeforeVPCDeletion is the resources deleted before the VPCs, which may otherwise block the VPC deletion.
+	BeforeVPCDeletion ResourcePaths `json:"beforeVPCDeletion"`
+	// AutoCreatedVPCs is keyed by the auto-created VPC path, the VPC is deleted recursively with the listed children.
+	AutoCreatedVPCs map[string]ResourcePaths `json:"autoCreatedVPCs"`
+	// PreCreatedVPCs is the resources deleted from the pre-created VPCs, the VPCs themselves are kept.
+	PreCreatedVPCs ResourcePaths `json:"preCreatedVPCs"`
+	// Infra is the resources deleted under /infra.
+	Infra ResourcePaths `json:"infra"`
+	// Health is the health checker resources.
+	Health ResourcePaths `json:"health"`
+	// Unplanned lists the cleaners which are not able to report the resources they would delete.
+	Unplanned []string `json:"unplanned,omitempty"`
+}
+
+func newCleanupPlan() *CleanupPlan {
+	return &CleanupPlan{
+		BeforeVPCDeletion: ResourcePaths{},
+		AutoCreatedVPCs:   map[string]ResourcePaths{},
+		PreCreatedVPCs:    ResourcePaths{},
+		Infra:             ResourcePaths{},
+		Health:            ResourcePaths{},
+	}
+}
+
+// Count returns the number of resources in the plan.
+func (p *CleanupPlan) Count() int {
+	count := p.BeforeVPCDeletion.Count() + p.PreCreatedVPCs.Count() + p.Infra.Count() + p.Health.Count()
+	for _, children := range p.AutoCreatedVPCs {
+		count += children.Count()
+	}
+	return count
+}
+
+type planSection struct {
+	title     string
+	resources ResourcePaths
+}
+
+// WriteText writes the plan in a human-readable format.
+func (p *CleanupPlan) WriteText(w io.Writer) error {
+	sections := []planSection{{title: "Before VPC deletion", resources: p.BeforeVPCDeletion}}
+	vpcPaths := make([]string, 0, len(p.AutoCreatedVPCs))
+	for vpcPath := range p.AutoCreatedVPCs {
+		vpcPaths = append(vpcPaths, vpcPath)
+	}
+	sort.Strings(vpcPaths)
+	for _, vpcPath := range vpcPaths {
+		sections = append(sections, planSection{title: fmt.Sprintf("Auto-created VPC %s (deleted recursively)", vpcPath), resources: p.AutoCreatedVPCs[vpcPath]})
+	}
+	sections = append(sections,
+		planSection{title: "Pre-created VPCs", resources: p.PreCreatedVPCs},
+		planSection{title: "Infra", resources: p.Infra},
+		planSection{title: "Health", resources: p.Health},
+	)
+
+	for _, section := range sections {
+		if _, err := fmt.Fprintf(w, "%s: %d\n", section.title, section.resources.Count()); err != nil {
+			return err
+		}
+		resourceTypes := make([]string, 0, len(section.resources))
+		for resourceType := range section.resources {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		sort.Strings(resourceTypes)
+		for _, resourceType := range resourceTypes {
+			paths := section.resources[resourceType]
+			if _, err := fmt.Fprintf(w, "  %s: %d\n", resourceType, len(paths)); err != nil {
+				return err
+			}
+			for _, path := range paths {
+				if _, err := fmt.Fprintf(w, "    %s\n", path); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, cleaner := range p.Unplanned {
+		if _, err := fmt.Fprintf(w, "Unplanned cleaner: %s\n", cleaner); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Total: %d\n", p.Count())
+	return err
+}
+
+// Plan walks the same cleanup steps as CleanWithOptions and returns the NSX resources which it would delete.
+// Nothing is deleted on NSX. The returned error types are the same as Clean except CleanupResourceFailed, which is
+// returned when failing to list the resources.
+func Plan(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger, debug bool, logLevel int, scope *Scope) (*CleanupPlan, error) {
+	if log == nil {
+		logg := logger.ZapCustomLogger(debug, logLevel).Logger
+		log = &logg
+	}
+
+	log.Info("Starting NSX cleanup plan")
+	cleanupService, err := initCleanupService(ctx, cf, log, scope)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := cleanupService.planCleanup(ctx)
+	if err != nil {
+		return nil, errors.Join(nsxutil.CleanupResourceFailed, err)
+	}
+	log.Info("Planned NSX cleanup", "resourceCount", plan.Count(), "unplannedCleaners", len(plan.Unplanned))
+	return plan, nil
+}
+
+// planCleanup collects the resources from the planners of the registered cleaners, following the steps in
+// cleanupVPCResources, cleanupInfraResources and cleanupHealthResources.
+func (c *CleanupService) planCleanup(ctx context.Context) (*CleanupPlan, error) {
+	plan := newCleanupPlan()
+	unplanned := sets.New[string]()
+
+	for _, cleaner := range c.vpcPreCleaners {
+		planner, ok := cleaner.(vpcPrePlanner)
+		if !ok {
+			unplanned.Insert(fmt.Sprintf("%T", cleaner))
+			continue
+		}
+		resources, err := planner.PlanCleanupBeforeVPCDeletion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		plan.BeforeVPCDeletion.merge(resources)
+	}
+
+	planVPCChildren := func(vpcPath string, into ResourcePaths) error {
+		for _, cleaner := range c.vpcChildrenCleaners {
+			planner, ok := cleaner.(vpcChildrenPlanner)
+			if !ok {
+				unplanned.Insert(fmt.Sprintf("%T", cleaner))
+				continue
+			}
+			resources, err := planner.PlanCleanupVPCChildResources(ctx, vpcPath)
+			if err != nil {
+				return err
+			}
+			into.merge(resources)
+		}
+		return nil
+	}
+	autoCreatedVPCs := c.listAutoCreatedVPCPaths()
+	for vpcPath := range autoCreatedVPCs {
+		children := ResourcePaths{common.ResourceTypeVpc: {vpcPath}}
+		if err := planVPCChildren(vpcPath, children); err != nil {
+			return nil, err
+		}
+		children.sort()
+		plan.AutoCreatedVPCs[vpcPath] = children
+	}
+	// The planners list the whole stores for the pre-created VPCs, while Clean has removed the children of the
+	// auto-created VPCs from the stores by then.
+	preCreatedVPCChildren := ResourcePaths{}
+	if err := planVPCChildren("", preCreatedVPCChildren); err != nil {
+		return nil, err
+	}
+	plan.PreCreatedVPCs.merge(preCreatedVPCChildren.excludeVPCs(autoCreatedVPCs))
+
+	for _, cleaner := range c.infraCleaners {
+		planner, ok := cleaner.(infraPlanner)
+		if !ok {
+			unplanned.Insert(fmt.Sprintf("%T", cleaner))
+			continue
+		}
+		resources, err := planner.PlanCleanupInfraResources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		plan.Infra.merge(resources)
+	}
+
+	for _, cleaner := range c.healthCleaners {
+		planner, ok := cleaner.(healthPlanner)
+		if !ok {
+			unplanned.Insert(fmt.Sprintf("%T", cleaner))
+			continue
+		}
+		resources, err := planner.PlanCleanupHealthResources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		plan.Health.merge(resources)
+	}
+
+	plan.BeforeVPCDeletion.sort()
+	plan.PreCreatedVPCs.sort()
+	plan.Infra.sort()
+	plan.Health.sort()
+	plan.Unplanned = sets.List(unplanned)
+	return plan, nil
+}
@@ -0,0 +1,156 @@
+package clean
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+)
+
+type mockPlanner struct {
+	MockCleanup
+	planErr error
+}
+
+func (m *mockPlanner) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		common.ResourceTypeSubnetPort: {"/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-2", "/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1"},
+	}, m.planErr
+}
+
+func (m *mockPlanner) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	if vpcPath == "" {
+		return map[string][]string{
+			common.ResourceTypeStaticRoutes: {"/orgs/default/projects/p1/vpcs/pre-vpc/static-routes/sr-1"},
+		}, nil
+	}
+	return map[string][]string{
+		common.ResourceTypeSubnet: {vpcPath + "/subnets/s1"},
+	}, nil
+}
+
+func (m *mockPlanner) PlanCleanupInfraResources(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		common.ResourceTypeShare: {"/infra/shares/share-1"},
+		common.ResourceTypeGroup: nil,
+	}, nil
+}
+
+func TestCleanupService_planCleanup(t *testing.T) {
+	vpcPath := "/orgs/default/projects/p1/vpcs/vpc-1"
+	newService := func(cleaners ...interface{}) *CleanupService {
+		cleanupService := &CleanupService{vpcService: &vpc.VPCService{}}
+		for _, cleaner := range cleaners {
+			cleanupService.AddCleanupService(func() (interface{}, error) {
+				return cleaner, nil
+			})
+		}
+		return cleanupService
+	}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&vpc.VPCService{}), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
+		return sets.New[string](vpcPath)
+	})
+	defer patches.Reset()
+
+	t.Run("planned", func(t *testing.T) {
+		cleaner := &mockPlanner{}
+		unplannedCleaner := &MockCleanup{}
+		plan, err := newService(cleaner, unplannedCleaner).planCleanup(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, ResourcePaths{
+			common.ResourceTypeSubnetPort: {"/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1", "/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-2"},
+		}, plan.BeforeVPCDeletion)
+		assert.Equal(t, map[string]ResourcePaths{
+			vpcPath: {
+				common.ResourceTypeVpc:    {vpcPath},
+				common.ResourceTypeSubnet: {vpcPath + "/subnets/s1"},
+			},
+		}, plan.AutoCreatedVPCs)
+		assert.Equal(t, ResourcePaths{
+			common.ResourceTypeStaticRoutes: {"/orgs/default/projects/p1/vpcs/pre-vpc/static-routes/sr-1"},
+		}, plan.PreCreatedVPCs)
+		assert.Equal(t, ResourcePaths{common.ResourceTypeShare: {"/infra/shares/share-1"}}, plan.Infra)
+		assert.Equal(t, []string{"*clean.MockCleanup"}, plan.Unplanned)
+		assert.Equal(t, 6, plan.Count())
+
+		// Nothing is deleted when planning.
+		assert.False(t, cleaner.vpcPreCleanupCalled)
+		assert.False(t, cleaner.vpcChildrenCleanupCalled)
+		assert.False(t, cleaner.infraCleanupCalled)
+		assert.False(t, unplannedCleaner.vpcPreCleanupCalled)
+	})
+
+	t.Run("populated store", func(t *testing.T) {
+		subnetService := &subnet.SubnetService{SubnetStore: &subnet.SubnetStore{ResourceStore: common.ResourceStore{
+			Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+				return *obj.(*model.VpcSubnet).Path, nil
+			}, cache.Indexers{common.IndexByVPCPathFuncKey: common.IndexByVPCFunc}),
+			BindingType: model.VpcSubnetBindingType(),
+		}}}
+		for _, parentPath := range []string{vpcPath, "/orgs/default/projects/p1/vpcs/pre-vpc"} {
+			require.NoError(t, subnetService.SubnetStore.Add(&model.VpcSubnet{
+				Path:       common.String(parentPath + "/subnets/s1"),
+				ParentPath: common.String(parentPath),
+			}))
+		}
+
+		plan, err := newService(subnetService).planCleanup(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]ResourcePaths{
+			vpcPath: {
+				common.ResourceTypeVpc:    {vpcPath},
+				common.ResourceTypeSubnet: {vpcPath + "/subnets/s1"},
+			},
+		}, plan.AutoCreatedVPCs)
+		// The Subnet of the auto-created VPC is not counted again in the pre-created VPCs.
+		assert.Equal(t, ResourcePaths{
+			common.ResourceTypeSubnet: {"/orgs/default/projects/p1/vpcs/pre-vpc/subnets/s1"},
+		}, plan.PreCreatedVPCs)
+		assert.Equal(t, 3, plan.Count())
+	})
+
+	t.Run("planner error", func(t *testing.T) {
+		_, err := newService(&mockPlanner{planErr: errors.New("list failed")}).planCleanup(context.Background())
+		assert.ErrorContains(t, err, "list failed")
+	})
+}
+
+func TestCleanupPlan_WriteText(t *testing.T) {
+	plan := newCleanupPlan()
+	plan.BeforeVPCDeletion.merge(map[string][]string{common.ResourceTypeSubnetPort: {"/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1"}})
+	plan.AutoCreatedVPCs["/orgs/default/projects/p1/vpcs/vpc-1"] = ResourcePaths{common.ResourceTypeVpc: {"/orgs/default/projects/p1/vpcs/vpc-1"}}
+	plan.Infra.merge(map[string][]string{common.ResourceTypeShare: {"/infra/shares/share-1"}})
+	plan.Unplanned = []string{"*clean.MockCleanup"}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, plan.WriteText(buf))
+	expected := `Before VPC deletion: 1
+  VpcSubnetPort: 1
+    /orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1
+Auto-created VPC /orgs/default/projects/p1/vpcs/vpc-1 (deleted recursively): 1
+  Vpc: 1
+    /orgs/default/projects/p1/vpcs/vpc-1
+Pre-created VPCs: 0
+Infra: 1
+  Share: 1
+    /infra/shares/share-1
+Health: 0
+Unplanned cleaner: *clean.MockCleanup
+Total: 3
+`
+	assert.Equal(t, expected, buf.String())
+}
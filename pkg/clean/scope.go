@@ -0,0 +1,111 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package clean
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// The resource kinds which can be selected in Scope.ResourceKinds, each kind maps to one cleaner.
+const (
+	ResourceKindSubnetPort          = "subnetports"
+	ResourceKindSubnetBinding       = "subnetbindings"
+	ResourceKindSubnetIPReservation = "subnetipreservations"
+	ResourceKindSubnet              = "subnets"
+	ResourceKindSecurityPolicy      = "securitypolicies"
+	ResourceKindStaticRoute         = "staticroutes"
+	ResourceKindVPC                 = "vpcs"
+	ResourceKindIPAddressAllocation = "ipaddressallocations"
+	ResourceKindDNSRecord           = "dnsrecords"
+	ResourceKindInventory           = "inventory"
+	ResourceKindLBInfra             = "lbinfra"
+	ResourceKindHealth              = "health"
+	ResourceKindNSXServiceAccount   = "nsxserviceaccounts"
+)
+
+var (
+	resourceKinds = sets.New[string](
+		ResourceKindSubnetPort, ResourceKindSubnetBinding, ResourceKindSubnetIPReservation, ResourceKindSubnet,
+		ResourceKindSecurityPolicy, ResourceKindStaticRoute, ResourceKindVPC, ResourceKindIPAddressAllocation,
+		ResourceKindDNSRecord, ResourceKindInventory, ResourceKindLBInfra, ResourceKindHealth, ResourceKindNSXServiceAccount,
+	)
+	// clusterResourceKinds are the kinds shared by the whole cluster, they are not selected by namespaces or VPC paths.
+	clusterResourceKinds = sets.New[string](ResourceKindInventory, ResourceKindLBInfra, ResourceKindHealth, ResourceKindNSXServiceAccount)
+)
+
+// Scope selects a subset of the NSX resources created for the cluster to clean up. A nil Scope selects all of them.
+type Scope struct {
+	// Namespaces selects the resources created for the namespaces, including the auto-created VPCs of the namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// VPCPaths selects the VPCs and the resources under them. The pre-created VPCs are never deleted.
+	VPCPaths []string `json:"vpcPaths,omitempty"`
+	// ResourceKinds selects the kinds of the resources to clean up, all the kinds are selected if it is empty. The
+	// auto-created VPCs are deleted only if ResourceKindVPC is selected. The cluster level kinds, i.e.
+	// ResourceKindInventory, ResourceKindLBInfra, ResourceKindHealth and ResourceKindNSXServiceAccount, are selected
+	// with Namespaces or VPCPaths only if they are listed explicitly, and they are not filtered by Namespaces or VPCPaths.
+	ResourceKinds []string `json:"resourceKinds,omitempty"`
+}
+
+// Validate checks the resource kinds in the scope.
+func (s *Scope) Validate() error {
+	if s == nil {
+		return nil
+	}
+	for _, kind := range s.ResourceKinds {
+		if !resourceKinds.Has(kind) {
+			return fmt.Errorf("unsupported resource kind %q, supported kinds: %v", kind, sets.List(resourceKinds))
+		}
+	}
+	return nil
+}
+
+// cleanupFilter returns the filter of the resources by Namespaces and VPCPaths, or nil if all resources are selected.
+func (s *Scope) cleanupFilter() *common.CleanupFilter {
+	if s == nil || (len(s.Namespaces) == 0 && len(s.VPCPaths) == 0) {
+		return nil
+	}
+	return common.NewCleanupFilter(s.VPCPaths, s.Namespaces)
+}
+
+// selects returns true if the cleaner of the resource kind should run in the scope.
+func (s *Scope) selects(kind string) bool {
+	if s == nil {
+		return true
+	}
+	if len(s.ResourceKinds) > 0 {
+		return slices.Contains(s.ResourceKinds, kind)
+	}
+	return s.cleanupFilter() == nil || !clusterResourceKinds.Has(kind)
+}
+
+// resolveScopedVPCs adds the VPCs tagged with the selected namespaces to the filter, so that the resources under these
+// VPCs are selected even if they are not tagged with the namespace.
+func (c *CleanupService) resolveScopedVPCs() {
+	if c.filter == nil {
+		return
+	}
+	for _, vpc := range c.vpcService.ListVPC() {
+		if vpc.Path != nil && c.filter.Match(&vpc) {
+			c.filter.VPCPaths.Insert(*vpc.Path)
+		}
+	}
+	c.log.Info("Resolved the VPCs in the cleanup scope", "vpcPaths", sets.List(c.filter.VPCPaths), "namespaces", sets.List(c.filter.Namespaces))
+}
+
+// listAutoCreatedVPCPaths returns the auto-created VPCs to delete in the scope.
+func (c *CleanupService) listAutoCreatedVPCPaths() sets.Set[string] {
+	if !c.scope.selects(ResourceKindVPC) {
+		return sets.New[string]()
+	}
+	vpcPaths := c.vpcService.ListAutoCreatedVPCPaths()
+	if c.filter == nil {
+		return vpcPaths
+	}
+	return vpcPaths.Intersection(c.filter.VPCPaths)
+}
@@ -0,0 +1,77 @@
+package clean
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+)
+
+func TestScope_Validate(t *testing.T) {
+	var nilScope *Scope
+	assert.NoError(t, nilScope.Validate())
+	assert.NoError(t, (&Scope{ResourceKinds: []string{ResourceKindSubnetPort, ResourceKindLBInfra}}).Validate())
+	assert.ErrorContains(t, (&Scope{ResourceKinds: []string{"pods"}}).Validate(), "unsupported resource kind \"pods\"")
+}
+
+func TestScope_selects(t *testing.T) {
+	var nilScope *Scope
+	assert.True(t, nilScope.selects(ResourceKindHealth))
+	assert.Nil(t, nilScope.cleanupFilter())
+
+	kindScope := &Scope{ResourceKinds: []string{ResourceKindStaticRoute}}
+	assert.True(t, kindScope.selects(ResourceKindStaticRoute))
+	assert.False(t, kindScope.selects(ResourceKindVPC))
+	assert.Nil(t, kindScope.cleanupFilter())
+
+	nsScope := &Scope{Namespaces: []string{"ns-1"}}
+	assert.True(t, nsScope.selects(ResourceKindSubnetPort))
+	assert.True(t, nsScope.selects(ResourceKindVPC))
+	assert.False(t, nsScope.selects(ResourceKindInventory))
+	assert.False(t, nsScope.selects(ResourceKindHealth))
+	assert.NotNil(t, nsScope.cleanupFilter())
+
+	explicitScope := &Scope{VPCPaths: []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, ResourceKinds: []string{ResourceKindLBInfra}}
+	assert.True(t, explicitScope.selects(ResourceKindLBInfra))
+	assert.False(t, explicitScope.selects(ResourceKindSubnetPort))
+}
+
+func TestCleanupService_listAutoCreatedVPCPaths(t *testing.T) {
+	vpc1 := "/orgs/default/projects/p1/vpcs/vpc-1"
+	vpc2 := "/orgs/default/projects/p1/vpcs/vpc-2"
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&vpc.VPCService{}), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
+		return sets.New[string](vpc1, vpc2)
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(&vpc.VPCService{}), "ListVPC", func(_ *vpc.VPCService) []model.Vpc {
+		return []model.Vpc{
+			{Path: &vpc1, Tags: []model.Tag{{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")}}},
+			{Path: &vpc2, Tags: []model.Tag{{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-2")}}},
+		}
+	})
+
+	log := logr.Discard()
+	for _, tc := range []struct {
+		name     string
+		scope    *Scope
+		expected []string
+	}{
+		{name: "all", scope: nil, expected: []string{vpc1, vpc2}},
+		{name: "namespace", scope: &Scope{Namespaces: []string{"ns-2"}}, expected: []string{vpc2}},
+		{name: "vpc path", scope: &Scope{VPCPaths: []string{vpc1, "/orgs/default/projects/p1/vpcs/pre-created"}}, expected: []string{vpc1}},
+		{name: "vpc not selected", scope: &Scope{Namespaces: []string{"ns-1"}, ResourceKinds: []string{ResourceKindSubnetPort}}, expected: []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cleanupService := &CleanupService{log: &log, vpcService: &vpc.VPCService{}, scope: tc.scope, filter: tc.scope.cleanupFilter()}
+			cleanupService.resolveScopedVPCs()
+			assert.ElementsMatch(t, tc.expected, sets.List(cleanupService.listAutoCreatedVPCPaths()))
+		})
+	}
+}
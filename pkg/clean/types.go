@@ -35,4 +35,28 @@ type healthCleaner interface {
 	CleanupHealthResources(ctx context.Context) error
 }
 
+// The planners below are implemented by the cleaners to report the NSX resources they would delete, without changing
+// anything on NSX or in the local cache. The returned map is keyed by the NSX resource type, and the values are the
+// NSX policy paths (or API paths for the non-policy resources).
+
+type vpcPrePlanner interface {
+	// PlanCleanupBeforeVPCDeletion returns the resources which CleanupBeforeVPCDeletion would delete.
+	PlanCleanupBeforeVPCDeletion(ctx context.Context) (map[string][]string, error)
+}
+
+type vpcChildrenPlanner interface {
+	// PlanCleanupVPCChildResources returns the resources which CleanupVPCChildResources would delete with the same vpcPath.
+	PlanCleanupVPCChildResources(ctx context.Context, vpcPath string) (map[string][]string, error)
+}
+
+type infraPlanner interface {
+	// PlanCleanupInfraResources returns the resources which CleanupInfraResources would delete.
+	PlanCleanupInfraResources(ctx context.Context) (map[string][]string, error)
+}
+
+type healthPlanner interface {
+	// PlanCleanupHealthResources returns the resources which CleanupHealthResources would delete.
+	PlanCleanupHealthResources(ctx context.Context) (map[string][]string, error)
+}
+
 type cleanupFunc func() (interface{}, error)
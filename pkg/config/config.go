@@ -19,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth/jwt"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 )
 
 // TODO replace to yaml
@@ -137,6 +138,23 @@ type NsxConfig struct {
 	RestoreVif *bool `ini:"restore_vif"`
 	// TnIdCheckInterval is the interval in seconds to check TN ID for node.
 	TnIdCheckInterval int `ini:"tn_id_check_interval"`
+	// APIRateMode is the rate limiter type of NSX API calls, AIMD(default), FIXRATE or TOKENBUCKET.
+	APIRateMode string `ini:"api_rate_mode"`
+	// APIRateLimitPerEndpoint is the max API rate per second, it is either one value for all the NSX managers or one
+	// value per NSX manager in NsxApiManagers. 0 disables the rate limiter, default is 100 which is also the upper bound.
+	APIRateLimitPerEndpoint []int `ini:"api_rate_limit_per_endpoint"`
+	// APIRateAdjustPeriod is the period in seconds to adjust the rate of AIMD rate limiter, default is 1.
+	APIRateAdjustPeriod float64 `ini:"api_rate_adjust_period"`
+	// APIRateIncreaseStep is added to the rate of AIMD rate limiter if the calls were blocked in a period, default is 1.
+	APIRateIncreaseStep int `ini:"api_rate_increase_step"`
+	// APIRateDecreaseFactor is multiplied by the rate of AIMD rate limiter if NSX returned 429 or 503 in a period, it
+	// should be in (0, 1), default is 0.5.
+	APIRateDecreaseFactor float64 `ini:"api_rate_decrease_factor"`
+	// APIRateBurst is the bucket size of TOKENBUCKET rate limiter, default is 10.
+	APIRateBurst int `ini:"api_rate_burst"`
+	// DNSTXTOwnerRecord creates a TXT ownership record for each DNS record, a DNS name owned by another cluster in a
+	// shared DNS zone is not overwritten.
+	DNSTXTOwnerRecord bool `ini:"dns_txt_owner_record"`
 }
 
 type K8sConfig struct {
@@ -152,6 +170,28 @@ type K8sConfig struct {
 	// Expected values: "IPv4" (default), "IPv6", or "DualStack".
 	// Use GetIPAddressType() to obtain the canonical v1alpha1.IPAddressType.
 	IPFamily string `ini:"ip_family"`
+	// DriftAuditInterval is the interval in seconds to audit the NSX resources in the stores against NSX, 0 disables
+	// the audit.
+	DriftAuditInterval int `ini:"drift_audit_interval"`
+	// DriftAuditRequeue requeues the CR owning a drifted NSX resource to repair it, the drifts are only reported if
+	// it is false.
+	DriftAuditRequeue bool `ini:"drift_audit_requeue"`
+	// OrphanGCInterval is the interval in seconds to collect the NSX resources whose owner K8s objects no longer exist,
+	// 0 disables the collection.
+	OrphanGCInterval int `ini:"orphan_gc_interval"`
+	// OrphanGCDelete deletes the orphan NSX resources, the orphans are only reported if it is false.
+	OrphanGCDelete bool `ini:"orphan_gc_delete"`
+	// OrphanGCGracePeriod is the time in seconds an NSX resource must stay orphan before it is deleted, default is 3600.
+	OrphanGCGracePeriod int `ini:"orphan_gc_grace_period"`
+	// NamedPortRuleGrouping translates a SecurityPolicy rule with named ports to one NSX rule per group of pods
+	// resolving the named ports to the same port numbers, instead of one NSX rule per resolved port number.
+	NamedPortRuleGrouping bool `ini:"named_port_rule_grouping"`
+	// SubnetSetAutoScaleThreshold is the IPv4 utilization in percent of the Subnets of a SubnetSet to pre-create a new
+	// Subnet for it, 0 disables the auto-scaling and a Subnet is only created when the existing ones are exhausted.
+	SubnetSetAutoScaleThreshold int `ini:"subnetset_autoscale_threshold"`
+	// SubnetSetAutoScaleCoolDown is the time in seconds an empty Subnet of a SubnetSet is kept before it is reclaimed
+	// when the auto-scaling is enabled, default is 600.
+	SubnetSetAutoScaleCoolDown int `ini:"subnetset_autoscale_cool_down"`
 }
 
 // GetIPAddressType parses the raw IPFamily string and returns the canonical
@@ -456,6 +496,63 @@ func (nsxConfig *NsxConfig) RestoreVifEnabled() bool {
 	return *nsxConfig.RestoreVif
 }
 
+// GetAPIRateMode returns the rate limiter type, it falls back to AIMD if APIRateMode is invalid.
+func (nsxConfig *NsxConfig) GetAPIRateMode() ratelimiter.Type {
+	rateMode, _ := ratelimiter.ParseType(nsxConfig.APIRateMode)
+	return rateMode
+}
+
+// GetRateLimiterConfigs returns the rate limiter config for each value of APIRateLimitPerEndpoint, or one config with
+// the default max rate if APIRateLimitPerEndpoint is not set.
+func (nsxConfig *NsxConfig) GetRateLimiterConfigs() []ratelimiter.Config {
+	maxRates := nsxConfig.APIRateLimitPerEndpoint
+	if len(maxRates) == 0 {
+		maxRates = []int{ratelimiter.MAXRATELIMIT}
+	}
+	configs := make([]ratelimiter.Config, 0, len(maxRates))
+	for _, maxRate := range maxRates {
+		configs = append(configs, ratelimiter.Config{
+			Type:           nsxConfig.GetAPIRateMode(),
+			MaxRate:        maxRate,
+			Period:         nsxConfig.APIRateAdjustPeriod,
+			IncreaseStep:   nsxConfig.APIRateIncreaseStep,
+			DecreaseFactor: nsxConfig.APIRateDecreaseFactor,
+			Burst:          nsxConfig.APIRateBurst,
+		})
+	}
+	return configs
+}
+
+func (nsxConfig *NsxConfig) validateRateLimiter() error {
+	if _, err := ratelimiter.ParseType(nsxConfig.APIRateMode); err != nil {
+		configLog.Error(err, "Validate NsxConfig failed")
+		return err
+	}
+	if count := len(nsxConfig.APIRateLimitPerEndpoint); count > 1 && count != len(nsxConfig.NsxApiManagers) {
+		err := errors.New("api_rate_limit_per_endpoint count not match manager count")
+		configLog.Error(err, "Validate NsxConfig failed", "rate limit count", count, "manager count", len(nsxConfig.NsxApiManagers))
+		return err
+	}
+	for _, maxRate := range nsxConfig.APIRateLimitPerEndpoint {
+		if maxRate < 0 {
+			err := fmt.Errorf("invalid api_rate_limit_per_endpoint %d", maxRate)
+			configLog.Error(err, "Validate NsxConfig failed")
+			return err
+		}
+	}
+	if nsxConfig.APIRateAdjustPeriod < 0 || nsxConfig.APIRateIncreaseStep < 0 || nsxConfig.APIRateBurst < 0 {
+		err := fmt.Errorf("invalid api_rate_adjust_period %v, api_rate_increase_step %d or api_rate_burst %d", nsxConfig.APIRateAdjustPeriod, nsxConfig.APIRateIncreaseStep, nsxConfig.APIRateBurst)
+		configLog.Error(err, "Validate NsxConfig failed")
+		return err
+	}
+	if nsxConfig.APIRateDecreaseFactor < 0 || nsxConfig.APIRateDecreaseFactor >= 1 {
+		err := fmt.Errorf("invalid api_rate_decrease_factor %v, it should be in (0, 1)", nsxConfig.APIRateDecreaseFactor)
+		configLog.Error(err, "Validate NsxConfig failed")
+		return err
+	}
+	return nil
+}
+
 func (nsxConfig *NsxConfig) validate(enableVPC bool) error {
 	nsxConfig.NsxApiManagers = removeEmptyItem(nsxConfig.NsxApiManagers)
 	mCount := len(nsxConfig.NsxApiManagers)
@@ -467,6 +564,9 @@ func (nsxConfig *NsxConfig) validate(enableVPC bool) error {
 	if err := nsxConfig.validateCert(); err != nil {
 		return err
 	}
+	if err := nsxConfig.validateRateLimiter(); err != nil {
+		return err
+	}
 	return nil
 }
 
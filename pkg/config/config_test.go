@@ -11,6 +11,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 )
 
 func TestConfig_VCConfig(t *testing.T) {
@@ -238,3 +240,57 @@ func TestNsxConfig_GetServiceSize(t *testing.T) {
 		})
 	}
 }
+
+func TestNsxConfig_RateLimiter(t *testing.T) {
+	nsxConfig := &NsxConfig{NsxApiManagers: []string{"10.0.0.1", "10.0.0.2"}, Insecure: true}
+	assert.NoError(t, nsxConfig.validate(false))
+	assert.Equal(t, ratelimiter.AIMD, nsxConfig.GetAPIRateMode())
+	assert.Equal(t, []ratelimiter.Config{{Type: ratelimiter.AIMD, MaxRate: ratelimiter.MAXRATELIMIT}}, nsxConfig.GetRateLimiterConfigs())
+
+	nsxConfig.APIRateMode = "fixrate"
+	nsxConfig.APIRateLimitPerEndpoint = []int{20, 50}
+	assert.NoError(t, nsxConfig.validate(false))
+	assert.Equal(t, []ratelimiter.Config{
+		{Type: ratelimiter.FIXRATE, MaxRate: 20},
+		{Type: ratelimiter.FIXRATE, MaxRate: 50},
+	}, nsxConfig.GetRateLimiterConfigs())
+
+	nsxConfig.APIRateMode = "TokenBucket"
+	nsxConfig.APIRateBurst = 20
+	assert.NoError(t, nsxConfig.validate(false))
+	assert.Equal(t, []ratelimiter.Config{
+		{Type: ratelimiter.TOKENBUCKET, MaxRate: 20, Burst: 20},
+		{Type: ratelimiter.TOKENBUCKET, MaxRate: 50, Burst: 20},
+	}, nsxConfig.GetRateLimiterConfigs())
+
+	nsxConfig.APIRateMode = "AIMD"
+	nsxConfig.APIRateBurst = 0
+	nsxConfig.APIRateLimitPerEndpoint = []int{40}
+	nsxConfig.APIRateAdjustPeriod = 2
+	nsxConfig.APIRateIncreaseStep = 5
+	nsxConfig.APIRateDecreaseFactor = 0.8
+	assert.NoError(t, nsxConfig.validate(false))
+	assert.Equal(t, []ratelimiter.Config{
+		{Type: ratelimiter.AIMD, MaxRate: 40, Period: 2, IncreaseStep: 5, DecreaseFactor: 0.8},
+	}, nsxConfig.GetRateLimiterConfigs())
+
+	tests := []struct {
+		name   string
+		modify func(c *NsxConfig)
+		err    string
+	}{
+		{name: "invalid mode", modify: func(c *NsxConfig) { c.APIRateMode = "token" }, err: "invalid rate limiter type"},
+		{name: "count mismatch", modify: func(c *NsxConfig) { c.APIRateLimitPerEndpoint = []int{1, 2, 3} }, err: "count not match manager count"},
+		{name: "negative rate", modify: func(c *NsxConfig) { c.APIRateLimitPerEndpoint = []int{-1} }, err: "invalid api_rate_limit_per_endpoint"},
+		{name: "negative period", modify: func(c *NsxConfig) { c.APIRateAdjustPeriod = -1 }, err: "invalid api_rate_adjust_period"},
+		{name: "negative burst", modify: func(c *NsxConfig) { c.APIRateBurst = -1 }, err: "api_rate_burst -1"},
+		{name: "invalid factor", modify: func(c *NsxConfig) { c.APIRateDecreaseFactor = 1 }, err: "invalid api_rate_decrease_factor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &NsxConfig{NsxApiManagers: []string{"10.0.0.1", "10.0.0.2"}, Insecure: true}
+			tt.modify(c)
+			assert.ErrorContains(t, c.validate(false), tt.err)
+		})
+	}
+}
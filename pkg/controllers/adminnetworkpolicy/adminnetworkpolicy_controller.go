@@ -0,0 +1,290 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	ResultRequeue = common.ResultRequeue
+	MetricResType = common.MetricResTypeAdminNetworkPolicy
+)
+
+const (
+	// ConditionTypeReady is the condition type reporting if the NSX SecurityPolicies of the policy are realized.
+	ConditionTypeReady = "Ready"
+
+	reasonSecurityPolicyReady    = "SecurityPolicyReady"
+	reasonSecurityPolicyNotReady = "SecurityPolicyNotReady"
+)
+
+// AdminNetworkPolicyReconciler reconciles an AdminNetworkPolicy object, the policy is translated to the NSX
+// SecurityPolicies in the Environment category of the VPCs of the subject namespaces.
+type AdminNetworkPolicyReconciler struct {
+	Client        client.Client
+	Scheme        *apimachineryruntime.Scheme
+	Service       *securitypolicy.SecurityPolicyService
+	Recorder      record.EventRecorder
+	StatusUpdater common.StatusUpdater
+}
+
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies/status,verbs=get;update;patch
+func (r *AdminNetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	anp := &policyv1alpha1.AdminNetworkPolicy{}
+	log.Info("Reconciling AdminNetworkPolicy", "adminnetworkpolicy", req.Name)
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling AdminNetworkPolicy", "adminnetworkpolicy", req.Name, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, anp); err != nil {
+		if apierrors.IsNotFound(err) {
+			if err := deleteAdminNetworkPolicyByName(r.Service, req.Name, servicecommon.ResourceTypeAdminNetworkPolicy); err != nil {
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return ResultRequeue, err
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch AdminNetworkPolicy", "req", req.NamespacedName)
+		return ResultRequeue, err
+	}
+
+	if !anp.DeletionTimestamp.IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.Service.DeleteAdminNetworkPolicy(anp.UID, false, servicecommon.ResourceTypeAdminNetworkPolicy); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, anp, err)
+			return ResultRequeue, err
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, anp)
+		return ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	if err := r.Service.CreateOrUpdateSecurityPolicy(anp); err != nil {
+		r.StatusUpdater.UpdateFail(ctx, anp, err, "", setReadyStatusFalse)
+		if errors.As(err, &nsxutil.RestrictionError{}) {
+			return ResultNormal, nil
+		}
+		return ResultRequeue, err
+	}
+	r.StatusUpdater.UpdateSuccess(ctx, anp, setReadyStatusTrue)
+	return ResultNormal, nil
+}
+
+// deleteAdminNetworkPolicyByName deletes the NSX resources of the deleted AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy whose UID is only known from the NSX resources.
+func deleteAdminNetworkPolicyByName(service *securitypolicy.SecurityPolicyService, name, createdFor string) error {
+	for uid := range service.ListAdminNetworkPolicyUIDByName(name, createdFor) {
+		log.Info("Deleting AdminNetworkPolicy", "createdFor", createdFor, "name", name, "uid", uid)
+		if err := service.DeleteAdminNetworkPolicy(types.UID(uid), false, createdFor); err != nil {
+			log.Error(err, "Failed to delete AdminNetworkPolicy", "createdFor", createdFor, "name", name, "uid", uid)
+			return err
+		}
+	}
+	return nil
+}
+
+func setReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, _ ...interface{}) {
+	updateReadyCondition(client, ctx, obj, metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonSecurityPolicyReady,
+		Message:            "NSX Security Policies have been successfully created/updated",
+		LastTransitionTime: transitionTime,
+	})
+}
+
+func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, _ ...interface{}) {
+	updateReadyCondition(client, ctx, obj, metav1.Condition{
+		Type:               ConditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reasonSecurityPolicyNotReady,
+		Message:            fmt.Sprintf("error occurred while processing the policy. Error: %v", err),
+		LastTransitionTime: transitionTime,
+	})
+}
+
+func updateReadyCondition(client client.Client, ctx context.Context, obj client.Object, condition metav1.Condition) {
+	var conditions *[]metav1.Condition
+	switch o := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		conditions = &o.Status.Conditions
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		conditions = &o.Status.Conditions
+	default:
+		return
+	}
+	condition.ObservedGeneration = obj.GetGeneration()
+	if !meta.SetStatusCondition(conditions, condition) {
+		return
+	}
+	if err := client.Status().Update(ctx, obj); err != nil {
+		log.Error(err, "Failed to update the policy status", "name", obj.GetName())
+		return
+	}
+	log.Info("Updated the policy status", "name", obj.GetName(), "condition", condition)
+}
+
+// enqueueAdminNetworkPolicies requeues all the AdminNetworkPolicies when the namespaces which may be selected by
+// their subjects change.
+func (r *AdminNetworkPolicyReconciler) enqueueAdminNetworkPolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	anpList := &policyv1alpha1.AdminNetworkPolicyList{}
+	if err := r.Client.List(ctx, anpList); err != nil {
+		log.Error(err, "Failed to list AdminNetworkPolicies")
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, anp := range anpList.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: anp.Name}})
+	}
+	return reqs
+}
+
+func (r *AdminNetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&policyv1alpha1.AdminNetworkPolicy{}).
+		Watches(
+			&v1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueAdminNetworkPolicies),
+			builder.WithPredicates(PredicateFuncsNs),
+		).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueAdminNetworkPolicies),
+			builder.WithPredicates(PredicateFuncsNetworkInfo),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
+			}).
+		Complete(r)
+}
+
+func (r *AdminNetworkPolicyReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *AdminNetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "AdminNetworkPolicy")
+		return err
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	return nil
+}
+
+// CollectGarbage deletes the NSX resources of the AdminNetworkPolicies and BaselineAdminNetworkPolicies which have
+// been removed from K8s, both kinds are collected here as their NSX resources share the same UID tag.
+func (r *AdminNetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("AdminNetworkPolicy garbage collector started")
+	nsxPolicySet := r.Service.ListAdminNetworkPolicyID()
+	if len(nsxPolicySet) == 0 {
+		return nil
+	}
+
+	crPolicySet := sets.New[string]()
+	anpList := &policyv1alpha1.AdminNetworkPolicyList{}
+	if err := r.Client.List(ctx, anpList); err != nil {
+		log.Error(err, "Failed to list AdminNetworkPolicies")
+		return err
+	}
+	for _, anp := range anpList.Items {
+		crPolicySet.Insert(string(anp.UID))
+	}
+	banpList := &policyv1alpha1.BaselineAdminNetworkPolicyList{}
+	if err := r.Client.List(ctx, banpList); err != nil {
+		log.Error(err, "Failed to list BaselineAdminNetworkPolicies")
+		return err
+	}
+	for _, banp := range banpList.Items {
+		crPolicySet.Insert(string(banp.UID))
+	}
+
+	var errList []error
+	for uid := range nsxPolicySet.Difference(crPolicySet) {
+		log.Debug("GC collected AdminNetworkPolicy", "UID", uid)
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.Service.DeleteAdminNetworkPolicy(types.UID(uid), true, servicecommon.ResourceTypeAdminNetworkPolicy); err != nil {
+			errList = append(errList, err)
+			r.StatusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			r.StatusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in AdminNetworkPolicy garbage collection: %s", errList)
+	}
+	return nil
+}
+
+// isKindInstalled checks if the CRD of the network policy API kind is installed in the cluster.
+func isKindInstalled(mgr ctrl.Manager, gvk schema.GroupVersionKind) bool {
+	if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		log.Info("Network policy API kind is not installed, skipping its controller", "kind", gvk.Kind, "version", gvk.Version, "error", err)
+		return false
+	}
+	return true
+}
+
+// NewAdminNetworkPolicyReconcilers returns the reconcilers of the AdminNetworkPolicies and the
+// BaselineAdminNetworkPolicies, it returns nil if their CRDs are not installed.
+func NewAdminNetworkPolicyReconcilers(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) []pkgutil.ReconcilerProvider {
+	if !isKindInstalled(mgr, policyv1alpha1.SchemeGroupVersion.WithKind("AdminNetworkPolicy")) ||
+		!isKindInstalled(mgr, policyv1alpha1.SchemeGroupVersion.WithKind("BaselineAdminNetworkPolicy")) {
+		return nil
+	}
+	service := securitypolicy.GetSecurityService(commonService, vpcService)
+	anpReconciler := &AdminNetworkPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Service:  service,
+		Recorder: mgr.GetEventRecorderFor("adminnetworkpolicy-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+	anpReconciler.StatusUpdater = common.NewStatusUpdater(anpReconciler.Client, service.NSXConfig, anpReconciler.Recorder, MetricResType, "SecurityPolicy", "AdminNetworkPolicy")
+	banpReconciler := &BaselineAdminNetworkPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Service:  service,
+		Recorder: mgr.GetEventRecorderFor("baselineadminnetworkpolicy-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+	banpReconciler.StatusUpdater = common.NewStatusUpdater(banpReconciler.Client, service.NSXConfig, banpReconciler.Recorder, MetricResTypeBaseline, "SecurityPolicy", "BaselineAdminNetworkPolicy")
+	return []pkgutil.ReconcilerProvider{anpReconciler, banpReconciler}
+}
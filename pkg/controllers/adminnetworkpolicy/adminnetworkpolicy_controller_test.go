@@ -0,0 +1,267 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func fakeService() *securitypolicy.SecurityPolicyService {
+	return &securitypolicy.SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster:          "k8scl-one:test",
+					EnableVPCNetwork: true,
+				},
+			},
+		},
+	}
+}
+
+func newFakeClient(objs []client.Object) client.Client {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(policyv1alpha1.AddToScheme(newScheme))
+	return fake.NewClientBuilder().WithScheme(newScheme).WithObjects(objs...).
+		WithStatusSubresource(&policyv1alpha1.AdminNetworkPolicy{}, &policyv1alpha1.BaselineAdminNetworkPolicy{}).Build()
+}
+
+func createFakeAdminNetworkPolicyReconciler(objs []client.Object) *AdminNetworkPolicyReconciler {
+	r := &AdminNetworkPolicyReconciler{
+		Client:   newFakeClient(objs),
+		Service:  fakeService(),
+		Recorder: fakeRecorder{},
+	}
+	r.StatusUpdater = ctrcommon.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, MetricResType, "SecurityPolicy", "AdminNetworkPolicy")
+	return r
+}
+
+func createFakeBaselineAdminNetworkPolicyReconciler(objs []client.Object) *BaselineAdminNetworkPolicyReconciler {
+	r := &BaselineAdminNetworkPolicyReconciler{
+		Client:   newFakeClient(objs),
+		Service:  fakeService(),
+		Recorder: fakeRecorder{},
+	}
+	r.StatusUpdater = ctrcommon.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, MetricResTypeBaseline, "SecurityPolicy", "BaselineAdminNetworkPolicy")
+	return r
+}
+
+func TestAdminNetworkPolicyReconciler_Reconcile(t *testing.T) {
+	anpName := "test-anp"
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: anpName}}
+	createANP := func(deleting bool) *policyv1alpha1.AdminNetworkPolicy {
+		anp := &policyv1alpha1.AdminNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: anpName, UID: "anp-uid", Generation: 2},
+			Spec:       policyv1alpha1.AdminNetworkPolicySpec{Priority: 10},
+		}
+		if deleting {
+			anp.Finalizers = []string{"test-Finalizers"}
+			anp.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		return anp
+	}
+
+	testCases := []struct {
+		name            string
+		existingANP     *policyv1alpha1.AdminNetworkPolicy
+		patches         func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches
+		expectRes       ctrl.Result
+		expectErrStr    string
+		expectCondition *metav1.Condition
+	}{
+		{
+			name: "AdminNetworkPolicy not found",
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "ListAdminNetworkPolicyUIDByName", func(_ *securitypolicy.SecurityPolicyService, name, createdFor string) sets.Set[string] {
+					assert.Equal(t, common.ResourceTypeAdminNetworkPolicy, createdFor)
+					return sets.New[string]("anp-uid")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+					assert.Equal(t, types.UID("anp-uid"), uid)
+					return nil
+				})
+				return patches
+			},
+			expectRes: ResultNormal,
+		},
+		{
+			name:        "AdminNetworkPolicy deleting and delete fail",
+			existingANP: createANP(true),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+					return errors.New("delete failed")
+				})
+			},
+			expectRes:    ResultRequeue,
+			expectErrStr: "delete failed",
+		},
+		{
+			name:        "AdminNetworkPolicy create/update success",
+			existingANP: createANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+					return nil
+				})
+			},
+			expectRes:       ResultNormal,
+			expectCondition: &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionTrue, Reason: reasonSecurityPolicyReady, ObservedGeneration: 2},
+		},
+		{
+			name:        "AdminNetworkPolicy create/update fail",
+			existingANP: createANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+					return errors.New("create failed")
+				})
+			},
+			expectRes:       ResultRequeue,
+			expectErrStr:    "create failed",
+			expectCondition: &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: reasonSecurityPolicyNotReady, ObservedGeneration: 2},
+		},
+		{
+			name:        "AdminNetworkPolicy create/update restriction error",
+			existingANP: createANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+					return nsxutil.RestrictionError{Desc: "restricted"}
+				})
+			},
+			expectRes:       ResultNormal,
+			expectCondition: &metav1.Condition{Type: ConditionTypeReady, Status: metav1.ConditionFalse, Reason: reasonSecurityPolicyNotReady, ObservedGeneration: 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var objs []client.Object
+			if tc.existingANP != nil {
+				objs = append(objs, tc.existingANP)
+			}
+			r := createFakeAdminNetworkPolicyReconciler(objs)
+			patches := tc.patches(r)
+			defer patches.Reset()
+
+			ctx := context.Background()
+			res, err := r.Reconcile(ctx, req)
+			if tc.expectErrStr != "" {
+				assert.ErrorContains(t, err, tc.expectErrStr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectRes, res)
+
+			if tc.expectCondition != nil {
+				anp := &policyv1alpha1.AdminNetworkPolicy{}
+				require.NoError(t, r.Client.Get(ctx, req.NamespacedName, anp))
+				condition := meta.FindStatusCondition(anp.Status.Conditions, ConditionTypeReady)
+				require.NotNil(t, condition)
+				assert.Equal(t, tc.expectCondition.Status, condition.Status)
+				assert.Equal(t, tc.expectCondition.Reason, condition.Reason)
+				assert.Equal(t, tc.expectCondition.ObservedGeneration, condition.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestBaselineAdminNetworkPolicyReconciler_Reconcile(t *testing.T) {
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "banp-uid", Generation: 1},
+	}
+	r := createFakeBaselineAdminNetworkPolicyReconciler([]client.Object{banp})
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+		assert.IsType(t, &policyv1alpha1.BaselineAdminNetworkPolicy{}, obj)
+		return nil
+	})
+	defer patches.Reset()
+
+	ctx := context.Background()
+	res, err := r.Reconcile(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultNormal, res)
+	actual := &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	require.NoError(t, r.Client.Get(ctx, req.NamespacedName, actual))
+	assert.True(t, meta.IsStatusConditionTrue(actual.Status.Conditions, ConditionTypeReady))
+
+	// The NSX resources of the deleted BaselineAdminNetworkPolicy are found by name.
+	require.NoError(t, r.Client.Delete(ctx, actual))
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "ListAdminNetworkPolicyUIDByName", func(_ *securitypolicy.SecurityPolicyService, name, createdFor string) sets.Set[string] {
+		assert.Equal(t, common.ResourceTypeBaselineAdminNetworkPolicy, createdFor)
+		return sets.New[string]("banp-uid")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+		return errors.New("delete failed")
+	})
+	res, err = r.Reconcile(ctx, req)
+	assert.ErrorContains(t, err, "delete failed")
+	assert.Equal(t, ResultRequeue, res)
+}
+
+func TestAdminNetworkPolicyReconciler_CollectGarbage(t *testing.T) {
+	anp := &policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp", UID: "anp-uid"}}
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "banp-uid"}}
+	r := createFakeAdminNetworkPolicyReconciler([]client.Object{anp, banp})
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "ListAdminNetworkPolicyID", func(_ *securitypolicy.SecurityPolicyService) sets.Set[string] {
+		return sets.New[string]("anp-uid", "banp-uid", "stale-uid")
+	})
+	defer patches.Reset()
+	deleted := sets.New[string]()
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+		assert.True(t, isGC)
+		deleted.Insert(string(uid))
+		return nil
+	})
+
+	assert.NoError(t, r.CollectGarbage(context.Background()))
+	assert.Equal(t, sets.New[string]("stale-uid"), deleted)
+}
+
+func TestPredicateFuncsNs(t *testing.T) {
+	oldNs := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}}
+	newNs := oldNs.DeepCopy()
+	assert.False(t, PredicateFuncsNs.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs}))
+	newNs.Labels["team"] = "b"
+	assert.True(t, PredicateFuncsNs.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs}))
+	assert.False(t, PredicateFuncsNs.Create(event.CreateEvent{Object: newNs}))
+	assert.True(t, PredicateFuncsNs.Delete(event.DeleteEvent{Object: newNs}))
+}
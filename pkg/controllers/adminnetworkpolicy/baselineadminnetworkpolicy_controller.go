@@ -0,0 +1,143 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+var MetricResTypeBaseline = common.MetricResTypeBaselineAdminNetworkPolicy
+
+// BaselineAdminNetworkPolicyReconciler reconciles the BaselineAdminNetworkPolicy object, the policy is translated
+// to the NSX SecurityPolicies in the Application category which are evaluated after the NetworkPolicies.
+type BaselineAdminNetworkPolicyReconciler struct {
+	Client        client.Client
+	Scheme        *apimachineryruntime.Scheme
+	Service       *securitypolicy.SecurityPolicyService
+	Recorder      record.EventRecorder
+	StatusUpdater common.StatusUpdater
+}
+
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=baselineadminnetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=baselineadminnetworkpolicies/status,verbs=get;update;patch
+func (r *BaselineAdminNetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	log.Info("Reconciling BaselineAdminNetworkPolicy", "baselineadminnetworkpolicy", req.Name)
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling BaselineAdminNetworkPolicy", "baselineadminnetworkpolicy", req.Name, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, banp); err != nil {
+		if apierrors.IsNotFound(err) {
+			if err := deleteAdminNetworkPolicyByName(r.Service, req.Name, servicecommon.ResourceTypeBaselineAdminNetworkPolicy); err != nil {
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return ResultRequeue, err
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch BaselineAdminNetworkPolicy", "req", req.NamespacedName)
+		return ResultRequeue, err
+	}
+
+	if !banp.DeletionTimestamp.IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.Service.DeleteAdminNetworkPolicy(banp.UID, false, servicecommon.ResourceTypeBaselineAdminNetworkPolicy); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, banp, err)
+			return ResultRequeue, err
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, banp)
+		return ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	if err := r.Service.CreateOrUpdateSecurityPolicy(banp); err != nil {
+		r.StatusUpdater.UpdateFail(ctx, banp, err, "", setReadyStatusFalse)
+		if errors.As(err, &nsxutil.RestrictionError{}) {
+			return ResultNormal, nil
+		}
+		return ResultRequeue, err
+	}
+	r.StatusUpdater.UpdateSuccess(ctx, banp, setReadyStatusTrue)
+	return ResultNormal, nil
+}
+
+// enqueueBaselineAdminNetworkPolicies requeues the BaselineAdminNetworkPolicies when the namespaces which may be
+// selected by their subjects change.
+func (r *BaselineAdminNetworkPolicyReconciler) enqueueBaselineAdminNetworkPolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	banpList := &policyv1alpha1.BaselineAdminNetworkPolicyList{}
+	if err := r.Client.List(ctx, banpList); err != nil {
+		log.Error(err, "Failed to list BaselineAdminNetworkPolicies")
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, banp := range banpList.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: banp.Name}})
+	}
+	return reqs
+}
+
+func (r *BaselineAdminNetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&policyv1alpha1.BaselineAdminNetworkPolicy{}).
+		Watches(
+			&v1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueBaselineAdminNetworkPolicies),
+			builder.WithPredicates(PredicateFuncsNs),
+		).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueBaselineAdminNetworkPolicies),
+			builder.WithPredicates(PredicateFuncsNetworkInfo),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeBaseline),
+			}).
+		Complete(r)
+}
+
+func (r *BaselineAdminNetworkPolicyReconciler) RestoreReconcile() error {
+	return nil
+}
+
+// CollectGarbage is a no-op, the NSX resources of the BaselineAdminNetworkPolicies are collected by the
+// AdminNetworkPolicyReconciler.
+func (r *BaselineAdminNetworkPolicyReconciler) CollectGarbage(_ context.Context) error {
+	return nil
+}
+
+func (r *BaselineAdminNetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "BaselineAdminNetworkPolicy")
+		return err
+	}
+	return nil
+}
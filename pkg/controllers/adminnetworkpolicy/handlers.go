@@ -0,0 +1,52 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"reflect"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PredicateFuncsNs filters the Namespace events which may change the namespaces selected by the policies.
+var PredicateFuncsNs = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		// A new namespace is handled when its NetworkInfo is created, as the VPC is not ready before it.
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj := e.ObjectOld.(*v1.Namespace)
+		newObj := e.ObjectNew.(*v1.Namespace)
+		if reflect.DeepEqual(oldObj.Labels, newObj.Labels) {
+			log.Trace("Label of namespace is not changed, ignore it", "name", oldObj.Name)
+			return false
+		}
+		return true
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return true
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// PredicateFuncsNetworkInfo filters the NetworkInfo events, the policies are reconciled once the VPC of a new
+// namespace is ready.
+var PredicateFuncsNetworkInfo = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return false
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
@@ -89,3 +90,27 @@ var PredicateFuncsWithSubnetBindings = predicate.Funcs{
 		return false
 	},
 }
+
+// PredicateNetworkInfoAllowedDNSDomainsChanged filters the NetworkInfo updates which change AllowedDNSDomains, the
+// DNS records in the namespace must be validated again with the new domains.
+func PredicateNetworkInfoAllowedDNSDomainsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNI, ok1 := e.ObjectOld.(*v1alpha1.NetworkInfo)
+			newNI, ok2 := e.ObjectNew.(*v1alpha1.NetworkInfo)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !slices.Equal(oldNI.AllowedDNSDomains, newNI.AllowedDNSDomains)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
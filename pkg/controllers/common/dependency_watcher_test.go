@@ -260,3 +260,45 @@ func TestIsObjectUpdateToReady(t *testing.T) {
 	assert.False(t, IsObjectUpdateToUnready(unreadyConditions, unreadyConditions))
 	assert.False(t, IsObjectUpdateToUnready(readyConditions, readyConditions))
 }
+
+func TestPredicateNetworkInfoAllowedDNSDomainsChanged(t *testing.T) {
+	p := PredicateNetworkInfoAllowedDNSDomainsChanged()
+
+	assert.False(t, p.Create(event.CreateEvent{}))
+	assert.False(t, p.Delete(event.DeleteEvent{}))
+	assert.False(t, p.Generic(event.GenericEvent{}))
+
+	// Test Update
+	tests := []struct {
+		name string
+		old  client.Object
+		new  client.Object
+		want bool
+	}{
+		{
+			name: "different types",
+			old:  &corev1.Service{},
+			new:  &corev1.Service{},
+			want: false,
+		},
+		{
+			name: "domains same",
+			old:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
+			new:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
+			want: false,
+		},
+		{
+			name: "domains changed",
+			old:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
+			new:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"b"}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
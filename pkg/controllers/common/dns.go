@@ -0,0 +1,90 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// DNSSkipped returns true if the object is annotated with nsx.vmware.com/skip.
+func DNSSkipped(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[servicecommon.AnnotationsDNSSkip]
+	return ok
+}
+
+// EndpointsForHostnames returns the endpoints of the hostnames, the wildcard hostnames are skipped as NSX doesn't
+// publish them.
+func EndpointsForHostnames(hostnames []string, targets extdns.Targets, ttl extdns.TTL) []*extdns.Endpoint {
+	var eps []*extdns.Endpoint
+	for _, h := range hostnames {
+		if strings.HasPrefix(h, "*.") {
+			continue
+		}
+		for _, ep := range extdns.EndpointsForHostname(h, targets, ttl) {
+			if ep == nil {
+				log.Info("Skipping invalid DNS hostname", "hostname", h)
+				continue
+			}
+			eps = append(eps, ep)
+		}
+	}
+	return eps
+}
+
+// BuildDNSBatch validates the endpoints with the allowed DNS zones of the namespace and returns the batch of the owner.
+// The batch may have valid rows even if a DNSZoneValidationError is returned.
+func BuildDNSBatch(provider dns.DNSRecordProvider, owner *dns.ResourceRef, eps []*extdns.Endpoint) (*dns.AggregatedDNSEndpoints, error) {
+	if len(eps) == 0 {
+		return nil, nil
+	}
+	rows, _, err := provider.ValidateEndpointsByZone(owner.GetNamespace(), owner, eps)
+	if len(rows) == 0 {
+		return nil, err
+	}
+	return dns.NewOwnerScopedAggregatedRouteDNS(owner, rows), err
+}
+
+// CollectDNSGarbage deletes the DNS records of the owners of the kind which don't exist.
+func CollectDNSGarbage(ctx context.Context, provider dns.DNSRecordProvider, kind string, existing sets.Set[types.NamespacedName]) error {
+	var errs []error
+	for nn := range provider.ListRecordOwnerResource()[kind] {
+		if existing.Has(nn) {
+			continue
+		}
+		log.Info("GC: deleting DNS records of missing owner", "kind", kind, "owner", nn)
+		if _, err := provider.DeleteRecordByOwnerNN(ctx, kind, nn.Namespace, nn.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s DNS garbage collection encountered %d error(s): %w", kind, len(errs), errors.Join(errs...))
+	}
+	return nil
+}
+
+// AddGarbageCollector adds a runnable to the manager which runs collect every GCInterval until the manager stops.
+func AddGarbageCollector(mgr ctrl.Manager, collect func(ctx context.Context) error) error {
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		stop := make(chan bool)
+		go func() {
+			<-ctx.Done()
+			close(stop)
+		}()
+		GenericGarbageCollector(stop, servicecommon.GCInterval, collect)
+		return nil
+	}))
+}
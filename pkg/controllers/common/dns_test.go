@@ -0,0 +1,25 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func TestDNSSkipped(t *testing.T) {
+	assert.False(t, DNSSkipped(&metav1.ObjectMeta{}))
+	assert.True(t, DNSSkipped(&metav1.ObjectMeta{Annotations: map[string]string{servicecommon.AnnotationsDNSSkip: ""}}))
+}
+
+func TestEndpointsForHostnames(t *testing.T) {
+	eps := EndpointsForHostnames([]string{"*.example.com", "a.example.com"}, extdns.NewTargets("10.0.0.1", "2001:db8::1"), 0)
+	// The wildcard hostname is skipped, an A and an AAAA record are built for the other one.
+	assert.Len(t, eps, 2)
+	for _, ep := range eps {
+		assert.Equal(t, "a.example.com", ep.DNSName)
+	}
+}
@@ -0,0 +1,188 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+const (
+	reconcileResultSuccess = "success"
+	reconcileResultRequeue = "requeue"
+)
+
+// NewQueue returns the NewQueue option of the controller. It creates the same priority queue as controller-runtime,
+// and reports the queue depth, retries, reconcile duration and the duration from event to realization of the
+// controller if the metrics are exposed.
+func NewQueue(cf *config.NSXOperatorConfig, metricResType string) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		queue := priorityqueue.New(controllerName, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.Log = logf.Log.WithValues("controller", controllerName)
+			o.RateLimiter = rateLimiter
+		})
+		if cf == nil || !metrics.AreMetricsExposed(cf) {
+			return queue
+		}
+		return &metricsPriorityQueue{
+			metricsQueue:  newMetricsQueue(queue, metricResType),
+			priorityQueue: queue,
+		}
+	}
+}
+
+// WithQueueMetrics wraps the queue created by the controller itself to report the same metrics as NewQueue, the
+// queue is returned as it is if the metrics are not exposed.
+func WithQueueMetrics(cf *config.NSXOperatorConfig, metricResType string, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if cf == nil || !metrics.AreMetricsExposed(cf) {
+		return queue
+	}
+	return newMetricsQueue(queue, metricResType)
+}
+
+// metricsQueue wraps the queue to report the metrics. A request is realized if the controller forgets it and doesn't
+// add it again during the reconciliation.
+type metricsQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	metricResType string
+
+	mu sync.Mutex
+	// eventTime is the time when the request which is not realized yet is added for the first time.
+	eventTime map[reconcile.Request]time.Time
+	// processing is the start time of the requests being reconciled.
+	processing map[reconcile.Request]time.Time
+	forgotten  sets.Set[reconcile.Request]
+	requeued   sets.Set[reconcile.Request]
+}
+
+func newMetricsQueue(queue workqueue.TypedRateLimitingInterface[reconcile.Request], metricResType string) *metricsQueue {
+	return &metricsQueue{
+		TypedRateLimitingInterface: queue,
+		metricResType:              metricResType,
+		eventTime:                  map[reconcile.Request]time.Time{},
+		processing:                 map[reconcile.Request]time.Time{},
+		forgotten:                  sets.New[reconcile.Request](),
+		requeued:                   sets.New[reconcile.Request](),
+	}
+}
+
+// metricsPriorityQueue keeps the priority queue interface, so that controller-runtime still adds the requests with
+// priorities.
+type metricsPriorityQueue struct {
+	*metricsQueue
+	priorityQueue priorityqueue.PriorityQueue[reconcile.Request]
+}
+
+func (q *metricsPriorityQueue) AddWithOpts(o priorityqueue.AddOpts, items ...reconcile.Request) {
+	q.recordAdd(items...)
+	if o.RateLimited {
+		metrics.ControllerQueueRetriesTotal.WithLabelValues(q.metricResType).Add(float64(len(items)))
+	}
+	q.priorityQueue.AddWithOpts(o, items...)
+	q.updateDepth()
+}
+
+func (q *metricsPriorityQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	item, priority, shutdown := q.priorityQueue.GetWithPriority()
+	q.recordGet(item, shutdown)
+	return item, priority, shutdown
+}
+
+func (q *metricsQueue) Add(item reconcile.Request) {
+	q.recordAdd(item)
+	q.TypedRateLimitingInterface.Add(item)
+	q.updateDepth()
+}
+
+func (q *metricsQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	q.recordAdd(item)
+	q.TypedRateLimitingInterface.AddAfter(item, duration)
+	q.updateDepth()
+}
+
+func (q *metricsQueue) AddRateLimited(item reconcile.Request) {
+	q.recordAdd(item)
+	metrics.ControllerQueueRetriesTotal.WithLabelValues(q.metricResType).Inc()
+	q.TypedRateLimitingInterface.AddRateLimited(item)
+	q.updateDepth()
+}
+
+func (q *metricsQueue) Get() (reconcile.Request, bool) {
+	item, shutdown := q.TypedRateLimitingInterface.Get()
+	q.recordGet(item, shutdown)
+	return item, shutdown
+}
+
+func (q *metricsQueue) Forget(item reconcile.Request) {
+	q.mu.Lock()
+	if _, ok := q.processing[item]; ok {
+		q.forgotten.Insert(item)
+	}
+	q.mu.Unlock()
+	q.TypedRateLimitingInterface.Forget(item)
+}
+
+func (q *metricsQueue) Done(item reconcile.Request) {
+	q.mu.Lock()
+	start, processing := q.processing[item]
+	eventTime, queued := q.eventTime[item]
+	realized := q.forgotten.Has(item) && !q.requeued.Has(item)
+	delete(q.processing, item)
+	q.forgotten.Delete(item)
+	q.requeued.Delete(item)
+	if realized {
+		delete(q.eventTime, item)
+	}
+	q.mu.Unlock()
+
+	q.TypedRateLimitingInterface.Done(item)
+	q.updateDepth()
+	if !processing {
+		return
+	}
+	result := reconcileResultRequeue
+	if realized {
+		result = reconcileResultSuccess
+		if queued {
+			metrics.ControllerRealizationDuration.WithLabelValues(q.metricResType).Observe(time.Since(eventTime).Seconds())
+		}
+	}
+	metrics.ControllerReconcileDuration.WithLabelValues(q.metricResType, result).Observe(time.Since(start).Seconds())
+}
+
+func (q *metricsQueue) recordAdd(items ...reconcile.Request) {
+	now := time.Now()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, item := range items {
+		if _, ok := q.processing[item]; ok {
+			q.requeued.Insert(item)
+		}
+		if _, ok := q.eventTime[item]; !ok {
+			q.eventTime[item] = now
+		}
+	}
+}
+
+func (q *metricsQueue) recordGet(item reconcile.Request, shutdown bool) {
+	if !shutdown {
+		q.mu.Lock()
+		q.processing[item] = time.Now()
+		q.mu.Unlock()
+	}
+	q.updateDepth()
+}
+
+func (q *metricsQueue) updateDepth() {
+	metrics.ControllerQueueDepth.WithLabelValues(q.metricResType).Set(float64(q.Len()))
+}
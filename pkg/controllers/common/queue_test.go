@@ -0,0 +1,100 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+func histogramSampleCount(t *testing.T, histogram *prometheus.HistogramVec, labels ...string) uint64 {
+	m := &dto.Metric{}
+	require.NoError(t, histogram.WithLabelValues(labels...).(prometheus.Histogram).Write(m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestNewQueue(t *testing.T) {
+	rateLimiter := workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()
+	queue := NewQueue(nil, "test")("test-controller", rateLimiter)
+	defer queue.ShutDown()
+	_, ok := queue.(*metricsPriorityQueue)
+	assert.False(t, ok)
+
+	cf := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"}}
+	queue = NewQueue(cf, "test")("test-controller", rateLimiter)
+	defer queue.ShutDown()
+	_, ok = queue.(priorityqueue.PriorityQueue[reconcile.Request])
+	assert.True(t, ok)
+	_, ok = queue.(*metricsPriorityQueue)
+	assert.True(t, ok)
+}
+
+func TestWithQueueMetrics(t *testing.T) {
+	resType := "plain-queue-test"
+	plainQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer plainQueue.ShutDown()
+	assert.Equal(t, plainQueue, WithQueueMetrics(nil, resType, plainQueue))
+
+	cf := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"}}
+	queue := WithQueueMetrics(cf, resType, plainQueue)
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "subnet-1"}}
+	queue.Add(item)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ControllerQueueDepth.WithLabelValues(resType)))
+	got, _ := queue.Get()
+	assert.Equal(t, item, got)
+	queue.Forget(item)
+	queue.Done(item)
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerReconcileDuration, resType, reconcileResultSuccess))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerRealizationDuration, resType))
+}
+
+func TestMetricsQueue(t *testing.T) {
+	resType := "queue-test"
+	pq := priorityqueue.New[reconcile.Request]("queue-test")
+	queue := &metricsPriorityQueue{metricsQueue: newMetricsQueue(pq, resType), priorityQueue: pq}
+	defer queue.ShutDown()
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "pod-1"}}
+
+	queue.Add(item)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ControllerQueueDepth.WithLabelValues(resType)))
+
+	// the first reconciliation fails and the request is requeued
+	got, _, _ := queue.GetWithPriority()
+	assert.Equal(t, item, got)
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.ControllerQueueDepth.WithLabelValues(resType)))
+	queue.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, item)
+	queue.Done(item)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ControllerQueueRetriesTotal.WithLabelValues(resType)))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerReconcileDuration, resType, reconcileResultRequeue))
+	assert.Equal(t, uint64(0), histogramSampleCount(t, metrics.ControllerRealizationDuration, resType))
+
+	// the second reconciliation succeeds
+	got, _, _ = queue.GetWithPriority()
+	assert.Equal(t, item, got)
+	queue.Forget(item)
+	queue.Done(item)
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerReconcileDuration, resType, reconcileResultSuccess))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerRealizationDuration, resType))
+	assert.Empty(t, queue.eventTime)
+	assert.Empty(t, queue.processing)
+
+	// requeue after a period is not realized
+	queue.Add(item)
+	got, _, _ = queue.GetWithPriority()
+	queue.Forget(item)
+	queue.AddWithOpts(priorityqueue.AddOpts{After: time.Hour}, item)
+	queue.Done(item)
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.ControllerRealizationDuration, resType))
+	assert.Contains(t, queue.eventTime, got)
+}
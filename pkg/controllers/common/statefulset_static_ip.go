@@ -0,0 +1,161 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// ErrInvalidStaticIPs is returned if the static IP annotations of a StatefulSet are invalid.
+var ErrInvalidStaticIPs = errors.New("invalid StatefulSet static IP annotations")
+
+// StatefulSetStaticIPs is the static IPs of the Pods of a StatefulSet from its annotations.
+type StatefulSetStaticIPs struct {
+	// Subnet is the Subnet which the Pods are attached to.
+	Subnet string
+	// SubnetIPReservation is the SubnetIPReservation which the Subnet and the IPs are taken from.
+	SubnetIPReservation string
+	// IPs are IP addresses, IP ranges or CIDRs, the n-th IP in them is for the Pod of the n-th ordinal from Start.
+	IPs   []string
+	Start int
+}
+
+// ParseStatefulSetStaticIPs parses the static IP annotations of the StatefulSet. It returns nil if the StatefulSet
+// doesn't have static IPs. The IPs of a SubnetIPReservation are not resolved, see GetStatefulSetStaticIPs.
+func ParseStatefulSetStaticIPs(sts *appsv1.StatefulSet) (*StatefulSetStaticIPs, error) {
+	annotations := sts.GetAnnotations()
+	ips, hasIPs := annotations[servicecommon.AnnotationStaticIPs]
+	subnet, hasSubnet := annotations[servicecommon.AnnotationStaticIPSubnet]
+	reservation, hasReservation := annotations[servicecommon.AnnotationStaticIPReservation]
+	if !hasIPs && !hasSubnet && !hasReservation {
+		return nil, nil
+	}
+	staticIPs := &StatefulSetStaticIPs{}
+	if sts.Spec.Ordinals != nil {
+		staticIPs.Start = int(sts.Spec.Ordinals.Start)
+	}
+	if hasReservation {
+		if hasIPs || hasSubnet {
+			return nil, fmt.Errorf("%w: %s can't be set with %s or %s", ErrInvalidStaticIPs, servicecommon.AnnotationStaticIPReservation, servicecommon.AnnotationStaticIPs, servicecommon.AnnotationStaticIPSubnet)
+		}
+		if staticIPs.SubnetIPReservation = strings.TrimSpace(reservation); staticIPs.SubnetIPReservation == "" {
+			return nil, fmt.Errorf("%w: %s is empty", ErrInvalidStaticIPs, servicecommon.AnnotationStaticIPReservation)
+		}
+		return staticIPs, nil
+	}
+	if staticIPs.Subnet = strings.TrimSpace(subnet); staticIPs.Subnet == "" {
+		return nil, fmt.Errorf("%w: %s is required with %s", ErrInvalidStaticIPs, servicecommon.AnnotationStaticIPSubnet, servicecommon.AnnotationStaticIPs)
+	}
+	for _, ip := range strings.Split(ips, ",") {
+		if ip = strings.TrimSpace(ip); ip == "" {
+			continue
+		}
+		// CIDRs are only accepted from a SubnetIPReservation, as the network address is not usable by a Pod.
+		if strings.Contains(ip, "/") {
+			return nil, fmt.Errorf("%w: CIDR %q is not supported in %s", ErrInvalidStaticIPs, ip, servicecommon.AnnotationStaticIPs)
+		}
+		if _, _, err := util.ParseIPRange(ip); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStaticIPs, err)
+		}
+		staticIPs.IPs = append(staticIPs.IPs, ip)
+	}
+	if len(staticIPs.IPs) == 0 {
+		return nil, fmt.Errorf("%w: %s is required with %s", ErrInvalidStaticIPs, servicecommon.AnnotationStaticIPs, servicecommon.AnnotationStaticIPSubnet)
+	}
+	return staticIPs, nil
+}
+
+// GetStatefulSetStaticIPs parses the static IP annotations of the StatefulSet, and takes the Subnet and the IPs from
+// the SubnetIPReservation if it is referenced.
+func GetStatefulSetStaticIPs(ctx context.Context, client k8sclient.Reader, sts *appsv1.StatefulSet) (*StatefulSetStaticIPs, error) {
+	staticIPs, err := ParseStatefulSetStaticIPs(sts)
+	if err != nil || staticIPs == nil || staticIPs.SubnetIPReservation == "" {
+		return staticIPs, err
+	}
+	reservation := &v1alpha1.SubnetIPReservation{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: sts.Namespace, Name: staticIPs.SubnetIPReservation}, reservation); err != nil {
+		return nil, err
+	}
+	if len(reservation.Status.IPs) == 0 {
+		return nil, fmt.Errorf("%w: SubnetIPReservation %s/%s has no reserved IPs yet", ErrInvalidStaticIPs, reservation.Namespace, reservation.Name)
+	}
+	staticIPs.Subnet = reservation.Spec.Subnet
+	staticIPs.IPs = reservation.Status.IPs
+	return staticIPs, nil
+}
+
+// IPForOrdinal returns the static IP of the Pod of the ordinal.
+func (s *StatefulSetStaticIPs) IPForOrdinal(ordinal int) (string, error) {
+	if ordinal < s.Start {
+		return "", fmt.Errorf("ordinal %d is less than the start ordinal %d", ordinal, s.Start)
+	}
+	ip, err := util.NthIPInRanges(s.IPs, ordinal-s.Start)
+	if err != nil {
+		return "", fmt.Errorf("no static IP for ordinal %d: %w", ordinal, err)
+	}
+	return ip.String(), nil
+}
+
+// GetStatefulSetStaticIPForPod returns the Subnet and the static IP of a Pod whose StatefulSet has static IPs, or
+// empty strings for other Pods. The Pods orphaned by a deleted or recreated StatefulSet don't have static IPs.
+func GetStatefulSetStaticIPForPod(ctx context.Context, client k8sclient.Reader, pod *v1.Pod) (string, string, error) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil || ref.Kind != appsv1.SchemeGroupVersion.WithKind("StatefulSet").Kind {
+		return "", "", nil
+	}
+	sts := &appsv1.StatefulSet{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: ref.Name}, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("StatefulSet of Pod not found, ignoring the static IPs", "Namespace", pod.Namespace, "Pod", pod.Name, "StatefulSet", ref.Name)
+			return "", "", nil
+		}
+		return "", "", err
+	}
+	if sts.UID != ref.UID {
+		log.Info("StatefulSet of Pod is recreated, ignoring the static IPs", "Namespace", pod.Namespace, "Pod", pod.Name, "StatefulSet", ref.Name)
+		return "", "", nil
+	}
+	staticIPs, err := GetStatefulSetStaticIPs(ctx, client, sts)
+	if err != nil || staticIPs == nil {
+		return "", "", err
+	}
+	ordinal, err := statefulSetPodOrdinal(pod)
+	if err != nil {
+		return "", "", err
+	}
+	ip, err := staticIPs.IPForOrdinal(ordinal)
+	if err != nil {
+		return "", "", err
+	}
+	return staticIPs.Subnet, ip, nil
+}
+
+// statefulSetPodOrdinal returns the ordinal of the StatefulSet Pod from the apps.kubernetes.io/pod-index label, or
+// from the "<StatefulSet name>-<ordinal>" Pod name.
+func statefulSetPodOrdinal(pod *v1.Pod) (int, error) {
+	index, ok := pod.GetLabels()[appsv1.PodIndexLabel]
+	if !ok {
+		index = pod.Name[strings.LastIndex(pod.Name, "-")+1:]
+	}
+	ordinal, err := strconv.Atoi(index)
+	if err != nil {
+		return -1, fmt.Errorf("failed to get the ordinal of StatefulSet Pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	return ordinal, nil
+}
@@ -0,0 +1,156 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestParseStatefulSetStaticIPs(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		ordinals    *appsv1.StatefulSetOrdinals
+		expected    *StatefulSetStaticIPs
+		expectedErr string
+	}{
+		{
+			name: "NoAnnotations",
+		},
+		{
+			name: "StaticIPs",
+			annotations: map[string]string{
+				servicecommon.AnnotationStaticIPs:      "10.0.0.10, 10.0.0.12-10.0.0.13",
+				servicecommon.AnnotationStaticIPSubnet: "subnet-1",
+			},
+			ordinals: &appsv1.StatefulSetOrdinals{Start: 5},
+			expected: &StatefulSetStaticIPs{Subnet: "subnet-1", IPs: []string{"10.0.0.10", "10.0.0.12-10.0.0.13"}, Start: 5},
+		},
+		{
+			name:        "SubnetIPReservation",
+			annotations: map[string]string{servicecommon.AnnotationStaticIPReservation: "reservation-1"},
+			expected:    &StatefulSetStaticIPs{SubnetIPReservation: "reservation-1"},
+		},
+		{
+			name: "SubnetIPReservationWithStaticIPs",
+			annotations: map[string]string{
+				servicecommon.AnnotationStaticIPReservation: "reservation-1",
+				servicecommon.AnnotationStaticIPs:           "10.0.0.10",
+			},
+			expectedErr: "can't be set with",
+		},
+		{
+			name:        "MissingStaticIPs",
+			annotations: map[string]string{servicecommon.AnnotationStaticIPSubnet: "subnet-1"},
+			expectedErr: "nsx.vmware.com/static-ips is required",
+		},
+		{
+			name: "CIDR",
+			annotations: map[string]string{
+				servicecommon.AnnotationStaticIPs:      "10.0.0.0/28",
+				servicecommon.AnnotationStaticIPSubnet: "subnet-1",
+			},
+			expectedErr: "CIDR \"10.0.0.0/28\" is not supported",
+		},
+		{
+			name: "InvalidIP",
+			annotations: map[string]string{
+				servicecommon.AnnotationStaticIPs:      "10.0.0.300",
+				servicecommon.AnnotationStaticIPSubnet: "subnet-1",
+			},
+			expectedErr: "invalid StatefulSet static IP annotations",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "db", Annotations: tt.annotations},
+				Spec:       appsv1.StatefulSetSpec{Ordinals: tt.ordinals},
+			}
+			staticIPs, err := ParseStatefulSetStaticIPs(sts)
+			if tt.expectedErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidStaticIPs)
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, staticIPs)
+		})
+	}
+}
+
+func TestGetStatefulSetStaticIPForPod(t *testing.T) {
+	scheme := clientgoscheme.Scheme
+	v1alpha1.AddToScheme(scheme)
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns1",
+			Name:        "db",
+			UID:         "sts-uid",
+			Annotations: map[string]string{servicecommon.AnnotationStaticIPReservation: "reservation-1"},
+		},
+		Spec: appsv1.StatefulSetSpec{Ordinals: &appsv1.StatefulSetOrdinals{Start: 1}},
+	}
+	reservation := &v1alpha1.SubnetIPReservation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "reservation-1"},
+		Spec:       v1alpha1.SubnetIPReservationSpec{Subnet: "subnet-1"},
+		Status:     v1alpha1.SubnetIPReservationStatus{IPs: []string{"10.0.0.16/31", "10.0.0.20"}},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sts, reservation).Build()
+	newPod := func(name string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns1",
+			Name:            name,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "db", UID: "sts-uid", Controller: ptr.To(true)}},
+		}}
+	}
+
+	expectedIPs := map[string]string{"db-1": "10.0.0.16", "db-2": "10.0.0.17", "db-3": "10.0.0.20"}
+	for name, expectedIP := range expectedIPs {
+		subnet, ip, err := GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, newPod(name, nil))
+		require.NoError(t, err)
+		assert.Equal(t, "subnet-1", subnet)
+		assert.Equal(t, expectedIP, ip)
+	}
+	// The ordinal is taken from the pod-index label first.
+	_, ip, err := GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, newPod("db-1", map[string]string{appsv1.PodIndexLabel: "3"}))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.20", ip)
+
+	_, _, err = GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, newPod("db-4", nil))
+	assert.ErrorContains(t, err, "no static IP for ordinal 4")
+
+	// Pods orphaned by a deleted or recreated StatefulSet don't have static IPs.
+	orphanedPod := newPod("db-1", nil)
+	orphanedPod.OwnerReferences[0].UID = "old-sts-uid"
+	subnet, ip, err := GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, orphanedPod)
+	require.NoError(t, err)
+	assert.Empty(t, subnet)
+	assert.Empty(t, ip)
+	orphanedPod.OwnerReferences[0].Name = "web"
+	subnet, ip, err = GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, orphanedPod)
+	require.NoError(t, err)
+	assert.Empty(t, subnet)
+	assert.Empty(t, ip)
+
+	// Pods not owned by a StatefulSet don't have static IPs.
+	subnet, ip, err = GetStatefulSetStaticIPForPod(context.TODO(), fakeClient, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod-1"}})
+	require.NoError(t, err)
+	assert.Empty(t, subnet)
+	assert.Empty(t, ip)
+}
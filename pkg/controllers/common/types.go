@@ -10,6 +10,8 @@ import (
 const (
 	MetricResTypeSecurityPolicy             = "securitypolicy"
 	MetricResTypeNetworkPolicy              = "networkpolicy"
+	MetricResTypeAdminNetworkPolicy         = "adminnetworkpolicy"
+	MetricResTypeBaselineAdminNetworkPolicy = "baselineadminnetworkpolicy"
 	MetricResTypeIPPool                     = "ippool"
 	MetricResTypeIPAddressAllocation        = "ipaddressallocation"
 	MetricResTypeNSXServiceAccount          = "nsxserviceaccount"
@@ -25,6 +27,11 @@ const (
 	MetricResTypeNode                       = "node"
 	MetricResTypeServiceLb                  = "servicelb"
 	MetricResTypeStatefulSet                = "statefulset"
+	MetricResTypeGateway                    = "gateway"
+	MetricResTypeHTTPRoute                  = "httproute"
+	MetricResTypeGRPCRoute                  = "grpcroute"
+	MetricResTypeTLSRoute                   = "tlsroute"
+	MetricResTypeIngress                    = "ingress"
 	MaxConcurrentReconciles                 = 8
 	NSXOperatorError                        = "nsx-op/error"
 	//sync the error with NCP side
@@ -0,0 +1,176 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var log = logger.Log
+
+const ReasonNSXResourceDrift = "NSXResourceDrift"
+
+var driftTypes = []servicecommon.DriftType{servicecommon.DriftTypeMissing, servicecommon.DriftTypeModified, servicecommon.DriftTypeUnexpected}
+
+// Owner maps the tags of an NSX resource to the CR owning it.
+type Owner struct {
+	// NameScope and UIDScope are the tag scopes of the CR name and UID.
+	NameScope string
+	UIDScope  string
+	// New returns an empty CR of the owner kind.
+	New func() client.Object
+	// Events receives the owners to requeue, nil if the controller of the owner doesn't watch the drifts.
+	Events chan event.GenericEvent
+}
+
+// Target is a store to audit.
+type Target struct {
+	// ResourceType is the metric label of the store.
+	ResourceType string
+	Detect       func() ([]servicecommon.Drift, error)
+	// Refresh updates the store with the drifted resource on NSX before requeuing the owner, otherwise the owner
+	// reconciler compares the CR with the stale store and never repairs the drift.
+	Refresh func(servicecommon.Drift) error
+	// Owners are checked in order, the first one whose NameScope is in the tags owns the NSX resource.
+	Owners []Owner
+}
+
+// Auditor periodically compares the NSX resources in the stores with NSX. It reports the drifts with events on the
+// owner CRs and metrics, and requeues the owners to repair the drifts if Requeue is true.
+type Auditor struct {
+	Recorder record.EventRecorder
+	Interval time.Duration
+	Requeue  bool
+	Targets  []Target
+	// suspected are the drifts found in the last audit. A drift is reported only if it is found in two audits in a
+	// row, so that the resources being created or deleted during an audit are not reported.
+	suspected sets.Set[string]
+}
+
+// Start runs the audit until the context is done, it implements manager.Runnable.
+func (a *Auditor) Start(ctx context.Context) error {
+	log.Info("Starting NSX resource drift auditor", "interval", a.Interval, "requeue", a.Requeue)
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			a.audit()
+		}
+	}
+}
+
+// NeedLeaderElection makes the auditor run only in the leader, as the controllers which repair the drifts.
+func (a *Auditor) NeedLeaderElection() bool {
+	return true
+}
+
+func (a *Auditor) audit() {
+	found := sets.New[string]()
+	for _, target := range a.Targets {
+		drifts, err := target.Detect()
+		if err != nil {
+			log.Error(err, "Failed to detect the drift of NSX resources", "resourceType", target.ResourceType)
+			continue
+		}
+		counts := map[servicecommon.DriftType]int{}
+		for _, drift := range drifts {
+			key := fmt.Sprintf("%s/%s/%s", target.ResourceType, drift.Type, drift.Path)
+			found.Insert(key)
+			if !a.suspected.Has(key) {
+				continue
+			}
+			counts[drift.Type]++
+			metrics.NSXDriftTotal.WithLabelValues(target.ResourceType, string(drift.Type)).Inc()
+			a.report(target, drift)
+		}
+		for _, driftType := range driftTypes {
+			metrics.NSXDriftResources.WithLabelValues(target.ResourceType, string(driftType)).Set(float64(counts[driftType]))
+		}
+	}
+	a.suspected = found
+}
+
+func (a *Auditor) report(target Target, drift servicecommon.Drift) {
+	owner, events := resolveOwner(target.Owners, drift.Tags)
+	if owner == nil {
+		log.Info("Found drifted NSX resource without owner", "resourceType", target.ResourceType, "driftType", drift.Type, "path", drift.Path)
+		return
+	}
+	log.Info("Found drifted NSX resource", "resourceType", target.ResourceType, "driftType", drift.Type, "path", drift.Path,
+		"owner", types.NamespacedName{Namespace: owner.GetNamespace(), Name: owner.GetName()})
+	if a.Recorder != nil {
+		a.Recorder.Event(owner, v1.EventTypeWarning, ReasonNSXResourceDrift, fmt.Sprintf("NSX %s %s is %s on NSX", target.ResourceType, drift.Path, driftMessage(drift.Type)))
+	}
+	// Unexpected resources are not in the store, requeuing the owner doesn't repair them.
+	if !a.Requeue || events == nil || drift.Type == servicecommon.DriftTypeUnexpected {
+		return
+	}
+	// The auditor is the only sender, the store is refreshed only if the owner can be requeued, so that the drift is
+	// found and reported again in the next audit if it is skipped.
+	if len(events) == cap(events) {
+		log.Info("Skipped requeuing the owner of drifted NSX resource as the queue is full", "path", drift.Path)
+		return
+	}
+	if target.Refresh != nil {
+		if err := target.Refresh(drift); err != nil {
+			log.Error(err, "Failed to refresh the store with drifted NSX resource", "resourceType", target.ResourceType, "path", drift.Path)
+			return
+		}
+	}
+	events <- event.GenericEvent{Object: owner}
+}
+
+func driftMessage(driftType servicecommon.DriftType) string {
+	switch driftType {
+	case servicecommon.DriftTypeMissing:
+		return "deleted"
+	case servicecommon.DriftTypeModified:
+		return "modified"
+	default:
+		return "not managed by the operator"
+	}
+}
+
+// resolveOwner returns the CR owning the NSX resource by the tags, and the channel to requeue it.
+func resolveOwner(owners []Owner, tags []model.Tag) (client.Object, chan event.GenericEvent) {
+	values := map[string]string{}
+	for _, tag := range tags {
+		if tag.Scope != nil && tag.Tag != nil {
+			values[*tag.Scope] = *tag.Tag
+		}
+	}
+	namespace := values[servicecommon.TagScopeNamespace]
+	if namespace == "" {
+		namespace = values[servicecommon.TagScopeVMNamespace]
+	}
+	for _, owner := range owners {
+		name, ok := values[owner.NameScope]
+		if !ok || name == "" {
+			continue
+		}
+		obj := owner.New()
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetUID(types.UID(values[owner.UIDScope]))
+		return obj, owner.Events
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+package drift
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func newTag(scope, tag string) model.Tag {
+	return model.Tag{Scope: &scope, Tag: &tag}
+}
+
+func TestResolveOwner(t *testing.T) {
+	subnetEvents := make(chan event.GenericEvent, 1)
+	owners := []Owner{
+		{NameScope: servicecommon.TagScopeSubnetCRName, UIDScope: servicecommon.TagScopeSubnetCRUID, New: func() client.Object { return &v1alpha1.Subnet{} }, Events: subnetEvents},
+		{NameScope: servicecommon.TagScopeSubnetSetCRName, UIDScope: servicecommon.TagScopeSubnetSetCRUID, New: func() client.Object { return &v1alpha1.SubnetSet{} }},
+	}
+
+	obj, events := resolveOwner(owners, []model.Tag{
+		newTag(servicecommon.TagScopeNamespace, "ns1"),
+		newTag(servicecommon.TagScopeSubnetCRName, "subnet1"),
+		newTag(servicecommon.TagScopeSubnetCRUID, "uid1"),
+	})
+	assert.IsType(t, &v1alpha1.Subnet{}, obj)
+	assert.Equal(t, "ns1", obj.GetNamespace())
+	assert.Equal(t, "subnet1", obj.GetName())
+	assert.Equal(t, types.UID("uid1"), obj.GetUID())
+	assert.Equal(t, subnetEvents, events)
+
+	obj, events = resolveOwner(owners, []model.Tag{
+		newTag(servicecommon.TagScopeVMNamespace, "ns2"),
+		newTag(servicecommon.TagScopeSubnetSetCRName, "subnetset1"),
+	})
+	assert.IsType(t, &v1alpha1.SubnetSet{}, obj)
+	assert.Equal(t, "ns2", obj.GetNamespace())
+	assert.Equal(t, "subnetset1", obj.GetName())
+	assert.Nil(t, events)
+
+	obj, events = resolveOwner(owners, []model.Tag{newTag(servicecommon.TagScopeNamespace, "ns1")})
+	assert.Nil(t, obj)
+	assert.Nil(t, events)
+}
+
+func TestAuditor_audit(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	subnetEvents := make(chan event.GenericEvent, 10)
+	tags := []model.Tag{
+		newTag(servicecommon.TagScopeNamespace, "ns1"),
+		newTag(servicecommon.TagScopeSubnetCRName, "subnet1"),
+		newTag(servicecommon.TagScopeSubnetCRUID, "uid1"),
+	}
+	var drifts, refreshed []servicecommon.Drift
+	auditor := &Auditor{
+		Recorder: recorder,
+		Requeue:  true,
+		Targets: []Target{{
+			ResourceType: servicecommon.ResourceTypeSubnet,
+			Detect: func() ([]servicecommon.Drift, error) {
+				return drifts, nil
+			},
+			Refresh: func(drift servicecommon.Drift) error {
+				refreshed = append(refreshed, drift)
+				return nil
+			},
+			Owners: []Owner{
+				{NameScope: servicecommon.TagScopeSubnetCRName, UIDScope: servicecommon.TagScopeSubnetCRUID, New: func() client.Object { return &v1alpha1.Subnet{} }, Events: subnetEvents},
+			},
+		}},
+	}
+
+	// The drifts found for the first time are not reported.
+	drifts = []servicecommon.Drift{
+		{Type: servicecommon.DriftTypeMissing, ResourceType: servicecommon.ResourceTypeSubnet, Path: "/subnet1", Tags: tags},
+		{Type: servicecommon.DriftTypeUnexpected, ResourceType: servicecommon.ResourceTypeSubnet, Path: "/subnet2", Tags: tags},
+	}
+	auditor.audit()
+	assert.Len(t, recorder.Events, 0)
+	assert.Len(t, subnetEvents, 0)
+	assert.Empty(t, refreshed)
+
+	// The drifts found in two audits in a row are reported, only the missing Subnet is requeued.
+	auditor.audit()
+	assert.Len(t, recorder.Events, 2)
+	assert.Equal(t, v1.EventTypeWarning+" "+ReasonNSXResourceDrift+" NSX VpcSubnet /subnet1 is deleted on NSX", <-recorder.Events)
+	assert.Equal(t, v1.EventTypeWarning+" "+ReasonNSXResourceDrift+" NSX VpcSubnet /subnet2 is not managed by the operator on NSX", <-recorder.Events)
+	assert.Len(t, subnetEvents, 1)
+	// The store is refreshed before the owner is requeued.
+	assert.Len(t, refreshed, 1)
+	assert.Equal(t, "/subnet1", refreshed[0].Path)
+	requeued := <-subnetEvents
+	assert.Equal(t, "ns1", requeued.Object.GetNamespace())
+	assert.Equal(t, "subnet1", requeued.Object.GetName())
+
+	// The drift which is repaired is not reported again.
+	drifts = []servicecommon.Drift{
+		{Type: servicecommon.DriftTypeModified, ResourceType: servicecommon.ResourceTypeSubnet, Path: "/subnet1", Tags: tags},
+	}
+	auditor.audit()
+	assert.Len(t, recorder.Events, 0)
+	assert.Len(t, subnetEvents, 0)
+}
+
+func TestAuditor_report(t *testing.T) {
+	tags := []model.Tag{
+		newTag(servicecommon.TagScopeNamespace, "ns1"),
+		newTag(servicecommon.TagScopeSubnetCRName, "subnet1"),
+	}
+	drift := servicecommon.Drift{Type: servicecommon.DriftTypeModified, ResourceType: servicecommon.ResourceTypeSubnet, Path: "/subnet1", Tags: tags}
+	tests := []struct {
+		name             string
+		bufferSize       int
+		refreshErr       error
+		expectedRefresh  int
+		expectedRequeued int
+	}{
+		{
+			name:             "Requeued",
+			bufferSize:       1,
+			expectedRefresh:  1,
+			expectedRequeued: 1,
+		},
+		{
+			name:       "RefreshFailure",
+			bufferSize: 1,
+			refreshErr: errors.New("failed to refresh"),
+			// The owner is not requeued with the stale store.
+			expectedRefresh: 1,
+		},
+		{
+			// The store is not refreshed if the owner can't be requeued.
+			name: "QueueFull",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetEvents := make(chan event.GenericEvent, tt.bufferSize)
+			refreshed := 0
+			auditor := &Auditor{Requeue: true}
+			auditor.report(Target{
+				ResourceType: servicecommon.ResourceTypeSubnet,
+				Refresh: func(servicecommon.Drift) error {
+					refreshed++
+					return tt.refreshErr
+				},
+				Owners: []Owner{
+					{NameScope: servicecommon.TagScopeSubnetCRName, UIDScope: servicecommon.TagScopeSubnetCRUID, New: func() client.Object { return &v1alpha1.Subnet{} }, Events: subnetEvents},
+				},
+			}, drift)
+			assert.Equal(t, tt.expectedRefresh, refreshed)
+			assert.Len(t, subnetEvents, tt.expectedRequeued)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// DefaultOrphanGracePeriod is used if OrphanCollector.GracePeriod is not set.
+const DefaultOrphanGracePeriod = time.Hour
+
+// OrphanTarget is a store to collect the orphan NSX resources from.
+type OrphanTarget struct {
+	// ResourceType is the metric label of the store.
+	ResourceType string
+	Store        *servicecommon.ResourceStore
+	// Inspect returns the path and the tags of an NSX resource in the store.
+	Inspect func(obj interface{}) (path string, tags []model.Tag)
+	// Delete deletes an orphan NSX resource on NSX and from the store, the orphans are only reported if it is nil.
+	Delete func(obj interface{}) error
+	// Owners are checked in order, the first one whose NameScope is in the tags owns the NSX resource. The NSX
+	// resources without any owner, e.g. the shared resources, are never orphans. The Events of the owners are not used.
+	Owners []Owner
+}
+
+// OrphanCollector periodically finds the NSX resources in the stores whose owner K8s objects no longer exist, the
+// owners are resolved by the name and UID tags of the NSX resources. It reports the orphans with logs and metrics, and
+// deletes the orphans which have been found for GracePeriod if Delete is true.
+type OrphanCollector struct {
+	// Client should read from the API server rather than the cache, so that an owner being created is not missed.
+	Client      client.Reader
+	Interval    time.Duration
+	Delete      bool
+	GracePeriod time.Duration
+	Targets     []OrphanTarget
+	// orphans are the time when the orphans were found first, keyed by the resource type and the path.
+	orphans map[string]time.Time
+	now     func() time.Time
+}
+
+// Start runs the collection until the context is done, it implements manager.Runnable.
+func (c *OrphanCollector) Start(ctx context.Context) error {
+	log.Info("Starting orphan NSX resource collector", "interval", c.Interval, "delete", c.Delete, "gracePeriod", c.gracePeriod())
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.collect(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection makes the collector run only in the leader, as the controllers which create the NSX resources.
+func (c *OrphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *OrphanCollector) gracePeriod() time.Duration {
+	if c.GracePeriod <= 0 {
+		return DefaultOrphanGracePeriod
+	}
+	return c.GracePeriod
+}
+
+func (c *OrphanCollector) collect(ctx context.Context) {
+	now := time.Now()
+	if c.now != nil {
+		now = c.now()
+	}
+	found := map[string]time.Time{}
+	for _, target := range c.Targets {
+		orphans, deleted := 0, 0
+		for _, obj := range target.Store.List() {
+			path, tags := target.Inspect(obj)
+			owner, _ := resolveOwner(target.Owners, tags)
+			if path == "" || owner == nil {
+				continue
+			}
+			exists, err := c.ownerExists(ctx, owner)
+			if err != nil {
+				log.Error(err, "Failed to get the owner of NSX resource", "resourceType", target.ResourceType, "path", path)
+				continue
+			}
+			if exists {
+				continue
+			}
+			key := target.ResourceType + "/" + path
+			since, ok := c.orphans[key]
+			if !ok {
+				since = now
+				log.Info("Found orphan NSX resource", "resourceType", target.ResourceType, "path", path,
+					"owner", types.NamespacedName{Namespace: owner.GetNamespace(), Name: owner.GetName()}, "ownerUID", owner.GetUID())
+			}
+			if c.Delete && target.Delete != nil && now.Sub(since) >= c.gracePeriod() {
+				if err := target.Delete(obj); err != nil {
+					metrics.NSXOrphanDeletedTotal.WithLabelValues(target.ResourceType, "failure").Inc()
+					log.Error(err, "Failed to delete orphan NSX resource", "resourceType", target.ResourceType, "path", path)
+				} else {
+					metrics.NSXOrphanDeletedTotal.WithLabelValues(target.ResourceType, "success").Inc()
+					log.Info("Deleted orphan NSX resource", "resourceType", target.ResourceType, "path", path, "orphanSince", since)
+					deleted++
+					continue
+				}
+			}
+			found[key] = since
+			orphans++
+		}
+		metrics.NSXOrphanResources.WithLabelValues(target.ResourceType).Set(float64(orphans))
+		if orphans > 0 || deleted > 0 {
+			log.Info("Collected orphan NSX resources", "resourceType", target.ResourceType, "orphans", orphans, "deleted", deleted)
+		}
+	}
+	c.orphans = found
+}
+
+// ownerExists returns false if the owner is not found, or it has been recreated with the same name.
+func (c *OrphanCollector) ownerExists(ctx context.Context, owner client.Object) (bool, error) {
+	uid := owner.GetUID()
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(owner), owner); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return uid == "" || owner.GetUID() == uid, nil
+}
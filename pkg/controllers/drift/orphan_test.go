@@ -0,0 +1,145 @@
+package drift
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+)
+
+func newStaticRoute(path string, tags ...model.Tag) *model.StaticRoutes {
+	return &model.StaticRoutes{Path: &path, Tags: tags}
+}
+
+func TestOrphanCollector_collect(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "uid1"}}
+	store := &servicecommon.ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			return *obj.(*model.StaticRoutes).Path, nil
+		}, cache.Indexers{}),
+	}
+	for _, route := range []*model.StaticRoutes{
+		// The owner exists.
+		newStaticRoute("/route1", newTag(servicecommon.TagScopeNamespace, "ns1"), newTag(servicecommon.TagScopePodName, "pod1"), newTag(servicecommon.TagScopePodUID, "uid1")),
+		// The owner is not found.
+		newStaticRoute("/route2", newTag(servicecommon.TagScopeNamespace, "ns1"), newTag(servicecommon.TagScopePodName, "pod2"), newTag(servicecommon.TagScopePodUID, "uid2")),
+		// The owner is recreated with the same name.
+		newStaticRoute("/route3", newTag(servicecommon.TagScopeNamespace, "ns1"), newTag(servicecommon.TagScopePodName, "pod1"), newTag(servicecommon.TagScopePodUID, "uid0")),
+		// The resource is not owned by any K8s object.
+		newStaticRoute("/route4", newTag(servicecommon.TagScopeNamespace, "ns1")),
+	} {
+		require.NoError(t, store.Add(route))
+	}
+
+	var deleted []string
+	now := time.Now()
+	collector := &OrphanCollector{
+		Client:      fake.NewClientBuilder().WithObjects(pod).Build(),
+		Delete:      true,
+		GracePeriod: time.Hour,
+		Targets: []OrphanTarget{{
+			ResourceType: servicecommon.ResourceTypeStaticRoutes,
+			Store:        store,
+			Inspect: func(obj interface{}) (string, []model.Tag) {
+				route := obj.(*model.StaticRoutes)
+				return *route.Path, route.Tags
+			},
+			Delete: func(obj interface{}) error {
+				deleted = append(deleted, *obj.(*model.StaticRoutes).Path)
+				return store.Delete(obj)
+			},
+			Owners: []Owner{
+				{NameScope: servicecommon.TagScopePodName, UIDScope: servicecommon.TagScopePodUID, New: func() client.Object { return &v1.Pod{} }},
+			},
+		}},
+		now: func() time.Time { return now },
+	}
+
+	// The orphans are not deleted in the grace period.
+	collector.collect(context.TODO())
+	assert.Empty(t, deleted)
+	assert.Len(t, collector.orphans, 2)
+	assert.Contains(t, collector.orphans, servicecommon.ResourceTypeStaticRoutes+"//route2")
+	assert.Contains(t, collector.orphans, servicecommon.ResourceTypeStaticRoutes+"//route3")
+
+	now = now.Add(30 * time.Minute)
+	collector.collect(context.TODO())
+	assert.Empty(t, deleted)
+
+	// The orphans are deleted after the grace period.
+	now = now.Add(time.Hour)
+	collector.collect(context.TODO())
+	assert.ElementsMatch(t, []string{"/route2", "/route3"}, deleted)
+	assert.Empty(t, collector.orphans)
+	assert.ElementsMatch(t, []string{"/route1", "/route4"}, store.ListKeys())
+
+	// The orphans are only reported if Delete is false.
+	deleted = nil
+	collector.Delete = false
+	require.NoError(t, store.Add(newStaticRoute("/route5", newTag(servicecommon.TagScopeNamespace, "ns1"), newTag(servicecommon.TagScopePodName, "pod5"))))
+	collector.collect(context.TODO())
+	now = now.Add(2 * time.Hour)
+	collector.collect(context.TODO())
+	assert.Empty(t, deleted)
+	assert.Len(t, collector.orphans, 1)
+}
+
+func TestOrphanCollector_collectStickySubnetPort(t *testing.T) {
+	store := &servicecommon.ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			return *obj.(*model.VpcSubnetPort).Path, nil
+		}, cache.Indexers{}),
+	}
+	podTags := []model.Tag{newTag(servicecommon.TagScopeNamespace, "ns1"), newTag(servicecommon.TagScopePodName, "pod1"), newTag(servicecommon.TagScopePodUID, "uid1")}
+	stickyTags := append(slices.Clone(podTags),
+		newTag(servicecommon.TagScopeStickyIdentity, "Pod/pod1"),
+		newTag(servicecommon.TagScopeStickyGracePeriod, "86400"),
+		newTag(servicecommon.TagScopeStickyReleasedAt, strconv.FormatInt(time.Now().Unix(), 10)))
+	for _, subnetPort := range []*model.VpcSubnetPort{
+		{Path: servicecommon.String("/port1"), Tags: podTags},
+		// The released sticky SubnetPort of the deleted Pod.
+		{Path: servicecommon.String("/port2"), Tags: stickyTags},
+	} {
+		require.NoError(t, store.Add(subnetPort))
+	}
+
+	var deleted []string
+	now := time.Now()
+	collector := &OrphanCollector{
+		Client:      fake.NewClientBuilder().Build(),
+		Delete:      true,
+		GracePeriod: time.Hour,
+		Targets: []OrphanTarget{{
+			ResourceType: subnetport.ResourceTypeSubnetPort,
+			Store:        store,
+			Inspect:      subnetport.InspectOrphanSubnetPort,
+			Delete: func(obj interface{}) error {
+				deleted = append(deleted, *obj.(*model.VpcSubnetPort).Path)
+				return store.Delete(obj)
+			},
+			Owners: []Owner{
+				{NameScope: servicecommon.TagScopePodName, UIDScope: servicecommon.TagScopePodUID, New: func() client.Object { return &v1.Pod{} }},
+			},
+		}},
+		now: func() time.Time { return now },
+	}
+
+	collector.collect(context.TODO())
+	now = now.Add(2 * time.Hour)
+	collector.collect(context.TODO())
+	assert.Equal(t, []string{"/port1"}, deleted)
+	assert.Equal(t, []string{"/port2"}, store.ListKeys())
+}
@@ -0,0 +1,98 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extannotations "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	// DNSReadyConditionType is set on the Gateway status and on the parent status of the Routes.
+	DNSReadyConditionType     = "DNSReady"
+	reasonDNSRecordConfigured = "DNSRecordConfigured"
+	reasonDNSRecordFailed     = "DNSRecordFailed"
+	reasonNoMatchingParent    = "NoMatchingParent"
+
+	// DNSControllerName is the controllerName of the parent status which NSX Operator adds to the Routes.
+	DNSControllerName gatewayv1.GatewayController = "nsx.vmware.com/dns-controller"
+
+	// The values of nsx.vmware.com/gateway-hostname-source, both the annotation and the spec hostnames are used if
+	// it is not set.
+	hostnameSourceAnnotationOnly   = "annotation-only"
+	hostnameSourceDefinedHostsOnly = "defined-hosts-only"
+)
+
+// hostnamesOf merges the hostnames from the spec and the nsx.vmware.com/hostname annotation according to
+// nsx.vmware.com/gateway-hostname-source.
+func hostnamesOf(obj metav1.Object, specHostnames []string) []string {
+	annotations := obj.GetAnnotations()
+	var hostnames []string
+	source := annotations[servicecommon.AnnotationDNSHostnameSourceKey]
+	if source != hostnameSourceAnnotationOnly {
+		hostnames = append(hostnames, specHostnames...)
+	}
+	if source != hostnameSourceDefinedHostsOnly {
+		for _, h := range extannotations.HostnamesFromAnnotations(annotations, servicecommon.AnnotationDNSHostnameKey) {
+			if h != "" {
+				hostnames = append(hostnames, h)
+			}
+		}
+	}
+	return hostnames
+}
+
+// gatewayTargets returns the IP and hostname addresses of the Gateway.
+func gatewayTargets(gw *gatewayv1.Gateway) extdns.Targets {
+	vals := make([]string, 0, len(gw.Status.Addresses))
+	for _, addr := range gw.Status.Addresses {
+		vals = append(vals, addr.Value)
+	}
+	return extdns.NewTargets(vals...)
+}
+
+// applyDNSBatch publishes the rows of the batch, or deletes the records of the owner if there is no row. It returns
+// the error to report in the DNSReady condition, and the error to requeue the owner. DNS zone validation errors are
+// only reported, as the owner must be updated to fix them.
+func applyDNSBatch(ctx context.Context, provider dns.DNSRecordProvider, owner *dns.ResourceRef, batch *dns.AggregatedDNSEndpoints, buildErr error) (condErr, requeueErr error) {
+	var zoneValErr *dns.DNSZoneValidationError
+	if buildErr != nil && !errors.As(buildErr, &zoneValErr) {
+		return buildErr, buildErr
+	}
+	if batch != nil && len(batch.Rows) > 0 {
+		if _, err := provider.CreateOrUpdateRecords(ctx, batch); err != nil {
+			log.Error(err, "Failed to create or update DNS records", "kind", owner.Kind, "namespace", owner.GetNamespace(), "name", owner.GetName())
+			return err, err
+		}
+	} else if _, err := provider.DeleteRecordByOwnerNN(ctx, owner.Kind, owner.GetNamespace(), owner.GetName()); err != nil {
+		log.Error(err, "Failed to delete stale DNS records", "kind", owner.Kind, "namespace", owner.GetNamespace(), "name", owner.GetName())
+		return err, err
+	}
+	return buildErr, nil
+}
+
+func buildDNSReadyCondition(err error, generation int64) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               DNSReadyConditionType,
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.Now(),
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonDNSRecordFailed
+		cond.Message = err.Error()
+	} else {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = reasonDNSRecordConfigured
+	}
+	return cond
+}
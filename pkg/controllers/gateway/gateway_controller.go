@@ -0,0 +1,262 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package gateway
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	MetricResType = common.MetricResTypeGateway
+)
+
+// GatewayReconciler publishes the DNS records of a Gateway for the hostnames of its listeners and the
+// nsx.vmware.com/hostname annotation, the records point to the addresses of the Gateway.
+type GatewayReconciler struct {
+	Client    client.Client
+	Scheme    *apimachineryruntime.Scheme
+	NSXConfig *config.NSXOperatorConfig
+	DNS       dns.DNSRecordProvider
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=get;update;patch
+func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	gw := &gatewayv1.Gateway{}
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling Gateway DNS", "Gateway", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, gw); err != nil {
+		if apierrors.IsNotFound(err) {
+			if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindGateway, req.Namespace, req.Name); err != nil {
+				log.Error(err, "Failed to delete DNS records for deleted Gateway", "Gateway", req.NamespacedName)
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch Gateway", "Gateway", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	if !gw.DeletionTimestamp.IsZero() || common.DNSSkipped(gw) {
+		if err := r.clearDNSAndCondition(ctx, req.NamespacedName); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling Gateway DNS", "Gateway", req.NamespacedName)
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
+	owner := &dns.ResourceRef{Kind: dns.ResourceKindGateway, Object: gw}
+	batch, buildErr := common.BuildDNSBatch(r.DNS, owner, common.EndpointsForHostnames(gatewayHostnames(gw), gatewayTargets(gw), dns.RecordTTLFromAnnotations(gw)))
+	condErr, requeueErr := applyDNSBatch(ctx, r.DNS, owner, batch, buildErr)
+	if batch == nil && condErr == nil {
+		if err := r.removeDNSReadyCondition(ctx, req.NamespacedName); err != nil {
+			log.Error(err, "Failed to clear Gateway DNSReady condition", "Gateway", req.NamespacedName)
+			return common.ResultRequeueAfter10sec, nil
+		}
+	} else if err := r.updateDNSReadyCondition(ctx, req.NamespacedName, condErr); err != nil {
+		log.Error(err, "Failed to update Gateway DNSReady condition", "Gateway", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	if requeueErr != nil {
+		metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateFailTotal, MetricResType)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateSuccessTotal, MetricResType)
+	return ResultNormal, nil
+}
+
+// gatewayHostnames returns the non-wildcard hostnames of the listeners and the annotation, a hostname is skipped if it
+// overlaps with a hostname before it.
+func gatewayHostnames(gw *gatewayv1.Gateway) []string {
+	var specHostnames []string
+	for _, listener := range gw.Spec.Listeners {
+		if listener.Hostname != nil {
+			specHostnames = append(specHostnames, string(*listener.Hostname))
+		}
+	}
+	claimed := map[string]struct{}{}
+	var hostnames []string
+	for _, h := range hostnamesOf(gw, specHostnames) {
+		h = extdns.GatewayCanonicalHost(h)
+		if strings.HasPrefix(h, "*.") {
+			continue
+		}
+		if extdns.ClaimGwMatchingDNSName(claimed, h) {
+			hostnames = append(hostnames, h)
+		}
+	}
+	return hostnames
+}
+
+func (r *GatewayReconciler) updateDNSReadyCondition(ctx context.Context, key types.NamespacedName, err error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gw := &gatewayv1.Gateway{}
+		if getErr := r.Client.Get(ctx, key, gw); getErr != nil {
+			return client.IgnoreNotFound(getErr)
+		}
+		if !meta.SetStatusCondition(&gw.Status.Conditions, buildDNSReadyCondition(err, gw.Generation)) {
+			return nil
+		}
+		return r.Client.Status().Update(ctx, gw)
+	})
+}
+
+func (r *GatewayReconciler) removeDNSReadyCondition(ctx context.Context, key types.NamespacedName) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gw := &gatewayv1.Gateway{}
+		if err := r.Client.Get(ctx, key, gw); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if !meta.RemoveStatusCondition(&gw.Status.Conditions, DNSReadyConditionType) {
+			return nil
+		}
+		return r.Client.Status().Update(ctx, gw)
+	})
+}
+
+func (r *GatewayReconciler) clearDNSAndCondition(ctx context.Context, key types.NamespacedName) error {
+	if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindGateway, key.Namespace, key.Name); err != nil {
+		log.Error(err, "Failed to delete DNS records for Gateway", "Gateway", key)
+		return err
+	}
+	if err := r.removeDNSReadyCondition(ctx, key); err != nil {
+		log.Error(err, "Failed to clear Gateway DNSReady condition", "Gateway", key)
+		return err
+	}
+	return nil
+}
+
+// enqueueGatewaysFromNetworkInfo requeues the Gateways in the namespace when AllowedDNSDomains change.
+func (r *GatewayReconciler) enqueueGatewaysFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	gwList := &gatewayv1.GatewayList{}
+	if err := r.Client.List(ctx, gwList, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list Gateways for NetworkInfo DNS domain change", "Namespace", obj.GetNamespace())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, gw := range gwList.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}})
+	}
+	return reqs
+}
+
+func (r *GatewayReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1.Gateway{}).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueGatewaysFromNetworkInfo),
+			builder.WithPredicates(common.PredicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.NSXConfig, MetricResType),
+			}).
+		Complete(r)
+}
+
+func (r *GatewayReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *GatewayReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "Gateway")
+		return err
+	}
+	return common.AddGarbageCollector(mgr, r.CollectGarbage)
+}
+
+// CollectGarbage deletes the DNS records of the Gateways which are deleted or skipped.
+func (r *GatewayReconciler) CollectGarbage(ctx context.Context) error {
+	gwList := &gatewayv1.GatewayList{}
+	if err := r.Client.List(ctx, gwList); err != nil {
+		log.Error(err, "Gateway DNS GC: failed to list Gateways")
+		return err
+	}
+	existing := sets.New[types.NamespacedName]()
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		if gw.DeletionTimestamp.IsZero() && !common.DNSSkipped(gw) {
+			existing.Insert(types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name})
+		}
+	}
+	return common.CollectDNSGarbage(ctx, r.DNS, dns.ResourceKindGateway, existing)
+}
+
+// isKindInstalled checks if the CRD of the Gateway API kind is installed in the cluster.
+func isKindInstalled(mgr ctrl.Manager, gvk schema.GroupVersionKind) bool {
+	if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		log.Info("Gateway API kind is not installed, skipping its DNS controller", "kind", gvk.Kind, "version", gvk.Version, "error", err)
+		return false
+	}
+	return true
+}
+
+// NewGatewayReconcilers returns the reconcilers which publish the DNS records for the Gateways and the Routes whose
+// CRDs are installed, it returns nil if the Gateway CRD is not installed or the DNS service is not initialized.
+func NewGatewayReconcilers(mgr ctrl.Manager, cf *config.NSXOperatorConfig, dnsRecordService *dns.DNSRecordService) []pkgutil.ReconcilerProvider {
+	if dnsRecordService == nil || !isKindInstalled(mgr, gatewayv1.SchemeGroupVersion.WithKind(dns.ResourceKindGateway)) {
+		return nil
+	}
+	reconcilers := []pkgutil.ReconcilerProvider{
+		&GatewayReconciler{
+			Client:    mgr.GetClient(),
+			Scheme:    mgr.GetScheme(),
+			NSXConfig: cf,
+			DNS:       dnsRecordService,
+		},
+	}
+	for _, kind := range routeKinds {
+		gvk := gatewayv1.SchemeGroupVersion.WithKind(kind)
+		if kind == dns.ResourceKindTLSRoute {
+			gvk = gatewayv1alpha2.SchemeGroupVersion.WithKind(kind)
+		}
+		if !isKindInstalled(mgr, gvk) {
+			continue
+		}
+		reconcilers = append(reconcilers, &RouteReconciler{
+			Client:    mgr.GetClient(),
+			Scheme:    mgr.GetScheme(),
+			NSXConfig: cf,
+			DNS:       dnsRecordService,
+			Kind:      kind,
+		})
+	}
+	return reconcilers
+}
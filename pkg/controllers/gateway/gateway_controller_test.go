@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func gatewayTestScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	require.NoError(t, gatewayv1.AddToScheme(s))
+	require.NoError(t, gatewayv1alpha2.AddToScheme(s))
+	return s
+}
+
+func gatewayFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithScheme(gatewayTestScheme(t)).
+		WithStatusSubresource(&gatewayv1.Gateway{}, &gatewayv1.HTTPRoute{}, &gatewayv1.GRPCRoute{}, &gatewayv1alpha2.TLSRoute{}).
+		WithObjects(objs...).Build()
+}
+
+func hostnamePtr(h string) *gatewayv1.Hostname {
+	hostname := gatewayv1.Hostname(h)
+	return &hostname
+}
+
+func newGateway(namespace, name string, annotations map[string]string, addresses []string, listeners ...gatewayv1.Listener) *gatewayv1.Gateway {
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Annotations: annotations, Generation: 1},
+		Spec:       gatewayv1.GatewaySpec{GatewayClassName: "nsx", Listeners: listeners},
+	}
+	for _, addr := range addresses {
+		gw.Status.Addresses = append(gw.Status.Addresses, gatewayv1.GatewayStatusAddress{Value: addr})
+	}
+	return gw
+}
+
+// validRows returns the rows of the endpoints as if they are all in an allowed DNS zone.
+func validRows(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+	rows := make([]dns.EndpointRow, 0, len(eps))
+	for _, ep := range eps {
+		rows = append(rows, *dns.NewEndpointRow(ep, "/zones/example", ep.DNSName))
+	}
+	return rows, nil, nil
+}
+
+func TestGatewayHostnames(t *testing.T) {
+	gw := newGateway("ns", "gw", map[string]string{servicecommon.AnnotationDNSHostnameKey: "a.example.com,extra.example.com"}, nil,
+		gatewayv1.Listener{Name: "http", Port: 80, Hostname: hostnamePtr("A.example.com.")},
+		gatewayv1.Listener{Name: "https", Port: 443, Hostname: hostnamePtr("a.example.com")},
+		gatewayv1.Listener{Name: "wildcard", Port: 8080, Hostname: hostnamePtr("*.example.com")},
+		gatewayv1.Listener{Name: "any", Port: 9090},
+	)
+	assert.Equal(t, []string{"a.example.com", "extra.example.com"}, gatewayHostnames(gw))
+
+	gw.Annotations[servicecommon.AnnotationDNSHostnameSourceKey] = hostnameSourceAnnotationOnly
+	assert.Equal(t, []string{"a.example.com", "extra.example.com"}, gatewayHostnames(gw))
+
+	gw.Annotations[servicecommon.AnnotationDNSHostnameSourceKey] = hostnameSourceDefinedHostsOnly
+	assert.Equal(t, []string{"a.example.com"}, gatewayHostnames(gw))
+}
+
+func TestGatewayReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "gw"}}
+	listener := gatewayv1.Listener{Name: "http", Port: 80, Hostname: hostnamePtr("a.example.com")}
+
+	tests := []struct {
+		name      string
+		objs      []client.Object
+		setupMock func(m *mockdns.MockDNSRecordProvider)
+		wantRes   ctrl.Result
+		wantCond  *metav1.ConditionStatus
+	}{
+		{
+			name: "not_found_deletes_dns",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "gw").Return(true, nil)
+			},
+			wantRes: ResultNormal,
+		},
+		{
+			name: "not_found_deletes_dns_error",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "gw").Return(false, fmt.Errorf("mock err"))
+			},
+			wantRes: common.ResultRequeueAfter10sec,
+		},
+		{
+			name: "skip_annotation_clears_dns",
+			objs: []client.Object{newGateway("ns", "gw", map[string]string{servicecommon.AnnotationsDNSSkip: "true"}, []string{"10.0.0.1"}, listener)},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "gw").Return(true, nil)
+			},
+			wantRes: ResultNormal,
+		},
+		{
+			name: "records_published",
+			objs: []client.Object{newGateway("ns", "gw", nil, []string{"10.0.0.1"}, listener)},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).DoAndReturn(validRows)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, batch *dns.AggregatedDNSEndpoints) (bool, error) {
+					require.Len(t, batch.Rows, 1)
+					assert.Equal(t, "a.example.com", batch.Rows[0].DNSName)
+					assert.Equal(t, extdns.Targets{"10.0.0.1"}, batch.Rows[0].Targets)
+					return true, nil
+				})
+			},
+			wantRes:  ResultNormal,
+			wantCond: ptrConditionStatus(metav1.ConditionTrue),
+		},
+		{
+			name: "create_records_error",
+			objs: []client.Object{newGateway("ns", "gw", nil, []string{"10.0.0.1"}, listener)},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).DoAndReturn(validRows)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("mock err"))
+			},
+			wantRes:  common.ResultRequeueAfter10sec,
+			wantCond: ptrConditionStatus(metav1.ConditionFalse),
+		},
+		{
+			name: "zone_validation_error_not_requeued",
+			objs: []client.Object{newGateway("ns", "gw", nil, []string{"10.0.0.1"}, listener)},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).Return(nil, nil, &dns.DNSZoneValidationError{Msg: "no DNS zones are permitted"})
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "gw").Return(false, nil)
+			},
+			wantRes:  ResultNormal,
+			wantCond: ptrConditionStatus(metav1.ConditionFalse),
+		},
+		{
+			name: "no_address_deletes_stale_records",
+			objs: []client.Object{newGateway("ns", "gw", nil, nil, listener)},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "gw").Return(false, nil)
+			},
+			wantRes: ResultNormal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			if tt.setupMock != nil {
+				tt.setupMock(m)
+			}
+			r := &GatewayReconciler{Client: gatewayFakeClient(t, tt.objs...), NSXConfig: &config.NSXOperatorConfig{}, DNS: m}
+			res, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRes, res)
+
+			gw := &gatewayv1.Gateway{}
+			if err := r.Client.Get(ctx, req.NamespacedName, gw); err != nil {
+				return
+			}
+			cond := meta.FindStatusCondition(gw.Status.Conditions, DNSReadyConditionType)
+			if tt.wantCond == nil {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, *tt.wantCond, cond.Status)
+		})
+	}
+}
+
+func ptrConditionStatus(s metav1.ConditionStatus) *metav1.ConditionStatus {
+	return &s
+}
+
+func TestGatewayReconciler_CollectGarbage(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+		dns.ResourceKindGateway: sets.New(
+			types.NamespacedName{Namespace: "ns", Name: "gw"},
+			types.NamespacedName{Namespace: "ns", Name: "deleted"},
+			types.NamespacedName{Namespace: "ns", Name: "skipped"},
+		),
+		dns.ResourceKindService: sets.New(types.NamespacedName{Namespace: "ns", Name: "svc"}),
+	})
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "deleted").Return(true, nil)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "ns", "skipped").Return(false, fmt.Errorf("mock err"))
+
+	r := &GatewayReconciler{
+		Client: gatewayFakeClient(t,
+			newGateway("ns", "gw", nil, nil),
+			newGateway("ns", "skipped", map[string]string{servicecommon.AnnotationsDNSSkip: "true"}, nil),
+		),
+		DNS: m,
+	}
+	err := r.CollectGarbage(context.Background())
+	assert.ErrorContains(t, err, "mock err")
+}
@@ -0,0 +1,367 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// routeMetricResTypes are the metric resource types of the Route kinds.
+var routeMetricResTypes = map[string]string{
+	dns.ResourceKindHTTPRoute: common.MetricResTypeHTTPRoute,
+	dns.ResourceKindGRPCRoute: common.MetricResTypeGRPCRoute,
+	dns.ResourceKindTLSRoute:  common.MetricResTypeTLSRoute,
+}
+
+// RouteReconciler publishes the DNS records of the Routes of a kind. The hostnames of a Route are the intersection of
+// its hostnames with the hostnames of the listeners of its parent Gateways which accepted it, and the records point to
+// the addresses of these Gateways. The DNSReady condition is reported in the parent status of the Route with
+// DNSControllerName as the controllerName.
+type RouteReconciler struct {
+	Client    client.Client
+	Scheme    *apimachineryruntime.Scheme
+	NSXConfig *config.NSXOperatorConfig
+	DNS       dns.DNSRecordProvider
+	Kind      string
+}
+
+// parentDNS is the DNS result of a parent Gateway of a Route, message is the reason if the parent has no hostname.
+type parentDNS struct {
+	ref       gatewayv1.ParentReference
+	hostnames []string
+	message   string
+}
+
+func (r *RouteReconciler) metricResType() string {
+	return routeMetricResTypes[r.Kind]
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes;tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status;grpcroutes/status;tlsroutes/status,verbs=get;update;patch
+func (r *RouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	route := newRoute(r.Kind)
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling Route DNS", "kind", r.Kind, "Route", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, r.Kind, req.Namespace, req.Name); err != nil {
+				log.Error(err, "Failed to delete DNS records for deleted Route", "kind", r.Kind, "Route", req.NamespacedName)
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch Route", "kind", r.Kind, "Route", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	if !route.GetDeletionTimestamp().IsZero() || common.DNSSkipped(route) {
+		if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, r.Kind, req.Namespace, req.Name); err != nil {
+			log.Error(err, "Failed to delete DNS records for Route", "kind", r.Kind, "Route", req.NamespacedName)
+			return common.ResultRequeueAfter10sec, nil
+		}
+		if err := r.updateParentStatus(ctx, req.NamespacedName, nil, nil); err != nil {
+			log.Error(err, "Failed to clear Route DNS parent status", "kind", r.Kind, "Route", req.NamespacedName)
+			return common.ResultRequeueAfter10sec, nil
+		}
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling Route DNS", "kind", r.Kind, "Route", req.NamespacedName)
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerSyncTotal, r.metricResType())
+	eps, parents, err := r.resolveRouteDNS(ctx, route)
+	if err != nil {
+		log.Error(err, "Failed to resolve the parent Gateways of Route", "kind", r.Kind, "Route", req.NamespacedName)
+		metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateFailTotal, r.metricResType())
+		return common.ResultRequeueAfter10sec, nil
+	}
+	owner := &dns.ResourceRef{Kind: r.Kind, Object: route}
+	batch, buildErr := common.BuildDNSBatch(r.DNS, owner, eps)
+	condErr, requeueErr := applyDNSBatch(ctx, r.DNS, owner, batch, buildErr)
+	if batch == nil && condErr == nil {
+		// No DNS record is published for the Route, the parent status is not reported to avoid adding it to every Route.
+		parents = nil
+	}
+	if err := r.updateParentStatus(ctx, req.NamespacedName, parents, condErr); err != nil {
+		log.Error(err, "Failed to update Route DNS parent status", "kind", r.Kind, "Route", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	if requeueErr != nil {
+		metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateFailTotal, r.metricResType())
+		return common.ResultRequeueAfter10sec, nil
+	}
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateSuccessTotal, r.metricResType())
+	return ResultNormal, nil
+}
+
+// resolveRouteDNS returns the endpoints of the Route and the DNS result of each parent Gateway. The endpoints of a
+// hostname shared by several Gateways point to the addresses of all of them.
+func (r *RouteReconciler) resolveRouteDNS(ctx context.Context, route client.Object) ([]*extdns.Endpoint, []parentDNS, error) {
+	parentRefs, specHostnames, status := routeSpec(route)
+	routeHosts := make([]string, 0, len(specHostnames))
+	for _, h := range specHostnames {
+		routeHosts = append(routeHosts, string(h))
+	}
+	routeHosts = hostnamesOf(route, routeHosts)
+	if len(routeHosts) == 0 {
+		// A Route without hostnames takes the hostnames of the listeners.
+		routeHosts = []string{""}
+	}
+
+	targetsByHost := map[string]sets.Set[string]{}
+	gatewaysByHost := map[string]sets.Set[string]{}
+	var parents []parentDNS
+	for _, ref := range parentRefs {
+		gwNN, ok := parentGateway(route.GetNamespace(), ref)
+		if !ok {
+			continue
+		}
+		p := parentDNS{ref: ref}
+		gw := &gatewayv1.Gateway{}
+		if err := r.Client.Get(ctx, gwNN, gw); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, nil, err
+			}
+			p.message = fmt.Sprintf("Gateway %s is not found", gwNN)
+			parents = append(parents, p)
+			continue
+		}
+		if !gw.DeletionTimestamp.IsZero() || common.DNSSkipped(gw) {
+			p.message = fmt.Sprintf("Gateway %s is deleting or skipped for DNS", gwNN)
+			parents = append(parents, p)
+			continue
+		}
+		if !routeAccepted(status, route.GetNamespace(), ref) {
+			p.message = fmt.Sprintf("Route is not accepted by Gateway %s", gwNN)
+			parents = append(parents, p)
+			continue
+		}
+		targets := gatewayTargets(gw)
+		if len(targets) == 0 {
+			p.message = fmt.Sprintf("Gateway %s has no address", gwNN)
+			parents = append(parents, p)
+			continue
+		}
+		hosts := matchingHostnames(gw, ref, routeHosts)
+		if len(hosts) == 0 {
+			p.message = fmt.Sprintf("No listener of Gateway %s matches the hostnames of the Route", gwNN)
+			parents = append(parents, p)
+			continue
+		}
+		p.hostnames = hosts
+		for _, h := range hosts {
+			if targetsByHost[h] == nil {
+				targetsByHost[h] = sets.New[string]()
+				gatewaysByHost[h] = sets.New[string]()
+			}
+			targetsByHost[h].Insert(targets...)
+			gatewaysByHost[h].Insert(gwNN.String())
+		}
+		parents = append(parents, p)
+	}
+
+	ttl := dns.RecordTTLFromAnnotations(route)
+	var eps []*extdns.Endpoint
+	for _, h := range sets.List(sets.KeySet(targetsByHost)) {
+		gwKeys := strings.Join(sets.List(gatewaysByHost[h]), ",")
+		for _, ep := range common.EndpointsForHostnames([]string{h}, extdns.NewTargets(sets.List(targetsByHost[h])...), ttl) {
+			eps = append(eps, ep.WithLabel(dns.EndpointLabelParentGateway, gwKeys))
+		}
+	}
+	return eps, parents, nil
+}
+
+// routeAccepted returns true if a Gateway controller other than NSX Operator accepted the Route for the parent.
+func routeAccepted(status *gatewayv1.RouteStatus, routeNamespace string, ref gatewayv1.ParentReference) bool {
+	if status == nil {
+		return false
+	}
+	for _, ps := range status.Parents {
+		if ps.ControllerName == DNSControllerName || !sameParentRef(routeNamespace, ps.ParentRef, ref) {
+			continue
+		}
+		if meta.IsStatusConditionTrue(ps.Conditions, string(gatewayv1.RouteConditionAccepted)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingHostnames returns the sorted non-wildcard hostnames of the Route which match the listeners of the Gateway
+// selected by the parent reference.
+func matchingHostnames(gw *gatewayv1.Gateway, ref gatewayv1.ParentReference, routeHosts []string) []string {
+	hosts := sets.New[string]()
+	for _, listener := range gw.Spec.Listeners {
+		if ref.SectionName != nil && *ref.SectionName != listener.Name {
+			continue
+		}
+		if ref.Port != nil && *ref.Port != listener.Port {
+			continue
+		}
+		listenerHost := ""
+		if listener.Hostname != nil {
+			listenerHost = string(*listener.Hostname)
+		}
+		for _, routeHost := range routeHosts {
+			h, ok := extdns.GwMatchingHost(listenerHost, routeHost)
+			if !ok || h == "" || strings.HasPrefix(h, "*.") {
+				continue
+			}
+			hosts.Insert(h)
+		}
+	}
+	return sets.List(hosts)
+}
+
+// updateParentStatus replaces the parent status entries of DNSControllerName with the DNSReady condition of the
+// parents, and removes them if parents is empty.
+func (r *RouteReconciler) updateParentStatus(ctx context.Context, key types.NamespacedName, parents []parentDNS, condErr error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		route := newRoute(r.Kind)
+		if err := r.Client.Get(ctx, key, route); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		_, _, status := routeSpec(route)
+		var existing []gatewayv1.RouteParentStatus
+		newParents := make([]gatewayv1.RouteParentStatus, 0, len(status.Parents)+len(parents))
+		for _, ps := range status.Parents {
+			if ps.ControllerName == DNSControllerName {
+				existing = append(existing, ps)
+			} else {
+				newParents = append(newParents, ps)
+			}
+		}
+		for _, p := range parents {
+			ps := gatewayv1.RouteParentStatus{ParentRef: p.ref, ControllerName: DNSControllerName}
+			if idx := slices.IndexFunc(existing, func(e gatewayv1.RouteParentStatus) bool {
+				return sameParentRef(key.Namespace, e.ParentRef, p.ref)
+			}); idx >= 0 {
+				ps.Conditions = slices.Clone(existing[idx].Conditions)
+			}
+			cond := buildDNSReadyCondition(condErr, route.GetGeneration())
+			if len(p.hostnames) == 0 {
+				cond.Status = metav1.ConditionFalse
+				cond.Reason = reasonNoMatchingParent
+				cond.Message = p.message
+			}
+			meta.SetStatusCondition(&ps.Conditions, cond)
+			newParents = append(newParents, ps)
+		}
+		if equality.Semantic.DeepEqual(status.Parents, newParents) || (len(status.Parents) == 0 && len(newParents) == 0) {
+			return nil
+		}
+		status.Parents = newParents
+		return r.Client.Status().Update(ctx, route)
+	})
+}
+
+// enqueueRoutesFromGateway requeues the Routes whose parent is the Gateway, as their DNS records depend on the
+// listeners and the addresses of the Gateway.
+func (r *RouteReconciler) enqueueRoutesFromGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	gwNN := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	routes, err := listRoutes(ctx, r.Client, r.Kind)
+	if err != nil {
+		log.Error(err, "Failed to list Routes for Gateway change", "kind", r.Kind, "Gateway", gwNN)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, route := range routes {
+		if referencesGateway(route, gwNN) {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(route)})
+		}
+	}
+	return reqs
+}
+
+// enqueueRoutesFromNetworkInfo requeues the Routes in the namespace when AllowedDNSDomains change.
+func (r *RouteReconciler) enqueueRoutesFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	routes, err := listRoutes(ctx, r.Client, r.Kind, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		log.Error(err, "Failed to list Routes for NetworkInfo DNS domain change", "kind", r.Kind, "Namespace", obj.GetNamespace())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, route := range routes {
+		reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(route)})
+	}
+	return reqs
+}
+
+func (r *RouteReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newRoute(r.Kind)).
+		Named(strings.ToLower(r.Kind)+"-dns").
+		Watches(
+			&gatewayv1.Gateway{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueRoutesFromGateway),
+		).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueRoutesFromNetworkInfo),
+			builder.WithPredicates(common.PredicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.NSXConfig, r.metricResType()),
+			}).
+		Complete(r)
+}
+
+func (r *RouteReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *RouteReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", r.Kind)
+		return err
+	}
+	return common.AddGarbageCollector(mgr, r.CollectGarbage)
+}
+
+// CollectGarbage deletes the DNS records of the Routes which are deleted or skipped.
+func (r *RouteReconciler) CollectGarbage(ctx context.Context) error {
+	routes, err := listRoutes(ctx, r.Client, r.Kind)
+	if err != nil {
+		log.Error(err, "Route DNS GC: failed to list Routes", "kind", r.Kind)
+		return err
+	}
+	existing := sets.New[types.NamespacedName]()
+	for _, route := range routes {
+		if route.GetDeletionTimestamp().IsZero() && !common.DNSSkipped(route) {
+			existing.Insert(client.ObjectKeyFromObject(route))
+		}
+	}
+	return common.CollectDNSGarbage(ctx, r.DNS, r.Kind, existing)
+}
@@ -0,0 +1,162 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+)
+
+func parentRef(name string, sectionName string) gatewayv1.ParentReference {
+	ref := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(name)}
+	if sectionName != "" {
+		section := gatewayv1.SectionName(sectionName)
+		ref.SectionName = &section
+	}
+	return ref
+}
+
+// acceptedBy returns the parent status of a Gateway controller which accepted the Route.
+func acceptedBy(ref gatewayv1.ParentReference) gatewayv1.RouteParentStatus {
+	return gatewayv1.RouteParentStatus{
+		ParentRef:      ref,
+		ControllerName: "example.com/gateway-controller",
+		Conditions: []metav1.Condition{{
+			Type:   string(gatewayv1.RouteConditionAccepted),
+			Status: metav1.ConditionTrue,
+			Reason: string(gatewayv1.RouteReasonAccepted),
+		}},
+	}
+}
+
+func newHTTPRoute(namespace, name string, hostnames []string, refs []gatewayv1.ParentReference, accepted ...gatewayv1.ParentReference) *gatewayv1.HTTPRoute {
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: 1},
+		Spec:       gatewayv1.HTTPRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: refs}},
+	}
+	for _, h := range hostnames {
+		route.Spec.Hostnames = append(route.Spec.Hostnames, gatewayv1.Hostname(h))
+	}
+	for _, ref := range accepted {
+		route.Status.Parents = append(route.Status.Parents, acceptedBy(ref))
+	}
+	return route
+}
+
+func TestRouteReconciler_resolveRouteDNS(t *testing.T) {
+	gw1 := newGateway("ns", "gw1", nil, []string{"10.0.0.1"},
+		gatewayv1.Listener{Name: "wildcard", Port: 80, Hostname: hostnamePtr("*.example.com")},
+		gatewayv1.Listener{Name: "b", Port: 443, Hostname: hostnamePtr("b.example.com")},
+	)
+	gw2 := newGateway("ns", "gw2", nil, []string{"10.0.0.2"}, gatewayv1.Listener{Name: "any", Port: 80})
+	gwNoAddr := newGateway("ns", "gw3", nil, nil, gatewayv1.Listener{Name: "any", Port: 80})
+	r := &RouteReconciler{Client: gatewayFakeClient(t, gw1, gw2, gwNoAddr), Kind: dns.ResourceKindHTTPRoute}
+
+	refs := []gatewayv1.ParentReference{
+		parentRef("gw1", ""), parentRef("gw2", ""), parentRef("gw3", ""), parentRef("gw4", ""), parentRef("gw2", "other"),
+	}
+	route := newHTTPRoute("ns", "route", []string{"a.example.com", "*.example.com", "c.other.com"}, refs, refs[0], refs[1], refs[2], refs[3])
+	eps, parents, err := r.resolveRouteDNS(context.Background(), route)
+	require.NoError(t, err)
+
+	got := map[string][]string{}
+	for _, ep := range eps {
+		got[ep.DNSName] = append(got[ep.DNSName], ep.Targets...)
+		if ep.DNSName == "a.example.com" {
+			assert.Equal(t, "ns/gw1,ns/gw2", ep.Labels[dns.EndpointLabelParentGateway])
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"a.example.com": {"10.0.0.1", "10.0.0.2"},
+		"b.example.com": {"10.0.0.1"},
+		"c.other.com":   {"10.0.0.2"},
+	}, got)
+
+	require.Len(t, parents, 5)
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, parents[0].hostnames)
+	assert.Equal(t, []string{"a.example.com", "c.other.com"}, parents[1].hostnames)
+	assert.Equal(t, "Gateway ns/gw3 has no address", parents[2].message)
+	assert.Equal(t, "Gateway ns/gw4 is not found", parents[3].message)
+	assert.Equal(t, "Route is not accepted by Gateway ns/gw2", parents[4].message)
+}
+
+func TestRouteReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "route"}}
+	gw := newGateway("ns", "gw", nil, []string{"10.0.0.1"}, gatewayv1.Listener{Name: "http", Port: 80, Hostname: hostnamePtr("*.example.com")})
+	refs := []gatewayv1.ParentReference{parentRef("gw", ""), parentRef("missing", "")}
+	route := newHTTPRoute("ns", "route", []string{"a.example.com"}, refs, refs[0])
+
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).DoAndReturn(validRows)
+	m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, batch *dns.AggregatedDNSEndpoints) (bool, error) {
+		assert.Equal(t, dns.ResourceKindHTTPRoute, batch.Owner.Kind)
+		require.Len(t, batch.Rows, 1)
+		assert.Equal(t, "a.example.com", batch.Rows[0].DNSName)
+		return true, nil
+	})
+
+	r := &RouteReconciler{Client: gatewayFakeClient(t, gw, route), NSXConfig: &config.NSXOperatorConfig{}, DNS: m, Kind: dns.ResourceKindHTTPRoute}
+	res, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, ResultNormal, res)
+
+	got := &gatewayv1.HTTPRoute{}
+	require.NoError(t, r.Client.Get(ctx, req.NamespacedName, got))
+	require.Len(t, got.Status.Parents, 3)
+	// The parent status of the other Gateway controller is kept.
+	assert.Equal(t, acceptedBy(refs[0]), got.Status.Parents[0])
+	conditions := map[gatewayv1.ObjectName]*metav1.Condition{}
+	for _, ps := range got.Status.Parents[1:] {
+		assert.Equal(t, DNSControllerName, ps.ControllerName)
+		conditions[ps.ParentRef.Name] = meta.FindStatusCondition(ps.Conditions, DNSReadyConditionType)
+	}
+	require.NotNil(t, conditions["gw"])
+	assert.Equal(t, metav1.ConditionTrue, conditions["gw"].Status)
+	require.NotNil(t, conditions["missing"])
+	assert.Equal(t, metav1.ConditionFalse, conditions["missing"].Status)
+	assert.Equal(t, reasonNoMatchingParent, conditions["missing"].Reason)
+
+	// The parent status of NSX Operator is removed when the Route is skipped.
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindHTTPRoute, "ns", "route").Return(true, nil)
+	got.Annotations = map[string]string{"nsx.vmware.com/skip": "true"}
+	require.NoError(t, r.Client.Update(ctx, got))
+	res, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, ResultNormal, res)
+	require.NoError(t, r.Client.Get(ctx, req.NamespacedName, got))
+	assert.Equal(t, []gatewayv1.RouteParentStatus{acceptedBy(refs[0])}, got.Status.Parents)
+}
+
+func TestRouteReconciler_enqueueRoutesFromGateway(t *testing.T) {
+	r := &RouteReconciler{
+		Client: gatewayFakeClient(t,
+			newHTTPRoute("ns", "route1", nil, []gatewayv1.ParentReference{parentRef("gw", "")}),
+			newHTTPRoute("ns2", "route2", nil, []gatewayv1.ParentReference{{Name: "gw", Namespace: ptrNamespace("ns")}}),
+			newHTTPRoute("ns2", "route3", nil, []gatewayv1.ParentReference{parentRef("gw", "")}),
+		),
+		Kind: dns.ResourceKindHTTPRoute,
+	}
+	var got []types.NamespacedName
+	for _, req := range r.enqueueRoutesFromGateway(context.Background(), newGateway("ns", "gw", nil, nil)) {
+		got = append(got, req.NamespacedName)
+	}
+	assert.ElementsMatch(t, []types.NamespacedName{{Namespace: "ns", Name: "route1"}, {Namespace: "ns2", Name: "route2"}}, got)
+}
+
+func ptrNamespace(ns string) *gatewayv1.Namespace {
+	namespace := gatewayv1.Namespace(ns)
+	return &namespace
+}
@@ -0,0 +1,131 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+)
+
+// routeKinds are the Route kinds which publish DNS records, in the order the controllers are created.
+var routeKinds = []string{dns.ResourceKindHTTPRoute, dns.ResourceKindGRPCRoute, dns.ResourceKindTLSRoute}
+
+// newRoute returns an empty Route of the kind.
+func newRoute(kind string) client.Object {
+	switch kind {
+	case dns.ResourceKindHTTPRoute:
+		return &gatewayv1.HTTPRoute{}
+	case dns.ResourceKindGRPCRoute:
+		return &gatewayv1.GRPCRoute{}
+	case dns.ResourceKindTLSRoute:
+		return &gatewayv1alpha2.TLSRoute{}
+	default:
+		return nil
+	}
+}
+
+// routeSpec returns the parent references, the hostnames and the status of the Route.
+func routeSpec(obj client.Object) ([]gatewayv1.ParentReference, []gatewayv1.Hostname, *gatewayv1.RouteStatus) {
+	switch route := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return route.Spec.ParentRefs, route.Spec.Hostnames, &route.Status.RouteStatus
+	case *gatewayv1.GRPCRoute:
+		return route.Spec.ParentRefs, route.Spec.Hostnames, &route.Status.RouteStatus
+	case *gatewayv1alpha2.TLSRoute:
+		return route.Spec.ParentRefs, route.Spec.Hostnames, &route.Status.RouteStatus
+	default:
+		return nil, nil, nil
+	}
+}
+
+// listRoutes lists the Routes of the kind.
+func listRoutes(ctx context.Context, c client.Client, kind string, opts ...client.ListOption) ([]client.Object, error) {
+	var objs []client.Object
+	switch kind {
+	case dns.ResourceKindHTTPRoute:
+		list := &gatewayv1.HTTPRouteList{}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case dns.ResourceKindGRPCRoute:
+		list := &gatewayv1.GRPCRouteList{}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	case dns.ResourceKindTLSRoute:
+		list := &gatewayv1alpha2.TLSRouteList{}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Route kind %q", kind)
+	}
+	return objs, nil
+}
+
+// parentGateway returns the Gateway of the parent reference, false if the parent is not a Gateway.
+func parentGateway(routeNamespace string, ref gatewayv1.ParentReference) (types.NamespacedName, bool) {
+	if ref.Group != nil && string(*ref.Group) != gatewayv1.GroupName {
+		return types.NamespacedName{}, false
+	}
+	if ref.Kind != nil && string(*ref.Kind) != dns.ResourceKindGateway {
+		return types.NamespacedName{}, false
+	}
+	namespace := routeNamespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}, true
+}
+
+// sameParentRef returns true if the parent references are the same with the defaults applied.
+func sameParentRef(routeNamespace string, a, b gatewayv1.ParentReference) bool {
+	gwA, okA := parentGateway(routeNamespace, a)
+	gwB, okB := parentGateway(routeNamespace, b)
+	if !okA || !okB || gwA != gwB {
+		return false
+	}
+	return sectionNameOf(a) == sectionNameOf(b) && portOf(a) == portOf(b)
+}
+
+func sectionNameOf(ref gatewayv1.ParentReference) gatewayv1.SectionName {
+	if ref.SectionName == nil {
+		return ""
+	}
+	return *ref.SectionName
+}
+
+func portOf(ref gatewayv1.ParentReference) gatewayv1.PortNumber {
+	if ref.Port == nil {
+		return 0
+	}
+	return *ref.Port
+}
+
+// referencesGateway returns true if any parent of the Route is the Gateway.
+func referencesGateway(obj client.Object, gw types.NamespacedName) bool {
+	parentRefs, _, _ := routeSpec(obj)
+	for _, ref := range parentRefs {
+		if nn, ok := parentGateway(obj.GetNamespace(), ref); ok && nn == gw {
+			return true
+		}
+	}
+	return false
+}
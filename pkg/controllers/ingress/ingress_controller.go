@@ -0,0 +1,264 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	MetricResType = common.MetricResTypeIngress
+)
+
+const (
+	reasonDNSRecordConfigured = "DNSRecordConfigured"
+	reasonDNSRecordFailed     = "DNSRecordFailed"
+)
+
+// IngressReconciler publishes the DNS records of an Ingress for the hosts of its rules, the records point to the
+// addresses in status.loadBalancer.ingress. As Ingress has no status conditions, the DNS result is reported with Events.
+type IngressReconciler struct {
+	Client    client.Client
+	Scheme    *apimachineryruntime.Scheme
+	NSXConfig *config.NSXOperatorConfig
+	DNS       dns.DNSRecordProvider
+	Recorder  record.EventRecorder
+}
+
+// ingressHostnames returns the unique hosts of the rules, the wildcard hosts are skipped as NSX doesn't publish them.
+func ingressHostnames(ing *networkingv1.Ingress) []string {
+	seen := sets.New[string]()
+	var hostnames []string
+	for _, rule := range ing.Spec.Rules {
+		host := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(rule.Host), "."))
+		if host == "" || strings.HasPrefix(host, "*.") || seen.Has(host) {
+			continue
+		}
+		seen.Insert(host)
+		hostnames = append(hostnames, host)
+	}
+	return hostnames
+}
+
+// targetsFromIngressLoadBalancer collects IP and Hostname values from Ingress.Status.LoadBalancer.Ingress.
+func targetsFromIngressLoadBalancer(ingress []networkingv1.IngressLoadBalancerIngress) extdns.Targets {
+	vals := make([]string, 0, len(ingress))
+	for _, lb := range ingress {
+		if ip := strings.TrimSpace(lb.IP); ip != "" {
+			vals = append(vals, ip)
+		}
+		if hn := strings.TrimSpace(lb.Hostname); hn != "" {
+			vals = append(vals, hn)
+		}
+	}
+	return extdns.NewTargets(vals...)
+}
+
+// buildIngressDNSBatch builds the owner-scoped DNS rows of the Ingress, then validates them with the allowed DNS zones
+// of the namespace. The batch may have valid rows even if a DNSZoneValidationError is returned.
+func buildIngressDNSBatch(ing *networkingv1.Ingress, w dns.DNSRecordProvider) (*dns.AggregatedDNSEndpoints, error) {
+	hostnames := ingressHostnames(ing)
+	if len(hostnames) == 0 {
+		return nil, nil
+	}
+	targets := targetsFromIngressLoadBalancer(ing.Status.LoadBalancer.Ingress)
+	if len(targets) == 0 {
+		log.Debug("Ingress has hosts but no load balancer address yet", "namespace", ing.Namespace, "name", ing.Name)
+		return nil, nil
+	}
+	owner := &dns.ResourceRef{Kind: dns.ResourceKindIngress, Object: ing.GetObjectMeta()}
+	return common.BuildDNSBatch(w, owner, common.EndpointsForHostnames(hostnames, targets, dns.RecordTTLFromAnnotations(ing)))
+}
+
+func (r *IngressReconciler) deleteDNSForIngress(ctx context.Context, key types.NamespacedName, op string) error {
+	if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindIngress, key.Namespace, key.Name); err != nil {
+		log.Error(err, "Failed to delete DNS records for Ingress", "Ingress", key, "Operation", op)
+		return fmt.Errorf("deleting DNS records for %s: %w", op, err)
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+func (r *IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ing := &networkingv1.Ingress{}
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling Ingress DNS", "Ingress", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, ing); err != nil {
+		if apierrors.IsNotFound(err) {
+			if err := r.deleteDNSForIngress(ctx, req.NamespacedName, "deleted Ingress"); err != nil {
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch Ingress", "Ingress", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	if !ing.DeletionTimestamp.IsZero() || common.DNSSkipped(ing) {
+		if err := r.deleteDNSForIngress(ctx, req.NamespacedName, "terminating or skipped Ingress"); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling Ingress DNS", "Ingress", req.NamespacedName)
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
+	if err := r.reconcileIngressDNS(ctx, ing); err != nil {
+		metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateFailTotal, MetricResType)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	metrics.CounterInc(r.NSXConfig, metrics.ControllerUpdateSuccessTotal, MetricResType)
+	return ResultNormal, nil
+}
+
+// reconcileIngressDNS applies the DNS rows of the Ingress. DNS zone validation errors are reported with a Warning
+// Event without requeue, as the Ingress or the allowed DNS domains must be updated to fix them.
+func (r *IngressReconciler) reconcileIngressDNS(ctx context.Context, ing *networkingv1.Ingress) error {
+	key := types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}
+	batch, err := buildIngressDNSBatch(ing, r.DNS)
+	if err != nil {
+		var zoneValErr *dns.DNSZoneValidationError
+		if !errors.As(err, &zoneValErr) {
+			log.Error(err, "Failed to build DNS endpoints for Ingress", "Ingress", key)
+			r.Recorder.Event(ing, v1.EventTypeWarning, reasonDNSRecordFailed, err.Error())
+			return err
+		}
+		log.Error(err, "Failed to validate DNS records for Ingress with the allowed DNS zones", "Ingress", key)
+		r.Recorder.Event(ing, v1.EventTypeWarning, reasonDNSRecordFailed, err.Error())
+	}
+	if batch == nil || len(batch.Rows) == 0 {
+		return r.deleteDNSForIngress(ctx, key, "stale DNS records")
+	}
+	updated, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch)
+	if uErr != nil {
+		log.Error(uErr, "Failed to reconcile DNS records", "Ingress", key)
+		r.Recorder.Event(ing, v1.EventTypeWarning, reasonDNSRecordFailed, uErr.Error())
+		return uErr
+	}
+	if updated && err == nil {
+		r.Recorder.Event(ing, v1.EventTypeNormal, reasonDNSRecordConfigured, "DNS records have been successfully configured")
+	}
+	return nil
+}
+
+// listIngressesWithDNS returns the Ingresses which should have DNS records.
+func listIngressesWithDNS(ctx context.Context, c client.Client, listOpts ...client.ListOption) ([]networkingv1.Ingress, error) {
+	ingList := &networkingv1.IngressList{}
+	if err := c.List(ctx, ingList, listOpts...); err != nil {
+		return nil, err
+	}
+	var filtered []networkingv1.Ingress
+	for _, ing := range ingList.Items {
+		if !ing.DeletionTimestamp.IsZero() || common.DNSSkipped(&ing) || len(ingressHostnames(&ing)) == 0 {
+			continue
+		}
+		filtered = append(filtered, ing)
+	}
+	return filtered, nil
+}
+
+// enqueueIngressesFromNetworkInfo requeues the Ingresses which publish DNS when namespace AllowedDNSDomains change.
+func (r *IngressReconciler) enqueueIngressesFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	ingresses, err := listIngressesWithDNS(ctx, r.Client, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		log.Error(err, "Failed to list Ingresses for NetworkInfo DNS domain change", "Namespace", obj.GetNamespace())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ing := range ingresses {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}})
+	}
+	return reqs
+}
+
+func (r *IngressReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1.Ingress{}).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueIngressesFromNetworkInfo),
+			builder.WithPredicates(common.PredicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.NSXConfig, MetricResType),
+			}).
+		Complete(r)
+}
+
+func (r *IngressReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *IngressReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "Ingress")
+		return err
+	}
+	if err := common.AddGarbageCollector(mgr, r.CollectGarbage); err != nil {
+		log.Error(err, "Failed to add Ingress DNS GC to manager")
+		return err
+	}
+	return nil
+}
+
+// CollectGarbage deletes the DNS records of the Ingresses which are deleted, skipped or have no host.
+func (r *IngressReconciler) CollectGarbage(ctx context.Context) error {
+	ingresses, err := listIngressesWithDNS(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "Ingress DNS GC: failed to list Ingresses")
+		return err
+	}
+	apiSet := sets.New[types.NamespacedName]()
+	for _, ing := range ingresses {
+		apiSet.Insert(types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name})
+	}
+	return common.CollectDNSGarbage(ctx, r.DNS, dns.ResourceKindIngress, apiSet)
+}
+
+// NewIngressReconciler returns the Ingress DNS reconciler, or nil if the DNS service is not initialized.
+func NewIngressReconciler(mgr ctrl.Manager, cf *config.NSXOperatorConfig, dnsRecordService *dns.DNSRecordService) *IngressReconciler {
+	if dnsRecordService == nil {
+		return nil
+	}
+	return &IngressReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		NSXConfig: cf,
+		DNS:       dnsRecordService,
+		Recorder:  mgr.GetEventRecorderFor("ingress-dns-controller"), //nolint:staticcheck // record.EventRecorder
+	}
+}
@@ -0,0 +1,190 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func newIngress(name string, annotations map[string]string, addresses []string, hosts ...string) *networkingv1.Ingress {
+	ing := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Annotations: annotations}}
+	for _, h := range hosts {
+		ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{Host: h})
+	}
+	for _, addr := range addresses {
+		ing.Status.LoadBalancer.Ingress = append(ing.Status.LoadBalancer.Ingress, networkingv1.IngressLoadBalancerIngress{IP: addr})
+	}
+	return ing
+}
+
+func newFakeIngressReconciler(t *testing.T, m dns.DNSRecordProvider, objs ...client.Object) *IngressReconciler {
+	s := runtime.NewScheme()
+	require.NoError(t, networkingv1.AddToScheme(s))
+	return &IngressReconciler{
+		Client:    fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+		Scheme:    s,
+		NSXConfig: &config.NSXOperatorConfig{},
+		DNS:       m,
+		Recorder:  record.NewFakeRecorder(10),
+	}
+}
+
+func validRows(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+	rows := make([]dns.EndpointRow, 0, len(eps))
+	for _, ep := range eps {
+		rows = append(rows, *dns.NewEndpointRow(ep, "/zones/example", ep.DNSName))
+	}
+	return rows, nil, nil
+}
+
+func TestIngressHostnames(t *testing.T) {
+	ing := newIngress("ing", nil, nil, "A.example.com.", "a.example.com", "*.example.com", "", "b.example.com")
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, ingressHostnames(ing))
+}
+
+func TestIngressReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "ing"}}
+
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		setupMock  func(m *mockdns.MockDNSRecordProvider)
+		wantRes    ctrl.Result
+		wantEvents int
+	}{
+		{
+			name: "not_found_deletes_dns",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "ing").Return(true, nil)
+			},
+			wantRes: ResultNormal,
+		},
+		{
+			name: "not_found_deletes_dns_error",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "ing").Return(false, fmt.Errorf("mock err"))
+			},
+			wantRes: common.ResultRequeueAfter10sec,
+		},
+		{
+			name: "skip_annotation_clears_dns",
+			objs: []client.Object{newIngress("ing", map[string]string{servicecommon.AnnotationsDNSSkip: "true"}, []string{"10.0.0.1"}, "a.example.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "ing").Return(true, nil)
+			},
+			wantRes: ResultNormal,
+		},
+		{
+			name: "no_address_deletes_stale_records",
+			objs: []client.Object{newIngress("ing", nil, nil, "a.example.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "ing").Return(false, nil)
+			},
+			wantRes: ResultNormal,
+		},
+		{
+			name: "records_published",
+			objs: []client.Object{newIngress("ing", nil, []string{"10.0.0.1"}, "a.example.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).DoAndReturn(validRows)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, batch *dns.AggregatedDNSEndpoints) (bool, error) {
+					assert.Equal(t, dns.ResourceKindIngress, batch.Owner.Kind)
+					require.Len(t, batch.Rows, 1)
+					assert.Equal(t, "a.example.com", batch.Rows[0].DNSName)
+					assert.Equal(t, extdns.Targets{"10.0.0.1"}, batch.Rows[0].Targets)
+					return true, nil
+				})
+			},
+			wantRes:    ResultNormal,
+			wantEvents: 1,
+		},
+		{
+			name: "create_records_error",
+			objs: []client.Object{newIngress("ing", nil, []string{"10.0.0.1"}, "a.example.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).DoAndReturn(validRows)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("mock err"))
+			},
+			wantRes:    common.ResultRequeueAfter10sec,
+			wantEvents: 1,
+		},
+		{
+			name: "zone_validation_error_not_requeued",
+			objs: []client.Object{newIngress("ing", nil, []string{"10.0.0.1"}, "a.other.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).Return(nil, nil, &dns.DNSZoneValidationError{Msg: "no DNS zones are permitted"})
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "ing").Return(false, nil)
+			},
+			wantRes:    ResultNormal,
+			wantEvents: 1,
+		},
+		{
+			name: "build_error_requeued",
+			objs: []client.Object{newIngress("ing", nil, []string{"10.0.0.1"}, "a.example.com")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns", gomock.Any(), gomock.Any()).Return(nil, nil, fmt.Errorf("mock err"))
+			},
+			wantRes:    common.ResultRequeueAfter10sec,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			if tt.setupMock != nil {
+				tt.setupMock(m)
+			}
+			r := newFakeIngressReconciler(t, m, tt.objs...)
+			res, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRes, res)
+			assert.Len(t, r.Recorder.(*record.FakeRecorder).Events, tt.wantEvents)
+		})
+	}
+}
+
+func TestIngressReconciler_CollectGarbage(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+		dns.ResourceKindIngress: sets.New(
+			types.NamespacedName{Namespace: "ns", Name: "ing"},
+			types.NamespacedName{Namespace: "ns", Name: "deleted"},
+			types.NamespacedName{Namespace: "ns", Name: "skipped"},
+			types.NamespacedName{Namespace: "ns", Name: "nohost"},
+		),
+	})
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "deleted").Return(true, nil)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "skipped").Return(true, nil)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns", "nohost").Return(false, fmt.Errorf("mock err"))
+
+	r := newFakeIngressReconciler(t, m,
+		newIngress("ing", nil, nil, "a.example.com"),
+		newIngress("skipped", map[string]string{servicecommon.AnnotationsDNSSkip: "true"}, nil, "b.example.com"),
+		newIngress("nohost", nil, nil),
+	)
+	err := r.CollectGarbage(context.Background())
+	assert.ErrorContains(t, err, "mock err")
+}
@@ -17,6 +17,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -42,7 +45,10 @@ type IPAddressAllocationReconciler struct {
 	VPCService    servicecommon.VPCServiceProvider
 	Recorder      record.EventRecorder
 	StatusUpdater common.StatusUpdater
-	restoreMode   bool
+	// DriftEvents receives the IPAddressAllocations owning the drifted NSX resources to requeue, nil if the drift
+	// audit doesn't requeue.
+	DriftEvents chan event.GenericEvent
+	restoreMode bool
 }
 
 func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, _ ...interface{}) {
@@ -166,13 +172,17 @@ func (r *IPAddressAllocationReconciler) handleDeletion(req ctrl.Request, obj *v1
 }
 
 func (r *IPAddressAllocationReconciler) setupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.IPAddressAllocation{}).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
-			}).
-		Complete(r)
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
+			})
+	if r.DriftEvents != nil {
+		b = b.WatchesRawSource(source.Channel(r.DriftEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return b.Complete(r)
 }
 
 func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) error {
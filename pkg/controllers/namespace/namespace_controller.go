@@ -333,6 +333,7 @@ func (r *NamespaceReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.NSXConfig, common.MetricResTypeNamespace),
 			}).
 		Watches(
 			&v1alpha1.VPCNetworkConfiguration{},
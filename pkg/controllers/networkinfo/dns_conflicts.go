@@ -0,0 +1,55 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package networkinfo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+// dnsConflictReportInterval is the interval to report the DNS conflicts on NetworkInfo.
+const dnsConflictReportInterval = time.Minute
+
+// dnsConflictLister lists the FQDNs rejected because the DNS records are owned by other resources, grouped by the
+// namespace of the rejected resources.
+type dnsConflictLister interface {
+	ListDNSConflicts() map[string][]v1alpha1.DNSConflict
+}
+
+// reportDNSConflicts sets the DNSConflicts of each NetworkInfo to the DNS conflicts of the resources in its
+// namespace, so that the contested FQDNs of the cluster can be listed from the NetworkInfos.
+func (r *NetworkInfoReconciler) reportDNSConflicts(ctx context.Context, lister dnsConflictLister) error {
+	conflicts := lister.ListDNSConflicts()
+	networkInfos := &v1alpha1.NetworkInfoList{}
+	if err := r.Client.List(ctx, networkInfos); err != nil {
+		log.Error(err, "Failed to list NetworkInfos to report DNS conflicts")
+		return err
+	}
+	var errList []error
+	for i := range networkInfos.Items {
+		networkInfo := &networkInfos.Items[i]
+		desired := conflicts[networkInfo.Namespace]
+		// Semantic.DeepEqual treats nil and empty slices as equal.
+		if equality.Semantic.DeepEqual(networkInfo.DNSConflicts, desired) {
+			continue
+		}
+		networkInfo.DNSConflicts = desired
+		// A conflicting update is retried in the next report.
+		if err := r.Client.Update(ctx, networkInfo); err != nil {
+			log.Error(err, "Failed to update DNS conflicts on NetworkInfo", "Namespace", networkInfo.Namespace, "NetworkInfo", networkInfo.Name)
+			errList = append(errList, err)
+			continue
+		}
+		log.Info("Updated DNS conflicts on NetworkInfo", "Namespace", networkInfo.Namespace, "NetworkInfo", networkInfo.Name, "conflicts", len(desired))
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in reporting DNS conflicts: %s", errList)
+	}
+	return nil
+}
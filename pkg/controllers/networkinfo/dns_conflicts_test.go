@@ -0,0 +1,44 @@
+package networkinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+type fakeDNSConflictLister map[string][]v1alpha1.DNSConflict
+
+func (l fakeDNSConflictLister) ListDNSConflicts() map[string][]v1alpha1.DNSConflict {
+	return l
+}
+
+func TestNetworkInfoReconciler_reportDNSConflicts(t *testing.T) {
+	ctx := context.Background()
+	conflict := v1alpha1.DNSConflict{
+		FQDN:       "a.example.com",
+		RecordType: "A",
+		Owner:      v1alpha1.DNSRecordOwner{Kind: "Service", Namespace: "ns2", Name: "svc", Cluster: "other"},
+		Claimants:  []v1alpha1.DNSRecordOwner{{Kind: "Gateway", Namespace: "ns1", Name: "gw", Cluster: "unit-test"}},
+		Reason:     "DNS name a in DNS zone /zones/example is owned by cluster other",
+	}
+	r := createNetworkInfoReconciler([]client.Object{
+		&v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ns1"}},
+		&v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "ns2"}, DNSConflicts: []v1alpha1.DNSConflict{conflict}},
+	})
+
+	require.NoError(t, r.reportDNSConflicts(ctx, fakeDNSConflictLister{"ns1": {conflict}, "ns3": {conflict}}))
+
+	got := &v1alpha1.NetworkInfo{}
+	require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "ns1"}, got))
+	assert.Equal(t, []v1alpha1.DNSConflict{conflict}, got.DNSConflicts)
+	// The resolved conflicts are removed.
+	require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns2", Name: "ns2"}, got))
+	assert.Empty(t, got.DNSConflicts)
+}
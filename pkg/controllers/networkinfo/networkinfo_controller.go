@@ -813,9 +813,9 @@ func (r *NetworkInfoReconciler) syncPreCreatedVpcs(ctx context.Context) {
 
 func (r *NetworkInfoReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
 	if r.queue == nil {
-		r.queue = workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+		r.queue = common.WithQueueMetrics(r.StatusUpdater.NSXConfig, MetricResType, workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
 			Name: controllerName,
-		})
+		}))
 	}
 	return r.queue
 }
@@ -921,6 +921,11 @@ func (r *NetworkInfoReconciler) StartController(mgr ctrl.Manager, _ webhook.Serv
 		return err
 	}
 	go common.GenericGarbageCollector(make(chan bool), commonservice.GCInterval, r.CollectGarbage)
+	if lister, ok := r.DNSRecordService.(dnsConflictLister); ok {
+		go common.GenericGarbageCollector(make(chan bool), dnsConflictReportInterval, func(ctx context.Context) error {
+			return r.reportDNSConflicts(ctx, lister)
+		})
+	}
 	return nil
 }
 
@@ -161,6 +161,7 @@ func (r *NetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
 			}).
 		Complete(r)
 }
@@ -230,6 +230,7 @@ func (r *NSXServiceAccountReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
 			}).
 		Watches(
 			&corev1.Service{},
@@ -5,6 +5,7 @@ package pod
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -24,7 +25,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -54,6 +57,9 @@ type PodReconciler struct {
 	Recorder          record.EventRecorder
 	StatusUpdater     common.StatusUpdater
 	restoreMode       bool
+	// DriftEvents receives the Pods owning the drifted NSX SubnetPorts to requeue, nil if the drift audit doesn't
+	// requeue.
+	DriftEvents chan event.GenericEvent
 }
 
 func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -128,6 +134,15 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 				return common.ResultNormal, err
 			}
 		}
+		if !r.restoreMode && hasPodNetworks(pod) {
+			if err = r.reconcilePodNetworks(ctx, pod); err != nil {
+				r.StatusUpdater.UpdateFail(ctx, pod, err, "Failed to reconcile the additional Pod networks", nil)
+				if errors.Is(err, errInvalidPodNetworks) {
+					return common.ResultNormal, nil
+				}
+				return common.ResultRequeue, err
+			}
+		}
 		r.StatusUpdater.UpdateSuccess(ctx, pod, nil)
 		if r.restoreMode && !nsx.RestoreVifFeatureEnabled(r.SubnetPortService.NSXClient, r.SubnetPortService.NSXConfig) {
 			// Update restore status on Pod to notify Spherelet for NSX version < 9.2
@@ -150,7 +165,12 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 					"pod", subnetPort.DisplayName, "statefulset-uid", r.getStsUID(subnetPort))
 				return common.ResultNormal, nil
 			}
-			if err := r.SubnetPortService.DeleteSubnetPort(subnetPort); err != nil {
+			if subnetport.IsStickySubnetPort(subnetPort) {
+				if err := r.SubnetPortService.ReleaseStickySubnetPort(subnetPort); err != nil {
+					r.StatusUpdater.DeleteFail(req.NamespacedName, pod, err)
+					return common.ResultRequeue, err
+				}
+			} else if err := r.SubnetPortService.DeleteSubnetPort(subnetPort); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, pod, err)
 				return common.ResultRequeue, err
 			}
@@ -265,14 +285,20 @@ func (r *PodReconciler) GetNodeByName(nodeName string) (*model.HostTransportNode
 
 // setupWithManager sets up the controller with the Manager.
 func (r *PodReconciler) setupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Pod{}).
+		// Watch the SubnetPort CRs created for the additional Pod networks to publish their addresses on the Pod.
+		Owns(&v1alpha1.SubnetPort{}).
 		WithEventFilter(PredicateFuncsPod).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
-			}).
-		Complete(r)
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypePod),
+			})
+	if r.DriftEvents != nil {
+		b = b.WatchesRawSource(source.Channel(r.DriftEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return b.Complete(r)
 }
 
 func (r *PodReconciler) RestoreReconcile() error {
@@ -383,6 +409,20 @@ func (r *PodReconciler) CollectGarbage(ctx context.Context) error {
 				log.Info("Skipping pod GC for StatefulSet pod subnet port", "NSXSubnetPortID", elem, "statefulset-uid", r.getStsUID(nsxSubnetPort))
 				continue
 			}
+			// The sticky SubnetPort is kept for the grace period after its Pod is deleted. The Pod may be deleted
+			// while the operator is down, in which case the grace period starts now.
+			if nsxSubnetPort := store.GetByKey(elem); nsxSubnetPort != nil && subnetport.IsStickySubnetPort(nsxSubnetPort) {
+				if !subnetport.IsStickySubnetPortReleased(nsxSubnetPort) {
+					if err = r.SubnetPortService.ReleaseStickySubnetPort(nsxSubnetPort); err != nil {
+						errList = append(errList, err)
+					}
+					continue
+				}
+				if !subnetport.StickySubnetPortExpired(nsxSubnetPort, time.Now()) {
+					continue
+				}
+				log.Info("Grace period of sticky subnet port expired", "NSXSubnetPortID", elem)
+			}
 		}
 		log.Debug("GC collected Pod", "NSXSubnetPortID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
@@ -416,6 +456,26 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 		log.Debug("NSX SubnetPort had been created, returning the existing NSX Subnet path", "pod.UID", pod.UID, "subnetPath", subnetPath)
 		return true, subnetPath, subnetSetUID, subnetSetLock, "", nil
 	}
+	if stickySubnetPort := r.SubnetPortService.ClaimStickySubnetPort(pod); stickySubnetPort != nil && stickySubnetPort.ParentPath != nil {
+		log.Info("Reusing sticky NSX SubnetPort, returning its NSX Subnet path", "pod.UID", pod.UID, "subnetPath", *stickySubnetPort.ParentPath)
+		return true, *stickySubnetPort.ParentPath, subnetSetUID, subnetSetLock, "", nil
+	}
+	staticIPSubnet, staticIP, err := common.GetStatefulSetStaticIPForPod(ctx, r.Client, pod)
+	if err != nil {
+		return false, "", subnetSetUID, subnetSetLock, "", err
+	}
+	if staticIPSubnet != "" {
+		subnetPath, err = r.getStaticIPSubnetPath(ctx, pod.Namespace, staticIPSubnet)
+		if err != nil {
+			return false, "", subnetSetUID, subnetSetLock, "", err
+		}
+		interfaceIPType := v1alpha1.IPAddressTypeIPv4
+		if util.IsIPv6(staticIP) {
+			interfaceIPType = v1alpha1.IPAddressTypeIPv6
+		}
+		log.Info("Got the static IP Subnet for StatefulSet Pod", "nsxSubnetPath", subnetPath, "staticIP", staticIP, "pod.Name", pod.Name, "pod.UID", pod.UID)
+		return true, subnetPath, subnetSetUID, subnetSetLock, interfaceIPType, nil
+	}
 	subnetSet, err := common.GetDefaultSubnetSetByNamespace(r.SubnetPortService.Client, pod.Namespace, servicecommon.DefaultPodNetwork)
 	if err != nil {
 		return false, "", subnetSetUID, subnetSetLock, "", err
@@ -446,6 +506,23 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 	return false, subnetPath, subnetSetUID, subnetSetLock, interfacetype, nil
 }
 
+// getStaticIPSubnetPath returns the NSX Subnet path of the Subnet which the static IPs of a StatefulSet are from.
+func (r *PodReconciler) getStaticIPSubnetPath(ctx context.Context, ns string, subnetName string) (string, error) {
+	subnetCR := &v1alpha1.Subnet{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: subnetName}, subnetCR); err != nil {
+		log.Error(err, "Failed to get the static IP Subnet", "Namespace", ns, "Subnet", subnetName)
+		return "", err
+	}
+	if !subnetCR.DeletionTimestamp.IsZero() {
+		return "", fmt.Errorf("static IP Subnet %s/%s is being deleted", ns, subnetName)
+	}
+	nsxSubnet, err := r.SubnetService.GetSubnetByCR(subnetCR)
+	if err != nil {
+		return "", err
+	}
+	return *nsxSubnet.Path, nil
+}
+
 func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string, name string) error {
 	// NamespacedName is a unique identity in store as only one worker can deal with the NamespacedName at a time
 	nsxSubnetPorts := r.SubnetPortService.ListSubnetPortByPodName(ns, name)
@@ -458,6 +535,12 @@ func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string
 				"pod", name, "statefulset-uid", r.getStsUID(nsxSubnetPort))
 			continue // Skip deletion
 		}
+		if subnetport.IsStickySubnetPort(nsxSubnetPort) {
+			if err := r.SubnetPortService.ReleaseStickySubnetPort(nsxSubnetPort); err != nil {
+				return err
+			}
+			continue
+		}
 
 		// Normal pod: delete the subnet port
 		if err := r.SubnetPortService.DeleteSubnetPort(nsxSubnetPort); err != nil {
@@ -810,6 +810,44 @@ func TestPodReconciler_deleteSubnetPortByPodName(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPodReconciler_deleteSubnetPortByPodName_Sticky(t *testing.T) {
+	stickyPort := &model.VpcSubnetPort{
+		Id: servicecommon.String("subnetport-1"),
+		Tags: []model.Tag{
+			{Scope: servicecommon.String(servicecommon.TagScopeNamespace), Tag: servicecommon.String("ns")},
+			{Scope: servicecommon.String(servicecommon.TagScopePodName), Tag: servicecommon.String("pod-1")},
+			{Scope: servicecommon.String(servicecommon.TagScopeStickyIdentity), Tag: servicecommon.String("Pod/pod-1")},
+		},
+	}
+	r := &PodReconciler{
+		SubnetPortService: &subnetport.SubnetPortService{},
+	}
+	patches := gomonkey.ApplyFunc((*subnetport.SubnetPortStore).GetByIndex,
+		func(s *subnetport.SubnetPortStore, key string, value string) []*model.VpcSubnetPort {
+			return []*model.VpcSubnetPort{stickyPort}
+		})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService.NSXClient), "NSXCheckVersion",
+		func(_ *nsx.Client, _ int) bool {
+			return false
+		})
+	patches.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
+		func(s *subnetport.SubnetPortService, sp *model.VpcSubnetPort) error {
+			assert.FailNow(t, "sticky subnet port should not be deleted")
+			return nil
+		})
+	released := false
+	patches.ApplyFunc((*subnetport.SubnetPortService).ReleaseStickySubnetPort,
+		func(s *subnetport.SubnetPortService, sp *model.VpcSubnetPort) error {
+			assert.Equal(t, stickyPort, sp)
+			released = true
+			return nil
+		})
+	err := r.deleteSubnetPortByPodName(context.TODO(), "ns", "pod-1")
+	assert.Nil(t, err)
+	assert.True(t, released)
+}
+
 func TestPodReconciler_StartController(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().WithObjects().Build()
 	vpcService := &vpc.VPCService{
@@ -0,0 +1,268 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	// primaryInterface is the interface of the SubnetPort created on the default Pod SubnetSet.
+	primaryInterface = "eth0"
+	// podNetworkKindSubnet and podNetworkKindSubnetSet are the kinds in the short form of the networks annotation.
+	podNetworkKindSubnet    = "subnet"
+	podNetworkKindSubnetSet = "subnetset"
+)
+
+var (
+	errInvalidPodNetworks = errors.New("invalid Pod networks annotation")
+	// interfaceNameRegex matches a Linux interface name which is also valid in a K8s resource name.
+	interfaceNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,13}[a-z0-9])?$`)
+)
+
+// PodNetworkSelection is an entry of the nsx.vmware.com/networks Pod annotation. It references the Subnet or
+// SubnetSet in the Pod Namespace which an additional interface of the Pod is attached to.
+type PodNetworkSelection struct {
+	Subnet    string `json:"subnet,omitempty"`
+	SubnetSet string `json:"subnetSet,omitempty"`
+	// Interface is the name of the interface in the Pod, it is eth1, eth2... in the order of the entries by default.
+	Interface string `json:"interface,omitempty"`
+}
+
+// PodNetworkStatus is an entry of the nsx.vmware.com/network-status Pod annotation.
+type PodNetworkStatus struct {
+	Interface    string                               `json:"interface"`
+	Subnet       string                               `json:"subnet,omitempty"`
+	SubnetSet    string                               `json:"subnetSet,omitempty"`
+	SubnetPort   string                               `json:"subnetPort"`
+	IPAddresses  []v1alpha1.NetworkInterfaceIPAddress `json:"ipAddresses,omitempty"`
+	MACAddress   string                               `json:"macAddress,omitempty"`
+	AttachmentID string                               `json:"attachmentID,omitempty"`
+	Ready        bool                                 `json:"ready"`
+}
+
+// hasPodNetworks checks if the Pod requests additional interfaces, or had additional interfaces which may need to be
+// removed.
+func hasPodNetworks(pod *v1.Pod) bool {
+	annotations := pod.GetAnnotations()
+	_, requested := annotations[servicecommon.AnnotationPodNetworks]
+	_, published := annotations[servicecommon.AnnotationPodNetworkStatus]
+	return requested || published
+}
+
+// parsePodNetworks parses the nsx.vmware.com/networks annotation. Like the Multus network selection, the annotation is
+// either a JSON list of PodNetworkSelection, or a comma-separated list of "[subnet|subnetset/]<name>[@<interface>]",
+// where a name without the kind refers to a Subnet.
+func parsePodNetworks(value string) ([]PodNetworkSelection, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	var selections []PodNetworkSelection
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &selections); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidPodNetworks, err)
+		}
+	} else {
+		for _, entry := range strings.Split(value, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			selection := PodNetworkSelection{}
+			entry, selection.Interface, _ = strings.Cut(entry, "@")
+			kind, name, found := strings.Cut(entry, "/")
+			if !found {
+				kind, name = podNetworkKindSubnet, entry
+			}
+			switch strings.ToLower(kind) {
+			case podNetworkKindSubnet:
+				selection.Subnet = name
+			case podNetworkKindSubnetSet:
+				selection.SubnetSet = name
+			default:
+				return nil, fmt.Errorf("%w: unsupported kind %q in %q", errInvalidPodNetworks, kind, entry)
+			}
+			selections = append(selections, selection)
+		}
+	}
+
+	interfaces := map[string]bool{primaryInterface: true}
+	for i := range selections {
+		selection := &selections[i]
+		if (selection.Subnet == "") == (selection.SubnetSet == "") {
+			return nil, fmt.Errorf("%w: exactly one of subnet or subnetSet must be set in entry %d", errInvalidPodNetworks, i)
+		}
+		if selection.Interface == "" {
+			selection.Interface = fmt.Sprintf("eth%d", i+1)
+		}
+		if !interfaceNameRegex.MatchString(selection.Interface) {
+			return nil, fmt.Errorf("%w: invalid interface name %q", errInvalidPodNetworks, selection.Interface)
+		}
+		if interfaces[selection.Interface] {
+			return nil, fmt.Errorf("%w: duplicated interface name %q", errInvalidPodNetworks, selection.Interface)
+		}
+		interfaces[selection.Interface] = true
+	}
+	return selections, nil
+}
+
+// reconcilePodNetworks creates a SubnetPort CR owned by the Pod for each additional interface in the networks
+// annotation, deletes the SubnetPort CRs of the interfaces removed from the annotation, and publishes the addresses
+// of the interfaces in the network-status annotation. The NSX SubnetPorts are realized by the SubnetPort controller,
+// and the SubnetPort CRs are garbage collected with the Pod.
+func (r *PodReconciler) reconcilePodNetworks(ctx context.Context, pod *v1.Pod) error {
+	selections, err := parsePodNetworks(pod.GetAnnotations()[servicecommon.AnnotationPodNetworks])
+	if err != nil {
+		return err
+	}
+	existingPorts, err := r.listPodNetworkSubnetPorts(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	var statuses []PodNetworkStatus
+	for _, selection := range selections {
+		subnetPort, ok := existingPorts[selection.Interface]
+		delete(existingPorts, selection.Interface)
+		// The parent Subnet of a SubnetPort can't be changed, so the SubnetPort is recreated instead.
+		if ok && (subnetPort.Spec.Subnet != selection.Subnet || subnetPort.Spec.SubnetSet != selection.SubnetSet) {
+			log.Info("Recreating SubnetPort for the changed Pod network", "Pod", pod.Name, "Namespace", pod.Namespace, "interface", selection.Interface)
+			if err := r.Client.Delete(ctx, subnetPort); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			ok = false
+		}
+		if !ok {
+			subnetPort, err = r.buildPodNetworkSubnetPort(pod, selection)
+			if err != nil {
+				return err
+			}
+			if err := r.Client.Create(ctx, subnetPort); err != nil && !apierrors.IsAlreadyExists(err) {
+				log.Error(err, "Failed to create SubnetPort for Pod network", "Pod", pod.Name, "Namespace", pod.Namespace, "interface", selection.Interface)
+				return err
+			}
+			log.Info("Created SubnetPort for Pod network", "Pod", pod.Name, "Namespace", pod.Namespace, "SubnetPort", subnetPort.Name)
+		}
+		statuses = append(statuses, buildPodNetworkStatus(selection, subnetPort))
+	}
+	for _, subnetPort := range existingPorts {
+		log.Info("Deleting SubnetPort of the removed Pod network", "Pod", pod.Name, "Namespace", pod.Namespace, "SubnetPort", subnetPort.Name)
+		if err := r.Client.Delete(ctx, subnetPort); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return updatePodNetworkStatus(ctx, r.Client, pod, statuses)
+}
+
+// listPodNetworkSubnetPorts returns the SubnetPort CRs owned by the Pod, keyed by the interface name.
+func (r *PodReconciler) listPodNetworkSubnetPorts(ctx context.Context, pod *v1.Pod) (map[string]*v1alpha1.SubnetPort, error) {
+	subnetPortList := &v1alpha1.SubnetPortList{}
+	if err := r.Client.List(ctx, subnetPortList, client.InNamespace(pod.Namespace)); err != nil {
+		return nil, err
+	}
+	subnetPorts := make(map[string]*v1alpha1.SubnetPort)
+	for i := range subnetPortList.Items {
+		subnetPort := &subnetPortList.Items[i]
+		if owner := metav1.GetControllerOf(subnetPort); owner == nil || owner.UID != pod.UID {
+			continue
+		}
+		if iface, ok := subnetPort.Annotations[servicecommon.AnnotationPodInterface]; ok {
+			subnetPorts[iface] = subnetPort
+		}
+	}
+	return subnetPorts, nil
+}
+
+func (r *PodReconciler) buildPodNetworkSubnetPort(pod *v1.Pod, selection PodNetworkSelection) (*v1alpha1.SubnetPort, error) {
+	subnetPort := &v1alpha1.SubnetPort{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        podNetworkSubnetPortName(pod, selection.Interface),
+			Namespace:   pod.Namespace,
+			Annotations: map[string]string{servicecommon.AnnotationPodInterface: selection.Interface},
+		},
+		Spec: v1alpha1.SubnetPortSpec{
+			Subnet:    selection.Subnet,
+			SubnetSet: selection.SubnetSet,
+		},
+	}
+	if err := controllerutil.SetControllerReference(pod, subnetPort, r.Scheme); err != nil {
+		return nil, err
+	}
+	return subnetPort, nil
+}
+
+// podNetworkSubnetPortName returns "<Pod name>-<interface>", the Pod name is replaced by a hash if the name is too long.
+func podNetworkSubnetPortName(pod *v1.Pod, iface string) string {
+	name := fmt.Sprintf("%s-%s", pod.Name, iface)
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	podName := pod.Name[:validation.DNS1123SubdomainMaxLength-len(iface)-10]
+	return fmt.Sprintf("%s-%s-%s", podName, util.Sha1(string(pod.UID))[:8], iface)
+}
+
+func buildPodNetworkStatus(selection PodNetworkSelection, subnetPort *v1alpha1.SubnetPort) PodNetworkStatus {
+	status := PodNetworkStatus{
+		Interface:    selection.Interface,
+		Subnet:       selection.Subnet,
+		SubnetSet:    selection.SubnetSet,
+		SubnetPort:   subnetPort.Name,
+		MACAddress:   subnetPort.Status.NetworkInterfaceConfig.MACAddress,
+		AttachmentID: subnetPort.Status.Attachment.ID,
+	}
+	for _, address := range subnetPort.Status.NetworkInterfaceConfig.IPAddresses {
+		if address.IPAddress != "" || address.Gateway != "" {
+			status.IPAddresses = append(status.IPAddresses, address)
+		}
+	}
+	for _, condition := range subnetPort.Status.Conditions {
+		if condition.Type == v1alpha1.Ready {
+			status.Ready = condition.Status == v1.ConditionTrue
+		}
+	}
+	return status
+}
+
+// updatePodNetworkStatus sets the network-status annotation of the Pod, or removes it if the Pod has no additional
+// interfaces.
+func updatePodNetworkStatus(ctx context.Context, client client.Client, pod *v1.Pod, statuses []PodNetworkStatus) error {
+	value := ""
+	if len(statuses) > 0 {
+		sort.Slice(statuses, func(i, j int) bool {
+			return statuses[i].Interface < statuses[j].Interface
+		})
+		data, err := json.Marshal(statuses)
+		if err != nil {
+			return err
+		}
+		value = string(data)
+	}
+	existing, ok := pod.GetAnnotations()[servicecommon.AnnotationPodNetworkStatus]
+	if existing == value && (ok || value == "") {
+		return nil
+	}
+	if err := util.UpdateK8sResourceAnnotation(client, ctx, pod, map[string]string{servicecommon.AnnotationPodNetworkStatus: value}); err != nil {
+		log.Error(err, "Failed to update Pod network status annotation", "Namespace", pod.Namespace, "Name", pod.Name)
+		return err
+	}
+	return nil
+}
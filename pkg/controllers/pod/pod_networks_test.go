@@ -0,0 +1,242 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestParsePodNetworks(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    []PodNetworkSelection
+		expectedErr string
+	}{
+		{
+			name:  "Empty",
+			value: " ",
+		},
+		{
+			name:  "ShortForm",
+			value: "storage, subnetset/data@data0,subnet/backup",
+			expected: []PodNetworkSelection{
+				{Subnet: "storage", Interface: "eth1"},
+				{SubnetSet: "data", Interface: "data0"},
+				{Subnet: "backup", Interface: "eth3"},
+			},
+		},
+		{
+			name:  "JSON",
+			value: `[{"subnet":"storage","interface":"storage0"},{"subnetSet":"data"}]`,
+			expected: []PodNetworkSelection{
+				{Subnet: "storage", Interface: "storage0"},
+				{SubnetSet: "data", Interface: "eth2"},
+			},
+		},
+		{
+			name:        "InvalidJSON",
+			value:       `[{"subnet":}]`,
+			expectedErr: "invalid Pod networks annotation",
+		},
+		{
+			name:        "UnsupportedKind",
+			value:       "vpc/storage",
+			expectedErr: `unsupported kind "vpc"`,
+		},
+		{
+			name:        "BothSubnetAndSubnetSet",
+			value:       `[{"subnet":"storage","subnetSet":"data"}]`,
+			expectedErr: "exactly one of subnet or subnetSet must be set in entry 0",
+		},
+		{
+			name:        "PrimaryInterface",
+			value:       "storage@eth0",
+			expectedErr: `duplicated interface name "eth0"`,
+		},
+		{
+			name:        "DuplicatedInterface",
+			value:       "storage@net1,data@net1",
+			expectedErr: `duplicated interface name "net1"`,
+		},
+		{
+			name:        "InvalidInterface",
+			value:       "storage@Storage_0",
+			expectedErr: `invalid interface name "Storage_0"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selections, err := parsePodNetworks(tt.value)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.True(t, errors.Is(err, errInvalidPodNetworks))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, selections)
+		})
+	}
+}
+
+func TestPodNetworkSubnetPortName(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", UID: "pod-uid"}}
+	assert.Equal(t, "pod-1-eth1", podNetworkSubnetPortName(pod, "eth1"))
+
+	pod.Name = strings.Repeat("a", validation.DNS1123SubdomainMaxLength)
+	name := podNetworkSubnetPortName(pod, "eth1")
+	assert.Len(t, name, validation.DNS1123SubdomainMaxLength)
+	assert.True(t, strings.HasSuffix(name, "-eth1"))
+}
+
+func TestPodReconciler_reconcilePodNetworks(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+
+	newPod := func(networks string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pod-1",
+				Namespace:   "ns-1",
+				UID:         "pod-uid",
+				Annotations: map[string]string{servicecommon.AnnotationPodNetworks: networks},
+			},
+		}
+	}
+	ownedSubnetPort := func(pod *v1.Pod, iface string, subnet string) *v1alpha1.SubnetPort {
+		return &v1alpha1.SubnetPort{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        podNetworkSubnetPortName(pod, iface),
+				Namespace:   pod.Namespace,
+				Annotations: map[string]string{servicecommon.AnnotationPodInterface: iface},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "v1", Kind: "Pod", Name: pod.Name, UID: pod.UID, Controller: ptr.To(true),
+				}},
+			},
+			Spec: v1alpha1.SubnetPortSpec{Subnet: subnet},
+		}
+	}
+	getNetworkStatus := func(t *testing.T, c client.Client, pod *v1.Pod) []PodNetworkStatus {
+		updated := &v1.Pod{}
+		require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(pod), updated))
+		value, ok := updated.Annotations[servicecommon.AnnotationPodNetworkStatus]
+		if !ok {
+			return nil
+		}
+		var statuses []PodNetworkStatus
+		require.NoError(t, json.Unmarshal([]byte(value), &statuses))
+		return statuses
+	}
+
+	t.Run("CreateSubnetPorts", func(t *testing.T) {
+		pod := newPod("storage,subnetset/data@data0")
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build()
+		r := &PodReconciler{Client: fakeClient, Scheme: scheme}
+
+		require.NoError(t, r.reconcilePodNetworks(context.TODO(), pod))
+
+		subnetPorts, err := r.listPodNetworkSubnetPorts(context.TODO(), pod)
+		require.NoError(t, err)
+		require.Len(t, subnetPorts, 2)
+		assert.Equal(t, "storage", subnetPorts["eth1"].Spec.Subnet)
+		assert.Equal(t, "pod-1-eth1", subnetPorts["eth1"].Name)
+		assert.Equal(t, "data", subnetPorts["data0"].Spec.SubnetSet)
+		statuses := getNetworkStatus(t, fakeClient, pod)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "data0", statuses[0].Interface)
+		assert.False(t, statuses[0].Ready)
+		assert.Equal(t, "pod-1-eth1", statuses[1].SubnetPort)
+	})
+
+	t.Run("PublishRealizedAddresses", func(t *testing.T) {
+		pod := newPod("storage")
+		subnetPort := ownedSubnetPort(pod, "eth1", "storage")
+		subnetPort.Status = v1alpha1.SubnetPortStatus{
+			Conditions: []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionTrue}},
+			Attachment: v1alpha1.PortAttachment{ID: "attachment-1"},
+			NetworkInterfaceConfig: v1alpha1.NetworkInterfaceConfig{
+				MACAddress:  "04:50:56:00:00:01",
+				IPAddresses: []v1alpha1.NetworkInterfaceIPAddress{{IPAddress: "10.0.1.5/24", Gateway: "10.0.1.1"}},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod, subnetPort).Build()
+		r := &PodReconciler{Client: fakeClient, Scheme: scheme}
+
+		require.NoError(t, r.reconcilePodNetworks(context.TODO(), pod))
+
+		statuses := getNetworkStatus(t, fakeClient, pod)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, PodNetworkStatus{
+			Interface:    "eth1",
+			Subnet:       "storage",
+			SubnetPort:   "pod-1-eth1",
+			IPAddresses:  []v1alpha1.NetworkInterfaceIPAddress{{IPAddress: "10.0.1.5/24", Gateway: "10.0.1.1"}},
+			MACAddress:   "04:50:56:00:00:01",
+			AttachmentID: "attachment-1",
+			Ready:        true,
+		}, statuses[0])
+	})
+
+	t.Run("RecreateAndDeleteSubnetPorts", func(t *testing.T) {
+		pod := newPod("backup")
+		pod.Annotations[servicecommon.AnnotationPodNetworkStatus] = "[]"
+		changed := ownedSubnetPort(pod, "eth1", "storage")
+		removed := ownedSubnetPort(pod, "eth2", "data")
+		notOwned := ownedSubnetPort(pod, "eth3", "data")
+		notOwned.OwnerReferences = nil
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod, changed, removed, notOwned).Build()
+		r := &PodReconciler{Client: fakeClient, Scheme: scheme}
+
+		require.NoError(t, r.reconcilePodNetworks(context.TODO(), pod))
+
+		subnetPorts, err := r.listPodNetworkSubnetPorts(context.TODO(), pod)
+		require.NoError(t, err)
+		require.Len(t, subnetPorts, 1)
+		assert.Equal(t, "backup", subnetPorts["eth1"].Spec.Subnet)
+		subnetPortList := &v1alpha1.SubnetPortList{}
+		require.NoError(t, fakeClient.List(context.TODO(), subnetPortList))
+		assert.Len(t, subnetPortList.Items, 2)
+	})
+
+	t.Run("RemoveNetworks", func(t *testing.T) {
+		pod := newPod("")
+		delete(pod.Annotations, servicecommon.AnnotationPodNetworks)
+		pod.Annotations[servicecommon.AnnotationPodNetworkStatus] = `[{"interface":"eth1","subnetPort":"pod-1-eth1","ready":true}]`
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod, ownedSubnetPort(pod, "eth1", "storage")).Build()
+		r := &PodReconciler{Client: fakeClient, Scheme: scheme}
+
+		assert.True(t, hasPodNetworks(pod))
+		require.NoError(t, r.reconcilePodNetworks(context.TODO(), pod))
+
+		subnetPorts, err := r.listPodNetworkSubnetPorts(context.TODO(), pod)
+		require.NoError(t, err)
+		assert.Empty(t, subnetPorts)
+		assert.Nil(t, getNetworkStatus(t, fakeClient, pod))
+	})
+
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		pod := newPod("storage@eth0")
+		r := &PodReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build(), Scheme: scheme}
+		err := r.reconcilePodNetworks(context.TODO(), pod)
+		assert.True(t, errors.Is(err, errInvalidPodNetworks))
+	})
+}
@@ -6,7 +6,9 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 )
 
 var (
@@ -15,6 +17,10 @@ var (
 
 type LoggingRateLimiter struct {
 	workqueue.TypedRateLimiter[reconcile.Request]
+	// NSXConfig and MetricResType are used to count the backoff in metrics.ControllerBackoffTotal, the backoff is not
+	// counted if NSXConfig is nil.
+	NSXConfig     *config.NSXOperatorConfig
+	MetricResType string
 }
 
 func (l *LoggingRateLimiter) When(item reconcile.Request) time.Duration {
@@ -22,5 +28,8 @@ func (l *LoggingRateLimiter) When(item reconcile.Request) time.Duration {
 	requeues := l.TypedRateLimiter.NumRequeues(item)
 	// If the request is requeued will error=nil, e.g. "return ResultRequeueAfter60sec, nil", it won't be logged here.
 	log.Debug("RateLimiter: Item has been requeued and will be delayed", "item", item, "requeues", requeues, "duration", duration)
+	if l.NSXConfig != nil {
+		metrics.CounterInc(l.NSXConfig, metrics.ControllerBackoffTotal, l.MetricResType)
+	}
 	return duration
 }
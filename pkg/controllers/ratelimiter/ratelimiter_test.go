@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 )
 
 func TestLoggingRateLimiter_When(t *testing.T) {
@@ -36,3 +40,15 @@ func TestLoggingRateLimiter_When(t *testing.T) {
 	numRequeues2 := loggingRateLimiter.NumRequeues(testRequest)
 	assert.Equal(t, 2, numRequeues2, "Number of requeues should be 2 after second call")
 }
+
+func TestLoggingRateLimiter_BackoffMetrics(t *testing.T) {
+	loggingRateLimiter := &LoggingRateLimiter{
+		TypedRateLimiter: workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+		NSXConfig:        &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"}},
+		MetricResType:    "backoff-test",
+	}
+	testRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "test-namespace", Name: "test-name"}}
+	loggingRateLimiter.When(testRequest)
+	loggingRateLimiter.When(testRequest)
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.ControllerBackoffTotal.WithLabelValues("backoff-test")))
+}
@@ -187,6 +187,10 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			return ResultRequeue, err
 		}
 		r.StatusUpdater.UpdateSuccess(ctx, realObj, setSecurityPolicyReadyStatusTrue, r.Service)
+		if ruleCount := realObj.Status.RuleCount; ruleCount > securitypolicy.MaxRuleCount {
+			r.Recorder.Event(realObj, v1.EventTypeWarning, "RuleCountExceeded",
+				fmt.Sprintf("SecurityPolicy is expanded to %d NSX rules, more than the %d rules supported in one NSX SecurityPolicy", ruleCount, securitypolicy.MaxRuleCount))
+		}
 		cleanSecurityPolicyErrorAnnotation(ctx, realObj, securitypolicy.IsVPCEnabled(r.Service), r.Client)
 	} else {
 		log.Info("Reconciling CR to delete securitypolicy", "securitypolicy", req.NamespacedName)
@@ -228,7 +232,7 @@ func setSecurityPolicyReadyStatusTrue(client client.Client, ctx context.Context,
 			LastTransitionTime: transitionTime,
 		},
 	}
-	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, service)
+	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, service.GetSecurityPolicyRuleCount(secPolicy.UID), service)
 }
 
 func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, args ...interface{}) {
@@ -250,16 +254,20 @@ func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context
 			LastTransitionTime: transitionTime,
 		},
 	}
-	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, service)
+	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, secPolicy.Status.RuleCount, service)
 }
 
-func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Context, secPolicy *v1alpha1.SecurityPolicy, newConditions []v1alpha1.Condition, service *securitypolicy.SecurityPolicyService) {
+func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Context, secPolicy *v1alpha1.SecurityPolicy, newConditions []v1alpha1.Condition, ruleCount int, service *securitypolicy.SecurityPolicyService) {
 	conditionsUpdated := false
 	for i := range newConditions {
 		if mergeSecurityPolicyStatusCondition(secPolicy, &newConditions[i]) {
 			conditionsUpdated = true
 		}
 	}
+	if secPolicy.Status.RuleCount != ruleCount {
+		secPolicy.Status.RuleCount = ruleCount
+		conditionsUpdated = true
+	}
 	if conditionsUpdated {
 		if securitypolicy.IsVPCEnabled(service) {
 			finalObj := securitypolicy.T1ToVPC(secPolicy)
@@ -274,7 +282,7 @@ func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Cont
 			}
 		}
 		log.Debug("Updated SecurityPolicy", "Name", secPolicy.Name, "Namespace", secPolicy.Namespace,
-			"New Conditions", newConditions)
+			"New Conditions", newConditions, "RuleCount", ruleCount)
 	}
 }
 
@@ -322,6 +330,7 @@ func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSecurityPolicy),
 			}).
 		Watches(
 			&v1.Namespace{},
@@ -101,7 +101,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 			Reason:  "Error occurred while processing the Security Policy CRD. Please check the config and try again",
 		},
 	}
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, 0, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -127,7 +127,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, 0, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -143,7 +143,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, 0, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -159,11 +159,16 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, 0, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
 	}
+
+	// Case: Only the rule count is changed
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, 5, r.Service)
+	assert.Equal(t, 5, dummySP.Status.RuleCount)
+	assert.Equal(t, newConditions, dummySP.Status.Conditions)
 }
 
 type fakeStatusWriter struct{}
@@ -370,6 +375,9 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
 		return nil
 	})
+	patch.ApplyMethod(reflect.TypeOf(service), "GetSecurityPolicyRuleCount", func(_ *securitypolicy.SecurityPolicyService, uid types.UID) int {
+		return 3
+	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
 	result, retErr = r.Reconcile(ctx, req)
 	assert.Equal(t, retErr, nil)
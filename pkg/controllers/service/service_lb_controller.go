@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
@@ -164,16 +165,21 @@ func (r *ServiceLbReconciler) setServiceLbStatus(ctx context.Context, lbService
 }
 
 func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
+	var cf *config.NSXOperatorConfig
+	if r.Service != nil {
+		cf = r.Service.NSXConfig
+	}
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Service{}).
 		Watches(
 			&v1alpha1.NetworkInfo{},
 			handler.EnqueueRequestsFromMapFunc(r.enqueueLBServiceRequestsFromNetworkInfo),
-			builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
+			builder.WithPredicates(common.PredicateNetworkInfoAllowedDNSDomainsChanged()),
 		).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(cf, MetricResType),
 			})
 	return b.Complete(r)
 }
@@ -32,7 +32,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
-	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -547,48 +546,6 @@ func TestServiceLbReconciler_CollectGarbage_table(t *testing.T) {
 	}
 }
 
-func TestPredicateNetworkInfoAllowedDNSDomainsChanged(t *testing.T) {
-	p := predicateNetworkInfoAllowedDNSDomainsChanged()
-
-	assert.False(t, p.Create(event.CreateEvent{}))
-	assert.False(t, p.Delete(event.DeleteEvent{}))
-	assert.False(t, p.Generic(event.GenericEvent{}))
-
-	// Test Update
-	tests := []struct {
-		name string
-		old  client.Object
-		new  client.Object
-		want bool
-	}{
-		{
-			name: "different types",
-			old:  &v1.Service{},
-			new:  &v1.Service{},
-			want: false,
-		},
-		{
-			name: "domains same",
-			old:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
-			new:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
-			want: false,
-		},
-		{
-			name: "domains changed",
-			old:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"a"}},
-			new:  &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"b"}},
-			want: true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := p.Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new})
-			assert.Equal(t, tt.want, got)
-		})
-	}
-}
-
 func TestServiceLbReconciler_RestoreReconcile(t *testing.T) {
 	r := &ServiceLbReconciler{}
 	err := r.RestoreReconcile()
@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"slices"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
@@ -17,8 +16,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -77,7 +74,7 @@ func buildLoadBalancerServiceDNSBatch(svc *v1.Service, w dns.DNSRecordProvider)
 	}
 	log.Debug("Building DNS batch for LB service", "namespace", svc.Namespace, "name", svc.Name,
 		"hostnames", len(hostnames), "targets", len(targets))
-	ttl := extdns.TTL(0)
+	ttl := dns.RecordTTLFromAnnotations(svc)
 	var eps []*extdns.Endpoint
 	for _, h := range hostnames {
 		for _, ep := range extdns.EndpointsForHostname(h, targets, ttl) {
@@ -88,6 +85,13 @@ func buildLoadBalancerServiceDNSBatch(svc *v1.Service, w dns.DNSRecordProvider)
 			eps = append(eps, ep)
 		}
 	}
+	for _, ep := range append(cnameEndpoints(svc, hostnames[0], ttl), srvEndpoints(svc, hostnames, ttl)...) {
+		if ep == nil {
+			log.Info("Skipping invalid DNS name in CNAME or SRV annotation", "namespace", svc.Namespace, "name", svc.Name)
+			continue
+		}
+		eps = append(eps, ep)
+	}
 	if len(eps) == 0 {
 		return nil, nil
 	}
@@ -101,6 +105,47 @@ func buildLoadBalancerServiceDNSBatch(svc *v1.Service, w dns.DNSRecordProvider)
 	return dns.NewOwnerScopedAggregatedRouteDNS(owner, rows), err
 }
 
+// cnameEndpoints returns a CNAME endpoint to the canonical hostname for each alias in nsx.vmware.com/dns-cname.
+func cnameEndpoints(svc *v1.Service, canonical string, ttl extdns.TTL) []*extdns.Endpoint {
+	if canonical == "" {
+		return nil
+	}
+	var eps []*extdns.Endpoint
+	for _, alias := range extannotations.HostnamesFromAnnotations(svc.GetAnnotations(), servicecommon.AnnotationDNSCNAMEKey) {
+		if alias == "" || strings.EqualFold(alias, canonical) {
+			continue
+		}
+		eps = append(eps, extdns.NewEndpointWithTTL(alias, extdns.RecordTypeCNAME, ttl, canonical))
+	}
+	return eps
+}
+
+// srvEndpoints returns the SRV endpoints "_<port name>._<protocol>.<hostname>" of the named ports of the Service for
+// each hostname if nsx.vmware.com/dns-srv is "true", the target is "<priority> <weight> <port> <hostname>".
+func srvEndpoints(svc *v1.Service, hostnames []string, ttl extdns.TTL) []*extdns.Endpoint {
+	if svc.GetAnnotations()[servicecommon.AnnotationDNSSRVKey] != "true" {
+		return nil
+	}
+	var eps []*extdns.Endpoint
+	for _, port := range svc.Spec.Ports {
+		if port.Name == "" {
+			continue
+		}
+		protocol := strings.ToLower(string(port.Protocol))
+		if protocol == "" {
+			protocol = strings.ToLower(string(v1.ProtocolTCP))
+		}
+		for _, h := range hostnames {
+			if h == "" {
+				continue
+			}
+			name := fmt.Sprintf("_%s._%s.%s", port.Name, protocol, h)
+			eps = append(eps, extdns.NewEndpointWithTTL(name, extdns.RecordTypeSRV, ttl, fmt.Sprintf("0 50 %d %s", port.Port, h)))
+		}
+	}
+	return eps
+}
+
 func buildServiceDNSReadyCondition(err error) metav1.Condition {
 	cond := metav1.Condition{
 		Type:               serviceDNSReadyConditionType,
@@ -241,28 +286,6 @@ func (r *ServiceLbReconciler) enqueueLBServiceRequestsFromNetworkInfo(ctx contex
 	return reqs
 }
 
-func predicateNetworkInfoAllowedDNSDomainsChanged() predicate.Predicate {
-	return predicate.Funcs{
-		CreateFunc: func(event.CreateEvent) bool {
-			return false
-		},
-		DeleteFunc: func(event.DeleteEvent) bool {
-			return false
-		},
-		UpdateFunc: func(e event.UpdateEvent) bool {
-			oldNI, ok1 := e.ObjectOld.(*v1alpha1.NetworkInfo)
-			newNI, ok2 := e.ObjectNew.(*v1alpha1.NetworkInfo)
-			if !ok1 || !ok2 {
-				return false
-			}
-			return !slices.Equal(oldNI.AllowedDNSDomains, newNI.AllowedDNSDomains)
-		},
-		GenericFunc: func(event.GenericEvent) bool {
-			return false
-		},
-	}
-}
-
 // collectDNSGarbage performs DNS record garbage collection for LoadBalancer Services.
 func (r *ServiceLbReconciler) collectDNSGarbage(ctx context.Context) error {
 	if r.DNS == nil {
@@ -75,6 +75,51 @@ func TestTargetsFromLoadBalancerIngress_table(t *testing.T) {
 	}
 }
 
+func TestBuildLoadBalancerServiceDNSBatch_cnameSrvTTL(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "lb",
+			Annotations: map[string]string{
+				servicecommon.AnnotationDNSHostnameKey: "app.example.com",
+				servicecommon.AnnotationDNSCNAMEKey:    "www.example.com,app.example.com",
+				servicecommon.AnnotationDNSSRVKey:      "true",
+				servicecommon.AnnotationDNSTTLKey:      "60",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Type: v1.ServiceTypeLoadBalancer,
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, Protocol: v1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+				{Port: 8080},
+			},
+		},
+		Status: v1.ServiceStatus{LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "203.0.113.5"}}}},
+	}
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ValidateEndpointsByZone("ns1", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+			return stubValidatedRows(eps)
+		})
+
+	batch, err := buildLoadBalancerServiceDNSBatch(svc, m)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	got := map[string]string{}
+	for _, row := range batch.Rows {
+		assert.Equal(t, extdns.TTL(60), row.RecordTTL)
+		got[row.RecordType+" "+row.DNSName] = strings.Join(row.Targets, ",")
+	}
+	assert.Equal(t, map[string]string{
+		"A app.example.com":              "203.0.113.5",
+		"CNAME www.example.com":          "app.example.com",
+		"SRV _http._tcp.app.example.com": "0 50 80 app.example.com",
+		"SRV _dns._udp.app.example.com":  "0 50 53 app.example.com",
+	}, got)
+}
+
 func TestReconcileLoadBalancerServiceDNS(t *testing.T) {
 	ctx := context.Background()
 	scheme := serviceLbTestScheme(t)
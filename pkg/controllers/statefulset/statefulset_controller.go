@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -450,15 +451,25 @@ func (r *StatefulSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(PredicateFuncsForStatefulSet).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.NumReconcile(),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeStatefulSet),
 		}).
 		Complete(r)
 }
 
-func (r *StatefulSetReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+func (r *StatefulSetReconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	if err := r.setupWithManager(mgr); err != nil {
 		log.Error(err, "failed to create controller", "controller", "StatefulSet")
 		return err
 	}
+	if hookServer != nil {
+		hookServer.Register("/validate-apps-v1-statefulset",
+			&webhook.Admission{
+				Handler: &StatefulSetValidator{
+					Client:  mgr.GetClient(),
+					decoder: admission.NewDecoder(mgr.GetScheme()),
+				},
+			})
+	}
 	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
 	return nil
 }
@@ -0,0 +1,133 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package statefulset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// +kubebuilder:webhook:path=/validate-apps-v1-statefulset,mutating=false,failurePolicy=ignore,sideEffects=None,groups=apps,resources=statefulsets,verbs=create;update,versions=v1,name=statefulset.validating.nsx.vmware.com,admissionReviewVersions=v1
+
+// StatefulSetValidator validates the static IP annotations of StatefulSets, every Pod ordinal must have a unique
+// static IP in the static IP allocation pool of the Subnet. The failure policy is Ignore as all the StatefulSets in
+// the cluster are sent to the webhook.
+type StatefulSetValidator struct {
+	Client  client.Client
+	decoder admission.Decoder
+}
+
+// Handle handles admission requests.
+func (v *StatefulSetValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	sts := &appsv1.StatefulSet{}
+	if err := v.decoder.Decode(req, sts); err != nil {
+		log.Error(err, "Error while decoding StatefulSet", "StatefulSet", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	staticIPs, err := common.ParseStatefulSetStaticIPs(sts)
+	if err != nil {
+		return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: %v", sts.Namespace, sts.Name, err))
+	}
+	if staticIPs == nil {
+		return admission.Allowed("")
+	}
+	if req.Operation == admissionv1.Update {
+		oldSts := &appsv1.StatefulSet{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldSts); err != nil {
+			log.Error(err, "Error while decoding StatefulSet", "StatefulSet", req.Namespace+"/"+req.Name)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// Don't block the updates unrelated to the static IPs, e.g. rolling out a new image.
+		oldStaticIPs, _ := common.ParseStatefulSetStaticIPs(oldSts)
+		if reflect.DeepEqual(staticIPs, oldStaticIPs) && statefulSetReplicas(sts) <= statefulSetReplicas(oldSts) {
+			return admission.Allowed("")
+		}
+	}
+
+	staticIPs, err = common.GetStatefulSetStaticIPs(ctx, v.Client, sts)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidStaticIPs) || apierrors.IsNotFound(err) {
+			return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: %v", sts.Namespace, sts.Name, err))
+		}
+		log.Error(err, "Failed to get the static IPs of StatefulSet", "StatefulSet", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	subnet := &v1alpha1.Subnet{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: sts.Namespace, Name: staticIPs.Subnet}, subnet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: Subnet %s is not found", sts.Namespace, sts.Name, staticIPs.Subnet))
+		}
+		log.Error(err, "Failed to get Subnet", "Subnet", sts.Namespace+"/"+staticIPs.Subnet)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if !staticIPAllocationEnabled(subnet) {
+		return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: static IP allocation is not enabled on Subnet %s", sts.Namespace, sts.Name, subnet.Name))
+	}
+	pool := staticIPAllocationPool(subnet)
+	if len(pool) == 0 {
+		return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: Subnet %s has no static IP allocation pool yet", sts.Namespace, sts.Name, subnet.Name))
+	}
+
+	ips := sets.New[string]()
+	for ordinal := staticIPs.Start; ordinal < staticIPs.Start+statefulSetReplicas(sts); ordinal++ {
+		ip, err := staticIPs.IPForOrdinal(ordinal)
+		if err != nil {
+			return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: %v", sts.Namespace, sts.Name, err))
+		}
+		if ips.Has(ip) {
+			return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: static IP %s is duplicated", sts.Namespace, sts.Name, ip))
+		}
+		ips.Insert(ip)
+		inPool, err := util.IPInRanges(net.ParseIP(ip), pool)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if !inPool {
+			return admission.Denied(fmt.Sprintf("StatefulSet %s/%s: static IP %s of ordinal %d is not in the static IP allocation pool %v of Subnet %s", sts.Namespace, sts.Name, ip, ordinal, pool, subnet.Name))
+		}
+	}
+	return admission.Allowed("")
+}
+
+func statefulSetReplicas(sts *appsv1.StatefulSet) int {
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*sts.Spec.Replicas)
+}
+
+// staticIPAllocationEnabled follows the default of the Subnet, static IP allocation is enabled if DHCP is disabled.
+func staticIPAllocationEnabled(subnet *v1alpha1.Subnet) bool {
+	if enabled := subnet.Spec.AdvancedConfig.StaticIPAllocation.Enabled; enabled != nil {
+		return *enabled
+	}
+	return !util.CRSubnetDHCPEnabled(subnet)
+}
+
+// staticIPAllocationPool returns the pool ranges of the Subnet, or the whole Subnet CIDRs if no ranges are set.
+func staticIPAllocationPool(subnet *v1alpha1.Subnet) []string {
+	if ranges := subnet.Spec.AdvancedConfig.StaticIPAllocation.PoolRanges; len(ranges) > 0 {
+		return ranges
+	}
+	if len(subnet.Status.NetworkAddresses) > 0 {
+		return subnet.Status.NetworkAddresses
+	}
+	return subnet.Spec.IPAddresses
+}
@@ -0,0 +1,148 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package statefulset
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func newStaticIPStatefulSet(replicas int32, annotations map[string]string) []byte {
+	sts, _ := json.Marshal(&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "db", Annotations: annotations},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(replicas)},
+	})
+	return sts
+}
+
+func TestStatefulSetValidator_Handle(t *testing.T) {
+	scheme := clientgoscheme.Scheme
+	v1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet-1"},
+			Spec: v1alpha1.SubnetSpec{AdvancedConfig: v1alpha1.SubnetAdvancedConfig{
+				StaticIPAllocation: v1alpha1.StaticIPAllocation{PoolRanges: []string{"10.0.0.10-10.0.0.20"}},
+			}},
+		},
+		&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet-dhcp"},
+			Spec: v1alpha1.SubnetSpec{
+				SubnetDHCPConfig: v1alpha1.SubnetDHCPConfig{Mode: v1alpha1.DHCPConfigMode(v1alpha1.DHCPConfigModeServer)},
+			},
+			Status: v1alpha1.SubnetStatus{NetworkAddresses: []string{"10.0.1.0/24"}},
+		},
+		&v1alpha1.SubnetIPReservation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "reservation-1"},
+			Spec:       v1alpha1.SubnetIPReservationSpec{Subnet: "subnet-1"},
+			Status:     v1alpha1.SubnetIPReservationStatus{IPs: []string{"10.0.0.16-10.0.0.17"}},
+		},
+		&v1alpha1.SubnetIPReservation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "reservation-pending"},
+			Spec:       v1alpha1.SubnetIPReservationSpec{Subnet: "subnet-1"},
+		},
+	).Build()
+	decoder := admission.NewDecoder(scheme)
+	v := &StatefulSetValidator{Client: fakeClient, decoder: decoder}
+
+	staticIPs := func(ips, subnet string) map[string]string {
+		return map[string]string{servicecommon.AnnotationStaticIPs: ips, servicecommon.AnnotationStaticIPSubnet: subnet}
+	}
+	reservation := func(name string) map[string]string {
+		return map[string]string{servicecommon.AnnotationStaticIPReservation: name}
+	}
+	tests := []struct {
+		name string
+		req  admission.Request
+		want admission.Response
+	}{
+		{
+			name: "NoStaticIPs",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(3, nil)}}},
+			want: admission.Allowed(""),
+		},
+		{
+			name: "StaticIPs",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(3, staticIPs("10.0.0.10,10.0.0.12-10.0.0.13", "subnet-1"))}}},
+			want: admission.Allowed(""),
+		},
+		{
+			name: "NotEnoughStaticIPs",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(3, staticIPs("10.0.0.10-10.0.0.11", "subnet-1"))}}},
+			want: admission.Denied("StatefulSet ns1/db: no static IP for ordinal 2: no IP at index 2 in [10.0.0.10-10.0.0.11]"),
+		},
+		{
+			name: "DuplicatedStaticIPs",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(2, staticIPs("10.0.0.10,10.0.0.10", "subnet-1"))}}},
+			want: admission.Denied("StatefulSet ns1/db: static IP 10.0.0.10 is duplicated"),
+		},
+		{
+			name: "StaticIPOutOfPool",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(2, staticIPs("10.0.0.20-10.0.0.21", "subnet-1"))}}},
+			want: admission.Denied("StatefulSet ns1/db: static IP 10.0.0.21 of ordinal 1 is not in the static IP allocation pool [10.0.0.10-10.0.0.20] of Subnet subnet-1"),
+		},
+		{
+			name: "MissingSubnetAnnotation",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(1, map[string]string{servicecommon.AnnotationStaticIPs: "10.0.0.10"})}}},
+			want: admission.Denied("StatefulSet ns1/db: invalid StatefulSet static IP annotations: nsx.vmware.com/static-ip-subnet is required with nsx.vmware.com/static-ips"),
+		},
+		{
+			name: "SubnetNotFound",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(1, staticIPs("10.0.0.10", "subnet-2"))}}},
+			want: admission.Denied("StatefulSet ns1/db: Subnet subnet-2 is not found"),
+		},
+		{
+			name: "StaticIPAllocationDisabled",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(1, staticIPs("10.0.1.10", "subnet-dhcp"))}}},
+			want: admission.Denied("StatefulSet ns1/db: static IP allocation is not enabled on Subnet subnet-dhcp"),
+		},
+		{
+			name: "SubnetIPReservation",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(2, reservation("reservation-1"))}}},
+			want: admission.Allowed(""),
+		},
+		{
+			name: "SubnetIPReservationNotReady",
+			req:  admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: runtime.RawExtension{Raw: newStaticIPStatefulSet(2, reservation("reservation-pending"))}}},
+			want: admission.Denied("StatefulSet ns1/db: invalid StatefulSet static IP annotations: SubnetIPReservation ns1/reservation-pending has no reserved IPs yet"),
+		},
+		{
+			name: "ScaleUpBeyondSubnetIPReservation",
+			req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: newStaticIPStatefulSet(3, reservation("reservation-1"))},
+				OldObject: runtime.RawExtension{Raw: newStaticIPStatefulSet(2, reservation("reservation-1"))},
+			}},
+			want: admission.Denied("StatefulSet ns1/db: no static IP for ordinal 2: no IP at index 2 in [10.0.0.16-10.0.0.17]"),
+		},
+		{
+			name: "UpdateWithoutStaticIPChanges",
+			req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: newStaticIPStatefulSet(1, staticIPs("10.0.0.10", "subnet-2"))},
+				OldObject: runtime.RawExtension{Raw: newStaticIPStatefulSet(1, staticIPs("10.0.0.10", "subnet-2"))},
+			}},
+			want: admission.Allowed(""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, v.Handle(context.TODO(), tt.req), "Handle()")
+		})
+	}
+}
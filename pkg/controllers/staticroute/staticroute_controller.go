@@ -183,6 +183,7 @@ func (r *StaticRouteReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeStaticRoute),
 			}).
 		Complete(r)
 }
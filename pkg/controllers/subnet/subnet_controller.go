@@ -22,7 +22,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -54,7 +57,9 @@ type SubnetReconciler struct {
 	BindingService    *subnetbinding.BindingService
 	Recorder          record.EventRecorder
 	StatusUpdater     common.StatusUpdater
-	queue             workqueue.TypedRateLimitingInterface[reconcile.Request]
+	// DriftEvents receives the Subnets owning the drifted NSX Subnets to requeue, nil if the drift audit doesn't requeue.
+	DriftEvents chan event.GenericEvent
+	queue       workqueue.TypedRateLimitingInterface[reconcile.Request]
 }
 
 func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -197,7 +202,8 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Create or update the subnet in NSX
-	if _, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, vpcInfoList[0], tags); err != nil {
+	nsxSubnet, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, vpcInfoList[0], tags)
+	if err != nil {
 		if errors.As(err, &nsxutil.ExceedTagsError{}) {
 			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Tags limit exceeded", setSubnetReadyStatusFalse)
 			return ResultNormal, nil
@@ -221,6 +227,16 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to create/update Subnet", setSubnetReadyStatusFalse)
 		return ResultRequeue, err
 	}
+	// Expand the realized NSX Subnet when spec.ipv4SubnetSize is increased. The Subnet stays ready on the expansion
+	// failure as the existing CIDR is still in use, the failure is reported through the SubnetExpanded condition.
+	if subnet.SubnetNeedsExpansion(subnetCR, nsxSubnet) {
+		if err := r.expandSubnet(ctx, subnetCR, nsxSubnet); err != nil && !errors.Is(err, subnet.ErrSubnetNotExpandable) {
+			return ResultRequeue, err
+		}
+	} else if cond := getExistingConditionOfType(v1alpha1.SubnetExpanded, subnetCR.Status.Conditions); cond != nil && cond.Status != v1.ConditionTrue {
+		// The spec.ipv4SubnetSize is reverted to the realized size after a failed expansion.
+		setSubnetExpandedCondition(r.Client, ctx, subnetCR, v1.ConditionTrue, "SubnetExpansionReverted", fmt.Sprintf("Subnet IPv4 size has been reverted to %d", subnetCR.Spec.IPv4SubnetSize))
+	}
 	// Update status
 	if err := r.updateSubnetStatus(subnetCR); err != nil {
 		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to update Subnet status", setSubnetReadyStatusFalse)
@@ -230,6 +246,26 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	return ctrl.Result{}, nil
 }
 
+// expandSubnet expands the NSX Subnet in place to the increased spec.ipv4SubnetSize and reports the progress through
+// the SubnetExpanded condition.
+func (r *SubnetReconciler) expandSubnet(ctx context.Context, subnetCR *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) error {
+	size := subnetCR.Spec.IPv4SubnetSize
+	log.Info("Expanding Subnet", "Subnet", subnetCR.Namespace+"/"+subnetCR.Name, "ipv4SubnetSize", size, "ipAddresses", nsxSubnet.IpAddresses)
+	// Keep the failure of the previous attempt on retries, flipping the condition would trigger another reconciliation.
+	if cond := getExistingConditionOfType(v1alpha1.SubnetExpanded, subnetCR.Status.Conditions); cond == nil || cond.Reason != "SubnetExpansionFailed" {
+		setSubnetExpandedCondition(r.Client, ctx, subnetCR, v1.ConditionFalse, "SubnetExpansionInProgress", fmt.Sprintf("Expanding Subnet to IPv4 size %d", size))
+	}
+	expandedSubnet, err := r.SubnetService.ExpandSubnet(subnetCR, nsxSubnet)
+	if err != nil {
+		setSubnetExpandedCondition(r.Client, ctx, subnetCR, v1.ConditionFalse, "SubnetExpansionFailed", fmt.Sprintf("Failed to expand Subnet to IPv4 size %d: %v", size, err))
+		return err
+	}
+	// The total IP count of the Subnet is cached for the SubnetPort allocation.
+	r.SubnetPortService.ResetSubnetTotalIP(*expandedSubnet.Path)
+	setSubnetExpandedCondition(r.Client, ctx, subnetCR, v1.ConditionTrue, "SubnetExpansionSucceeded", fmt.Sprintf("Subnet has been expanded to IPv4 size %d", size))
+	return nil
+}
+
 func (r *SubnetReconciler) setDefaultIPv4SubnetSizeValue(ctx context.Context, subnetCR *v1alpha1.Subnet, vpcNetworkConfig *v1alpha1.VPCNetworkConfiguration) error {
 	var err error
 	if vpcNetworkConfig == nil {
@@ -410,6 +446,19 @@ func setSubnetReadyStatusFalse(client client.Client, ctx context.Context, obj cl
 	updateSubnetStatusConditions(client, ctx, subnetCR, newConditions)
 }
 
+func setSubnetExpandedCondition(client client.Client, ctx context.Context, subnet *v1alpha1.Subnet, status v1.ConditionStatus, reason string, msg string) {
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.SubnetExpanded,
+			Status:             status,
+			Message:            msg,
+			Reason:             reason,
+			LastTransitionTime: metav1.Now(),
+		},
+	}
+	updateSubnetStatusConditions(client, ctx, subnet, newConditions)
+}
+
 func (r *SubnetReconciler) setSubnetDeletionFailedStatus(ctx context.Context, subnet *v1alpha1.Subnet, transitionTime metav1.Time, msg string, reason string) {
 	newConditions := []v1alpha1.Condition{
 		{
@@ -578,7 +627,7 @@ func (r *SubnetReconciler) start(mgr ctrl.Manager, hookServer webhook.Server) er
 
 // setupWithManager configures the controller to watch Subnet resources
 func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Subnet{}).
 		WithOptions(
 			controller.Options{
@@ -601,15 +650,18 @@ func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 				RequeueByDelete: requeueSubnetBySubnetBindingDelete,
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
-		).
-		Complete(r)
+		)
+	if r.DriftEvents != nil {
+		b = b.WatchesRawSource(source.Channel(r.DriftEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return b.Complete(r)
 }
 
 func (r *SubnetReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
 	if r.queue == nil {
-		r.queue = workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+		r.queue = common.WithQueueMetrics(r.StatusUpdater.NSXConfig, MetricResTypeSubnet, workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
 			Name: controllerName,
-		})
+		}))
 	}
 	return r.queue
 }
@@ -14,6 +14,7 @@ import (
 	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -1662,3 +1663,68 @@ func TestSetDefaultIPv4SubnetSizeValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSubnetReconciler_expandSubnet(t *testing.T) {
+	nsxSubnet := &model.VpcSubnet{
+		Id:          common.String("subnet-1"),
+		Path:        common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1"),
+		IpAddresses: []string{"10.0.0.0/27"},
+	}
+	testCases := []struct {
+		name           string
+		expandErr      error
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+		expectedReset  bool
+	}{
+		{
+			name:           "Success",
+			expectedStatus: corev1.ConditionTrue,
+			expectedReason: "SubnetExpansionSucceeded",
+			expectedReset:  true,
+		},
+		{
+			name:           "Failure",
+			expandErr:      fmt.Errorf("%w: CIDR 10.0.0.0/27 is not aligned to size 64", subnet.ErrSubnetNotExpandable),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: "SubnetExpansionFailed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			subnetCR := &v1alpha1.Subnet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-subnet", Namespace: "test-ns"},
+				Spec:       v1alpha1.SubnetSpec{IPv4SubnetSize: 64, IPAddressType: v1alpha1.IPAddressTypeIPv4},
+			}
+			r := createFakeSubnetReconciler(nil)
+			r.Client = fake.NewClientBuilder().WithScheme(r.Client.Scheme()).WithStatusSubresource(&v1alpha1.Subnet{}).WithObjects(subnetCR).Build()
+			assert.True(t, subnet.SubnetNeedsExpansion(subnetCR, nsxSubnet))
+
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService), "ExpandSubnet", func(_ *subnet.SubnetService, _ *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) (*model.VpcSubnet, error) {
+				if tc.expandErr != nil {
+					return nil, tc.expandErr
+				}
+				expanded := *nsxSubnet
+				expanded.IpAddresses = []string{"10.0.0.0/26"}
+				return &expanded, nil
+			})
+			defer patches.Reset()
+			reset := false
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "ResetSubnetTotalIP", func(_ *subnetport.SubnetPortService, path string) {
+				reset = path == *nsxSubnet.Path
+			})
+
+			err := r.expandSubnet(ctx, subnetCR, nsxSubnet)
+			assert.Equal(t, tc.expandErr, err)
+			assert.Equal(t, tc.expectedReset, reset)
+
+			updated := &v1alpha1.Subnet{}
+			require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: subnetCR.Namespace, Name: subnetCR.Name}, updated))
+			require.Len(t, updated.Status.Conditions, 1)
+			assert.Equal(t, v1alpha1.SubnetExpanded, updated.Status.Conditions[0].Type)
+			assert.Equal(t, tc.expectedStatus, updated.Status.Conditions[0].Status)
+			assert.Equal(t, tc.expectedReason, updated.Status.Conditions[0].Reason)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	controllercommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	subnetservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
@@ -100,6 +101,11 @@ func (v *SubnetValidator) Handle(ctx context.Context, req admission.Request) adm
 			if !nsxutil.CompareArraysWithoutOrder(oldSubnet.Spec.IPAddresses, subnet.Spec.IPAddresses) {
 				return admission.Denied("ipAddresses is immutable")
 			}
+			if oldSubnet.Spec.IPv4SubnetSize != 0 && oldSubnet.Spec.IPv4SubnetSize != subnet.Spec.IPv4SubnetSize {
+				if msg := v.validateIPv4SubnetSizeUpdate(oldSubnet, subnet); msg != "" {
+					return admission.Denied(msg)
+				}
+			}
 		}
 	case admissionv1.Delete:
 		oldSubnet := &v1alpha1.Subnet{}
@@ -175,6 +181,38 @@ func (v *SubnetValidator) checkSubnetIPReservation(ctx context.Context, ns strin
 	return false, nil
 }
 
+// validateIPv4SubnetSizeUpdate returns the denial message if the ipv4SubnetSize can't be updated. A realized Subnet
+// is expanded in place to the increased ipv4SubnetSize, so the expansion must be supported by NSX and the realized IPv4
+// CIDR in status.networkAddresses must be expandable to the size. The size can be reverted to the realized size after
+// a failed expansion, e.g. the expanded CIDR overlaps with other Subnets.
+func (v *SubnetValidator) validateIPv4SubnetSizeUpdate(oldSubnet, newSubnet *v1alpha1.Subnet) string {
+	size := newSubnet.Spec.IPv4SubnetSize
+	subnetKey := newSubnet.Namespace + "/" + newSubnet.Name
+	realizedSize := util.IPv4CIDRSize(oldSubnet.Status.NetworkAddresses)
+	if size < oldSubnet.Spec.IPv4SubnetSize {
+		if size != realizedSize {
+			return fmt.Sprintf("Subnet %s: spec.ipv4SubnetSize can only be increased or reverted to the realized size", subnetKey)
+		}
+		return ""
+	}
+	if util.ContainsIPv4CIDR(newSubnet.Spec.IPAddresses) {
+		return fmt.Sprintf("Subnet %s: spec.ipv4SubnetSize cannot be updated when spec.ipAddresses has IPv4 CIDR", subnetKey)
+	}
+	if valid, msg := util.ValidateSubnetSize(v.nsxClient, size); !valid {
+		return fmt.Sprintf("Subnet %s has invalid size %d: %s", subnetKey, size, msg)
+	}
+	if realizedSize == 0 || size <= realizedSize {
+		return ""
+	}
+	if !v.nsxClient.NSXCheckVersion(nsx.SubnetExpansion) {
+		return fmt.Sprintf("Subnet %s: spec.ipv4SubnetSize cannot be increased as NSX doesn't support the Subnet expansion", subnetKey)
+	}
+	if err := subnetservice.ValidateSubnetExpansion(oldSubnet.Status.NetworkAddresses, size); err != nil {
+		return fmt.Sprintf("Subnet %s: spec.ipv4SubnetSize cannot be increased: %v", subnetKey, err)
+	}
+	return ""
+}
+
 func (v *SubnetValidator) checkSubnetSet(ctx context.Context, ns string, subnetName string) (bool, error) {
 	crdSubnetSets := &v1alpha1.SubnetSetList{}
 	err := v.Client.List(ctx, crdSubnetSets, client.InNamespace(ns))
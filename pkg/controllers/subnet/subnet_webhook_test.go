@@ -162,6 +162,24 @@ func TestSubnetValidator_Handle(t *testing.T) {
 		},
 	})
 
+	// Subnets for ipv4SubnetSize update tests
+	newSizedSubnet := func(size int, ipAddresses []string, networkAddresses ...string) []byte {
+		subnet, _ := json.Marshal(&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-9",
+				Name:      "subnet-to-expand",
+			},
+			Spec: v1alpha1.SubnetSpec{
+				IPv4SubnetSize: size,
+				IPAddresses:    ipAddresses,
+			},
+			Status: v1alpha1.SubnetStatus{
+				NetworkAddresses: networkAddresses,
+			},
+		})
+		return subnet
+	}
+
 	// Old shared subnet for update test
 	oldSharedSubnet, _ := json.Marshal(&v1alpha1.Subnet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -196,6 +214,7 @@ func TestSubnetValidator_Handle(t *testing.T) {
 		prepareFunc     func(t *testing.T)
 		want            admission.Response
 		accessModeCheck bool
+		nsxVersion      string
 	}
 
 	tests := []testCase{
@@ -440,6 +459,80 @@ func TestSubnetValidator_Handle(t *testing.T) {
 			want:            admission.Denied("ipAddresses is immutable"),
 			accessModeCheck: true,
 		},
+		{
+			name:            "Update subnet with increased IPv4SubnetSize",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(64, nil),
+			oldObject:       newSizedSubnet(32, nil),
+			user:            "non-nsx-operator",
+			want:            admission.Allowed(""),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Update subnet with decreased IPv4SubnetSize",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(16, nil),
+			oldObject:       newSizedSubnet(32, nil),
+			user:            "non-nsx-operator",
+			want:            admission.Denied("Subnet ns-9/subnet-to-expand: spec.ipv4SubnetSize can only be increased or reverted to the realized size"),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Revert IPv4SubnetSize to the realized size",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(32, nil, "10.0.0.32/27"),
+			oldObject:       newSizedSubnet(64, nil, "10.0.0.32/27"),
+			user:            "non-nsx-operator",
+			want:            admission.Allowed(""),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Expand realized subnet",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(64, nil, "10.0.0.0/27"),
+			oldObject:       newSizedSubnet(32, nil, "10.0.0.0/27"),
+			user:            "non-nsx-operator",
+			want:            admission.Allowed(""),
+			accessModeCheck: true,
+			nsxVersion:      "9.2.0.0.12345",
+		},
+		{
+			name:            "Expand realized subnet with unaligned CIDR",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(64, nil, "10.0.0.32/27"),
+			oldObject:       newSizedSubnet(32, nil, "10.0.0.32/27"),
+			user:            "non-nsx-operator",
+			want:            admission.Denied("Subnet ns-9/subnet-to-expand: spec.ipv4SubnetSize cannot be increased: subnet cannot be expanded: CIDR 10.0.0.32/27 is not aligned to size 64"),
+			accessModeCheck: true,
+			nsxVersion:      "9.2.0.0.12345",
+		},
+		{
+			name:            "Expand realized subnet on NSX without Subnet expansion",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(64, nil, "10.0.0.0/27"),
+			oldObject:       newSizedSubnet(32, nil, "10.0.0.0/27"),
+			user:            "non-nsx-operator",
+			want:            admission.Denied("Subnet ns-9/subnet-to-expand: spec.ipv4SubnetSize cannot be increased as NSX doesn't support the Subnet expansion"),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Update subnet with invalid IPv4SubnetSize",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(48, nil),
+			oldObject:       newSizedSubnet(32, nil),
+			user:            "non-nsx-operator",
+			want:            admission.Denied("Subnet ns-9/subnet-to-expand has invalid size 48: Subnet size must be a power of 2"),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Update subnet IPv4SubnetSize with IPv4 CIDR",
+			operation:       admissionv1.Update,
+			object:          newSizedSubnet(64, []string{"192.168.1.0/27"}),
+			oldObject:       newSizedSubnet(32, []string{"192.168.1.0/27"}),
+			user:            "non-nsx-operator",
+			want:            admission.Denied("Subnet ns-9/subnet-to-expand: spec.ipv4SubnetSize cannot be updated when spec.ipAddresses has IPv4 CIDR"),
+			accessModeCheck: true,
+		},
 		{
 			name:            "Update shared subnet by non-NSX Operator",
 			operation:       admissionv1.Update,
@@ -483,9 +576,15 @@ func TestSubnetValidator_Handle(t *testing.T) {
 			if tt.prepareFunc != nil {
 				tt.prepareFunc(t)
 			}
+			nsxVersion := "9.0.0.0.12345"
+			if tt.nsxVersion != "" {
+				nsxVersion = tt.nsxVersion
+			}
+			// The supported NSX features are cached by the client.
+			v.nsxClient = &nsx.Client{Cluster: cluster}
 			patches := gomonkey.ApplyMethod(reflect.TypeOf(v.nsxClient.Cluster), "GetVersion", func(_ *nsx.Cluster) (*nsx.NsxVersion, error) {
 				return &nsx.NsxVersion{
-					NodeVersion: "9.0.0.0.12345",
+					NodeVersion: nsxVersion,
 				}, nil
 			})
 			patches.ApplyFunc(controllercommon.CheckAccessModeOrVisibility, func(_ client.Client, ctx context.Context, ns string, accessMode string, resourceType string) error {
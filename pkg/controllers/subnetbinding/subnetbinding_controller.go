@@ -172,6 +172,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1alpha1.SubnetConnectionBindingMap{}, builder.WithPredicates(PredicateFuncsForBindingMaps)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.NumReconcile(),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetConnectionBindingMap),
 		}).
 		Watches(
 			&v1alpha1.Subnet{},
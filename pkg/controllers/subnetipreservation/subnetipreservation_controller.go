@@ -155,6 +155,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1alpha1.SubnetIPReservation{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.NumReconcile(),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetIPReservation),
 		}).
 		Watches(
 			&v1alpha1.Subnet{},
@@ -31,9 +31,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -72,9 +74,13 @@ type SubnetPortReconciler struct {
 	SubnetService              servicecommon.SubnetServiceProvider
 	VPCService                 servicecommon.VPCServiceProvider
 	IpAddressAllocationService servicecommon.IPAddressAllocationServiceProvider
+	NodeServiceReader          servicecommon.NodeServiceReader
 	Recorder                   record.EventRecorder
 	StatusUpdater              common.StatusUpdater
-	restoreMode                bool
+	// DriftEvents receives the SubnetPorts owning the drifted NSX SubnetPorts to requeue, nil if the drift audit
+	// doesn't requeue.
+	DriftEvents chan event.GenericEvent
+	restoreMode bool
 }
 
 // +kubebuilder:rbac:groups=nsx.vmware.com,resources=subnetports,verbs=get;list;watch;create;update;patch;delete
@@ -158,12 +164,20 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 
 		isVmSubnetPort := true
+		contextID := ""
 		if value, exists := subnetPort.Labels[servicecommon.LabelImageFetcher]; exists && value == "true" {
 			isVmSubnetPort = false
 			if labels == nil {
 				labels = &map[string]string{}
 			}
 			(*labels)[servicecommon.LabelImageFetcher] = "true"
+		} else if isPodNetworkSubnetPort(subnetPort) {
+			// The SubnetPort is an additional interface of a Pod, tag it with the Namespace the same as the Pod SubnetPort.
+			isVmSubnetPort = false
+			if contextID, err = r.getPodContextID(ctx, subnetPort); err != nil {
+				r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "Failed to get the Node of the Pod", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
+				return common.ResultRequeue, err
+			}
 		}
 		ab := r.SubnetPortService.GetAddressBindingBySubnetPort(subnetPort)
 		err = r.IpAddressAllocationService.CreateIPAddressAllocationForAddressBinding(ab, subnetPort, r.restoreMode)
@@ -171,7 +185,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "Failed to create NSX IPAddressAllocation for AddressBinding restore", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			return common.ResultRequeue, err
 		}
-		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(subnetPort, nsxSubnet, "", labels, isVmSubnetPort, r.restoreMode, interfaceIPType)
+		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(subnetPort, nsxSubnet, contextID, labels, isVmSubnetPort, r.restoreMode, interfaceIPType)
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			if nsxutil.IsRealizeStateError(err) {
@@ -468,21 +482,27 @@ func (r *SubnetPortReconciler) deleteSubnetPortByName(ctx context.Context, ns st
 
 // setupWithManager sets up the controller with the Manager.
 func (r *SubnetPortReconciler) setupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.SubnetPort{}).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSubnetPort),
 				RateLimiter: &ratelimiter.LoggingRateLimiter{
 					TypedRateLimiter: workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+					NSXConfig:        r.StatusUpdater.NSXConfig,
+					MetricResType:    MetricResTypeSubnetPort,
 				},
 			}).
 		Watches(&vmv1alpha1.VirtualMachine{},
 			handler.EnqueueRequestsFromMapFunc(r.vmMapFunc),
 			builder.WithPredicates(predicate.LabelChangedPredicate{})).
 		Watches(&v1alpha1.AddressBinding{},
-				handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
-		Complete(r) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
+			handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc))
+	if r.DriftEvents != nil {
+		b = b.WatchesRawSource(source.Channel(r.DriftEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return b.Complete(r) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
 }
 
 func (r *SubnetPortReconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
@@ -639,7 +659,7 @@ func (r *SubnetPortReconciler) StartController(mgr ctrl.Manager, hookServer webh
 	return nil
 }
 
-func NewSubnetPortReconciler(mgr ctrl.Manager, subnetPortService *subnetport.SubnetPortService, subnetService *subnet.SubnetService, vpcService *vpc.VPCService, ipAddressAllocationService servicecommon.IPAddressAllocationServiceProvider) *SubnetPortReconciler {
+func NewSubnetPortReconciler(mgr ctrl.Manager, subnetPortService *subnetport.SubnetPortService, subnetService *subnet.SubnetService, vpcService *vpc.VPCService, ipAddressAllocationService servicecommon.IPAddressAllocationServiceProvider, nodeService servicecommon.NodeServiceReader) *SubnetPortReconciler {
 	subnetPortReconciler := &SubnetPortReconciler{
 		Client:                     mgr.GetClient(),
 		Scheme:                     mgr.GetScheme(),
@@ -648,6 +668,7 @@ func NewSubnetPortReconciler(mgr ctrl.Manager, subnetPortService *subnetport.Sub
 		SubnetPortService:          subnetPortService,
 		VPCService:                 vpcService,
 		IpAddressAllocationService: ipAddressAllocationService,
+		NodeServiceReader:          nodeService,
 		Recorder:                   mgr.GetEventRecorderFor("subnetport-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
 	}
 	err := subnetPortReconciler.SetupFieldIndexers(mgr)
@@ -1002,6 +1023,30 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 	return
 }
 
+// getPodContextID returns the ID of the transport node where the Pod owning the SubnetPort of an additional Pod
+// interface runs, the NSX SubnetPort is attached to the Pod on it the same as the Pod SubnetPort.
+func (r *SubnetPortReconciler) getPodContextID(ctx context.Context, subnetPort *v1alpha1.SubnetPort) (string, error) {
+	owner := metav1.GetControllerOf(subnetPort)
+	pod := &v1.Pod{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: subnetPort.Namespace, Name: owner.Name}, pod); err != nil {
+		return "", err
+	}
+	if pod.UID != owner.UID {
+		return "", fmt.Errorf("the Pod %s/%s owning the SubnetPort has been recreated", pod.Namespace, pod.Name)
+	}
+	nodes := r.NodeServiceReader.GetNodeByName(pod.Spec.NodeName)
+	if len(nodes) != 1 || nodes[0].UniqueId == nil {
+		return "", fmt.Errorf("failed to get the unique transport node %s of Pod %s/%s, found %d", pod.Spec.NodeName, pod.Namespace, pod.Name, len(nodes))
+	}
+	return *nodes[0].UniqueId, nil
+}
+
+// isPodNetworkSubnetPort checks if the SubnetPort is created by the Pod controller for an additional Pod interface.
+func isPodNetworkSubnetPort(subnetPort *v1alpha1.SubnetPort) bool {
+	owner := metav1.GetControllerOf(subnetPort)
+	return owner != nil && owner.Kind == "Pod" && subnetPort.Annotations[servicecommon.AnnotationPodInterface] != ""
+}
+
 func (r *SubnetPortReconciler) updateSubnetStatusOnSubnetPort(subnetPort *v1alpha1.SubnetPort, nsxSubnet *model.VpcSubnet) error {
 	subnetPort.Status.NetworkInterfaceConfig.LogicalSwitchUUID = *nsxSubnet.RealizationId
 	// Get all gateways from the subnet (may be IPv4, IPv6, or both for dual-stack)
@@ -2673,7 +2673,7 @@ func TestSubnetPortReconciler_StartController(t *testing.T) {
 		return nil
 	})
 	defer patches.Reset()
-	r := NewSubnetPortReconciler(mockMgr, subnetPortService, subnetService, vpcService, &mockIPAddressAllocationService)
+	r := NewSubnetPortReconciler(mockMgr, subnetPortService, subnetService, vpcService, &mockIPAddressAllocationService, nil)
 	err := r.StartController(mockMgr, nil)
 	assert.Nil(t, err)
 }
@@ -3066,3 +3066,23 @@ func TestSubnetPortReconciler_UpdateSubnetPortIPType(t *testing.T) {
 		assert.Equal(t, v1alpha1.StaticIPAllocationTypeNone, subnetPort.Spec.StaticIPAllocationType)
 	})
 }
+
+func TestIsPodNetworkSubnetPort(t *testing.T) {
+	podOwner := []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "pod-1", UID: "pod-uid", Controller: ptr.To(true)}}
+	tests := []struct {
+		name        string
+		owners      []metav1.OwnerReference
+		annotations map[string]string
+		expected    bool
+	}{
+		{name: "PodNetwork", owners: podOwner, annotations: map[string]string{servicecommon.AnnotationPodInterface: "eth1"}, expected: true},
+		{name: "NoInterfaceAnnotation", owners: podOwner},
+		{name: "NoOwner", annotations: map[string]string{servicecommon.AnnotationPodInterface: "eth1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetPort := &v1alpha1.SubnetPort{ObjectMeta: metav1.ObjectMeta{OwnerReferences: tt.owners, Annotations: tt.annotations}}
+			assert.Equal(t, tt.expected, isPodNetworkSubnetPort(subnetPort))
+		})
+	}
+}
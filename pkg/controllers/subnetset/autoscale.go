@@ -0,0 +1,209 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// DefaultAutoScaleCoolDown is the time an empty Subnet of a SubnetSet is kept before it is reclaimed if
+// subnetset_autoscale_cool_down is not set.
+var DefaultAutoScaleCoolDown = 10 * time.Minute
+
+// subnetIPUsage is the IPv4 usage of an NSX Subnet of a SubnetSet.
+type subnetIPUsage struct {
+	nsxSubnet *model.VpcSubnet
+	used      int
+	total     int
+}
+
+func (r *SubnetSetReconciler) autoScaleThreshold() int {
+	if r.SubnetService.NSXConfig == nil || r.SubnetService.NSXConfig.K8sConfig == nil {
+		return 0
+	}
+	return r.SubnetService.NSXConfig.SubnetSetAutoScaleThreshold
+}
+
+// ipUtilizationEnabled checks if the auto-scaling feature is configured, the IPv4 utilization of the SubnetSets is
+// only read from NSX and reported when it is.
+func (r *SubnetSetReconciler) ipUtilizationEnabled() bool {
+	threshold := r.autoScaleThreshold()
+	return threshold > 0 && threshold <= 100 && !r.restoreMode
+}
+
+func (r *SubnetSetReconciler) autoScaleCoolDown() time.Duration {
+	if r.SubnetService.NSXConfig == nil || r.SubnetService.NSXConfig.K8sConfig == nil || r.SubnetService.NSXConfig.SubnetSetAutoScaleCoolDown <= 0 {
+		return DefaultAutoScaleCoolDown
+	}
+	return time.Duration(r.SubnetService.NSXConfig.SubnetSetAutoScaleCoolDown) * time.Second
+}
+
+// autoScaleEnabled checks if the Subnets of the SubnetSet are scaled by the IPv4 utilization. SubnetSets with
+// pre-created Subnets, DHCPRelay or IPv6 only SubnetSets are not scaled.
+func (r *SubnetSetReconciler) autoScaleEnabled(subnetSet *v1alpha1.SubnetSet) bool {
+	return r.ipUtilizationEnabled() && subnetSet.Spec.SubnetNames == nil &&
+		subnetSet.Spec.SubnetDHCPConfig.Mode != v1alpha1.DHCPConfigMode(v1alpha1.DHCPConfigModeRelay) &&
+		util.IPAddressTypeIncludesIPv4(subnetSet.Spec.IPAddressType)
+}
+
+// getSubnetIPUsages gets the IPv4 usage of the NSX Subnets. The SubnetPorts in the store are counted as used in case
+// NSX has not allocated their IPs yet.
+func (r *SubnetSetReconciler) getSubnetIPUsages(nsxSubnets []*model.VpcSubnet) ([]subnetIPUsage, error) {
+	usages := make([]subnetIPUsage, 0, len(nsxSubnets))
+	for _, nsxSubnet := range nsxSubnets {
+		used, total, err := r.SubnetService.GetSubnetIPUsage(nsxSubnet)
+		if err != nil {
+			return nil, err
+		}
+		used = max(used, len(r.SubnetPortService.GetPortsOfSubnet(*nsxSubnet.Path)))
+		usages = append(usages, subnetIPUsage{nsxSubnet: nsxSubnet, used: used, total: total})
+	}
+	return usages, nil
+}
+
+func buildIPUtilization(usages []subnetIPUsage) *v1alpha1.IPUtilization {
+	utilization := &v1alpha1.IPUtilization{}
+	for _, usage := range usages {
+		utilization.UsedIPs += usage.used
+		utilization.TotalIPs += usage.total
+	}
+	if utilization.TotalIPs > 0 {
+		utilization.Percentage = utilization.UsedIPs * 100 / utilization.TotalIPs
+	}
+	return utilization
+}
+
+// updateSubnetSetIPUtilization reports the IPv4 utilization of the NSX Subnets of the SubnetSet on its status.
+func (r *SubnetSetReconciler) updateSubnetSetIPUtilization(ctx context.Context, subnetSet *v1alpha1.SubnetSet, utilization *v1alpha1.IPUtilization) error {
+	if reflect.DeepEqual(subnetSet.Status.IPUtilization, utilization) {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.SubnetSet{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, latest); err != nil {
+			return err
+		}
+		if reflect.DeepEqual(latest.Status.IPUtilization, utilization) {
+			return nil
+		}
+		latest.Status.IPUtilization = utilization
+		return r.Client.Status().Update(ctx, latest)
+	})
+}
+
+// reportSubnetSetIPUtilization reports the IPv4 utilization of the SubnetSet whose Subnets are not auto-scaled, e.g. a
+// DHCPRelay SubnetSet, when the auto-scaling feature is configured.
+func (r *SubnetSetReconciler) reportSubnetSetIPUtilization(ctx context.Context, subnetSet *v1alpha1.SubnetSet) error {
+	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
+	usages, err := r.getSubnetIPUsages(nsxSubnets)
+	if err != nil {
+		return err
+	}
+	return r.updateSubnetSetIPUtilization(ctx, subnetSet, buildIPUtilization(usages))
+}
+
+// autoScaleSubnetSet pre-creates a new NSX Subnet for the SubnetSet when the IPv4 utilization of its Subnets reaches
+// the threshold, so that the SubnetPorts are not blocked on the Subnet creation when the Subnets are exhausted. Only
+// one spare Subnet is created at a time, a new one is created after all the Subnets are in use. An empty Subnet is
+// reclaimed after the cool-down if the utilization stays below the threshold without it.
+func (r *SubnetSetReconciler) autoScaleSubnetSet(ctx context.Context, subnetSet *v1alpha1.SubnetSet) error {
+	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
+	usages, err := r.getSubnetIPUsages(nsxSubnets)
+	if err != nil {
+		return err
+	}
+	utilization := buildIPUtilization(usages)
+	threshold := r.autoScaleThreshold()
+	now := time.Now()
+
+	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
+	// The empty Subnets are only accessed by the garbage collector goroutine.
+	if r.emptySubnets == nil {
+		r.emptySubnets = make(map[types.UID]map[string]time.Time)
+	}
+	emptySince := make(map[string]time.Time)
+	var emptyUsages []subnetIPUsage
+	for _, usage := range usages {
+		path := *usage.nsxSubnet.Path
+		if !r.SubnetPortService.IsEmptySubnet(path) {
+			continue
+		}
+		emptySince[path] = now
+		if since, ok := r.emptySubnets[subnetSet.GetUID()][path]; ok {
+			emptySince[path] = since
+		}
+		emptyUsages = append(emptyUsages, usage)
+	}
+
+	var scaleErr error
+	if len(emptyUsages) == 0 && utilization.TotalIPs > 0 && utilization.Percentage >= threshold {
+		log.Info("Pre-creating Subnet for SubnetSet", "SubnetSet", subnetSet.Namespace+"/"+subnetSet.Name, "utilization", utilization.Percentage, "threshold", threshold)
+		var nsxSubnet *model.VpcSubnet
+		if nsxSubnet, scaleErr = r.scaleOutSubnetSet(subnetSet); scaleErr == nil {
+			emptySince[*nsxSubnet.Path] = now
+		}
+	} else {
+		total := utilization.TotalIPs
+		coolDown := r.autoScaleCoolDown()
+		var errList []error
+		for _, usage := range emptyUsages {
+			path := *usage.nsxSubnet.Path
+			remaining := total - usage.total
+			if now.Sub(emptySince[path]) < coolDown || (remaining > 0 && utilization.UsedIPs*100/remaining >= threshold) {
+				continue
+			}
+			log.Info("Reclaiming empty Subnet of SubnetSet", "SubnetSet", subnetSet.Namespace+"/"+subnetSet.Name, "nsxSubnet", *usage.nsxSubnet.Id)
+			hasStalePort, err := r.deleteSubnets([]*model.VpcSubnet{usage.nsxSubnet}, true)
+			if err != nil {
+				errList = append(errList, err)
+				continue
+			}
+			if !hasStalePort {
+				total = remaining
+				delete(emptySince, path)
+			}
+		}
+		scaleErr = errors.Join(errList...)
+	}
+	r.emptySubnets[subnetSet.GetUID()] = emptySince
+	common.WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
+
+	if err := r.SubnetService.UpdateSubnetSetStatus(subnetSet); err != nil {
+		return err
+	}
+	if err := r.updateSubnetSetIPUtilization(ctx, subnetSet, utilization); err != nil {
+		return err
+	}
+	return scaleErr
+}
+
+func (r *SubnetSetReconciler) scaleOutSubnetSet(subnetSet *v1alpha1.SubnetSet) (*model.VpcSubnet, error) {
+	tags := r.SubnetService.GenerateSubnetNSTags(subnetSet)
+	if tags == nil {
+		return nil, errors.New("failed to generate subnet tags")
+	}
+	vpcInfoList := r.VPCService.ListVPCInfo(subnetSet.Namespace)
+	if len(vpcInfoList) == 0 {
+		return nil, fmt.Errorf("failed to find VPC for Namespace %s", subnetSet.Namespace)
+	}
+	nsxSubnet, err := r.SubnetService.CreateOrUpdateSubnet(subnetSet, vpcInfoList[0], tags)
+	if err != nil {
+		log.Error(err, "Failed to pre-create Subnet for SubnetSet", "SubnetSet", subnetSet.Namespace+"/"+subnetSet.Name)
+		return nil, err
+	}
+	return nsxSubnet, nil
+}
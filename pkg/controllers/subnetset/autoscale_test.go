@@ -0,0 +1,176 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetset
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+)
+
+func TestSubnetSetReconciler_autoScaleEnabled(t *testing.T) {
+	r := createFakeSubnetSetReconciler(nil)
+	subnetSet := &v1alpha1.SubnetSet{Spec: v1alpha1.SubnetSetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4}}
+	assert.False(t, r.autoScaleEnabled(subnetSet))
+	assert.False(t, r.ipUtilizationEnabled())
+
+	r.SubnetService.NSXConfig.SubnetSetAutoScaleThreshold = 80
+	assert.True(t, r.ipUtilizationEnabled())
+	assert.True(t, r.autoScaleEnabled(subnetSet))
+	assert.False(t, r.autoScaleEnabled(&v1alpha1.SubnetSet{Spec: v1alpha1.SubnetSetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv6}}))
+	assert.False(t, r.autoScaleEnabled(&v1alpha1.SubnetSet{Spec: v1alpha1.SubnetSetSpec{SubnetNames: &[]string{"subnet-1"}}}))
+	r.EnableRestoreMode()
+	assert.False(t, r.autoScaleEnabled(subnetSet))
+	assert.False(t, r.ipUtilizationEnabled())
+
+	assert.Equal(t, DefaultAutoScaleCoolDown, r.autoScaleCoolDown())
+	r.SubnetService.NSXConfig.SubnetSetAutoScaleCoolDown = 60
+	assert.Equal(t, time.Minute, r.autoScaleCoolDown())
+}
+
+func TestSubnetSetReconciler_autoScaleSubnetSet(t *testing.T) {
+	subnetPath := func(id string) string {
+		return "/orgs/default/projects/default/vpcs/vpc-1/subnets/" + id
+	}
+	newSubnet := func(id string) *model.VpcSubnet {
+		return &model.VpcSubnet{Id: common.String(id), Path: common.String(subnetPath(id))}
+	}
+	type ipUsage struct {
+		used  int
+		total int
+	}
+	tests := []struct {
+		name                string
+		usages              map[string]ipUsage
+		emptySince          map[string]time.Time
+		expectedCreated     bool
+		expectedDeleted     []string
+		expectedUtilization *v1alpha1.IPUtilization
+		expectedEmpty       []string
+	}{
+		{
+			name:                "ScaleOut",
+			usages:              map[string]ipUsage{"subnet-1": {used: 9, total: 10}, "subnet-2": {used: 8, total: 10}},
+			expectedCreated:     true,
+			expectedUtilization: &v1alpha1.IPUtilization{UsedIPs: 17, TotalIPs: 20, Percentage: 85},
+			expectedEmpty:       []string{"subnet-new"},
+		},
+		{
+			name:                "BelowThreshold",
+			usages:              map[string]ipUsage{"subnet-1": {used: 7, total: 10}},
+			expectedUtilization: &v1alpha1.IPUtilization{UsedIPs: 7, TotalIPs: 10, Percentage: 70},
+		},
+		{
+			name:                "SpareSubnetInCoolDown",
+			usages:              map[string]ipUsage{"subnet-1": {used: 5, total: 10}, "subnet-2": {total: 10}},
+			expectedUtilization: &v1alpha1.IPUtilization{UsedIPs: 5, TotalIPs: 20, Percentage: 25},
+			expectedEmpty:       []string{"subnet-2"},
+		},
+		{
+			name:                "ReclaimSpareSubnet",
+			usages:              map[string]ipUsage{"subnet-1": {used: 5, total: 10}, "subnet-2": {total: 10}},
+			emptySince:          map[string]time.Time{subnetPath("subnet-2"): time.Now().Add(-time.Hour)},
+			expectedDeleted:     []string{"subnet-2"},
+			expectedUtilization: &v1alpha1.IPUtilization{UsedIPs: 5, TotalIPs: 20, Percentage: 25},
+		},
+		{
+			name:                "KeepSpareSubnetAboveThreshold",
+			usages:              map[string]ipUsage{"subnet-1": {used: 9, total: 10}, "subnet-2": {total: 10}},
+			emptySince:          map[string]time.Time{subnetPath("subnet-2"): time.Now().Add(-time.Hour)},
+			expectedUtilization: &v1alpha1.IPUtilization{UsedIPs: 9, TotalIPs: 20, Percentage: 45},
+			expectedEmpty:       []string{"subnet-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetSet := &v1alpha1.SubnetSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "subnetset-1", Namespace: "ns-1", UID: "subnetset-uid"},
+				Spec:       v1alpha1.SubnetSetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4},
+			}
+			r := createFakeSubnetSetReconciler([]client.Object{subnetSet})
+			r.SubnetService.NSXConfig.SubnetSetAutoScaleThreshold = 80
+			if tt.emptySince != nil {
+				r.emptySubnets = map[types.UID]map[string]time.Time{subnetSet.UID: tt.emptySince}
+			}
+			var nsxSubnets []*model.VpcSubnet
+			for id := range tt.usages {
+				nsxSubnets = append(nsxSubnets, newSubnet(id))
+			}
+
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService.SubnetStore), "GetByIndex", func(_ *subnet.SubnetStore, _ string, _ string) []*model.VpcSubnet {
+				return nsxSubnets
+			})
+			defer patches.Reset()
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetIPUsage", func(_ *subnet.SubnetService, nsxSubnet *model.VpcSubnet) (int, int, error) {
+				usage := tt.usages[*nsxSubnet.Id]
+				return usage.used, usage.total, nil
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) []*model.VpcSubnetPort {
+				return nil
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
+				for id, usage := range tt.usages {
+					if subnetPath(id) == path {
+						return usage.used == 0
+					}
+				}
+				return true
+			})
+			created := false
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GenerateSubnetNSTags", func(_ *subnet.SubnetService, _ client.Object) []model.Tag {
+				return []model.Tag{}
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, _ string) []common.VPCResourceInfo {
+				return []common.VPCResourceInfo{{OrgID: "default", ProjectID: "default", VPCID: "vpc-1"}}
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ client.Object, _ common.VPCResourceInfo, _ []model.Tag) (*model.VpcSubnet, error) {
+				created = true
+				return newSubnet("subnet-new"), nil
+			})
+			var deleted []string
+			patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ *model.VpcSubnet) error {
+				return nil
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				deleted = append(deleted, *nsxSubnet.Id)
+				return nil
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSetStatus", func(_ *subnet.SubnetService, _ *v1alpha1.SubnetSet) error {
+				return nil
+			})
+
+			require.NoError(t, r.autoScaleSubnetSet(context.TODO(), subnetSet))
+			assert.Equal(t, tt.expectedCreated, created)
+			assert.Equal(t, tt.expectedDeleted, deleted)
+			updated := &v1alpha1.SubnetSet{}
+			require.NoError(t, r.Client.Get(context.TODO(), types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, updated))
+			assert.Equal(t, tt.expectedUtilization, updated.Status.IPUtilization)
+			var empty []string
+			for path := range r.emptySubnets[subnetSet.UID] {
+				empty = append(empty, path)
+			}
+			var expectedEmpty []string
+			for _, id := range tt.expectedEmpty {
+				expectedEmpty = append(expectedEmpty, subnetPath(id))
+			}
+			assert.ElementsMatch(t, expectedEmpty, empty)
+		})
+	}
+}
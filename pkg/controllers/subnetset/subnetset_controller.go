@@ -21,6 +21,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -52,6 +55,12 @@ type SubnetSetReconciler struct {
 	Recorder          record.EventRecorder
 	StatusUpdater     common.StatusUpdater
 	restoreMode       bool
+	// emptySubnets records since when the NSX Subnets of the auto-scaled SubnetSets are empty, by SubnetSet UID and
+	// NSX Subnet path.
+	emptySubnets map[types.UID]map[string]time.Time
+	// DriftEvents receives the SubnetSets owning the drifted NSX Subnets to requeue, nil if the drift audit doesn't
+	// requeue.
+	DriftEvents chan event.GenericEvent
 }
 
 func (r *SubnetSetReconciler) UpdateSubnetSetForSubnetNames(ctx context.Context, subnetsetCR *v1alpha1.SubnetSet) error {
@@ -442,10 +451,11 @@ func getExistingConditionOfType(conditionType v1alpha1.ConditionType, existingCo
 }
 
 func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.SubnetSet{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.NumReconcile(),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSubnetSet),
 		}).
 		Watches(
 			&v1.Namespace{},
@@ -462,8 +472,11 @@ func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 				RequeueByDelete: requeueSubnetSetBySubnetBindingDelete,
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
-		).
-		Complete(r)
+		)
+	if r.DriftEvents != nil {
+		b = b.WatchesRawSource(source.Channel(r.DriftEvents, &handler.EnqueueRequestForObject{}))
+	}
+	return b.Complete(r)
 }
 
 func (r *SubnetSetReconciler) EnableRestoreMode() {
@@ -492,12 +505,24 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 			continue
 		}
 		crdSubnetSetIDsSet.Insert(string(subnetSet.UID))
+		// The empty Subnets of an auto-scaled SubnetSet are reclaimed after the cool-down instead of at once
+		if r.autoScaleEnabled(&subnetSet) {
+			if err := r.autoScaleSubnetSet(ctx, &subnetSet); err != nil {
+				errList = append(errList, err)
+			}
+			continue
+		}
 		if err := r.deleteSubnetForSubnetSet(subnetSet, true, true); err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
 		} else {
 			r.StatusUpdater.IncreaseDeleteSuccessTotal()
 		}
+		if r.ipUtilizationEnabled() {
+			if err := r.reportSubnetSetIPUtilization(ctx, &subnetSet); err != nil {
+				errList = append(errList, err)
+			}
+		}
 	}
 
 	subnetSetIDs := r.SubnetService.ListSubnetSetIDsFromNSXSubnets()
@@ -523,6 +548,11 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 		}
 		return true
 	})
+	for uid := range r.emptySubnets {
+		if !crdSubnetSetIDsSet.Has(string(uid)) {
+			delete(r.emptySubnets, uid)
+		}
+	}
 	if len(errList) > 0 {
 		return fmt.Errorf("errors found in SubnetSet garbage collection: %s", errList)
 	}
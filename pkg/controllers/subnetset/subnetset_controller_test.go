@@ -1040,6 +1040,11 @@ func TestSubnetSetReconciler_CollectGarbage(t *testing.T) {
 	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
 		return nil
 	})
+	usageRead := false
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetIPUsage", func(_ *subnet.SubnetService, _ *model.VpcSubnet) (int, int, error) {
+		usageRead = true
+		return 0, 0, nil
+	})
 
 	patches.ApplyMethod(reflect.TypeOf(&common.ResourceStore{}), "ListIndexFuncValues", func(_ *common.ResourceStore, _ string) sets.Set[string] {
 		res := sets.New[string]("fake-subnetSet-uid-2")
@@ -1066,6 +1071,8 @@ func TestSubnetSetReconciler_CollectGarbage(t *testing.T) {
 	// the lock for should be deleted
 	_, ok := ctlcommon.SubnetSetLocks.Load(subnetSetId)
 	assert.False(t, ok)
+	// the IP utilization is not read from NSX when the auto-scaling is not configured
+	assert.False(t, usageRead)
 }
 
 func TestSubnetSetReconciler_deleteSubnetForSubnetSet(t *testing.T) {
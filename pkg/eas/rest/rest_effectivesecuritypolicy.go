@@ -0,0 +1,67 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+)
+
+var effectiveSecurityPolicyColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"},
+	{Name: "TYPE", Type: "string", Description: "Kind of the policy"},
+	{Name: "SECTIONS", Type: "integer", Description: "Number of NSX SecurityPolicies"},
+	{Name: "RULES", Type: "integer", Description: "Number of NSX rules"},
+	{Name: "GROUPS", Type: "integer", Description: "Number of NSX groups"},
+	{Name: "REALIZED_STATE", Type: "string", Description: "Realized state of the NSX SecurityPolicies"},
+}
+
+func effectiveSecurityPolicyRealizedState(obj *easv1alpha1.EffectiveSecurityPolicy) string {
+	state := ""
+	for _, section := range obj.Sections {
+		if state == "" || section.RealizedState != "REALIZED" {
+			state = section.RealizedState
+		}
+	}
+	return state
+}
+
+func NewEffectiveSecurityPolicyStorage(store *storage.EffectiveSecurityPolicyStorage) *effectiveSecurityPolicyStorage {
+	return &effectiveSecurityPolicyStorage{store: store}
+}
+
+// effectiveSecurityPolicyStorage supports Get-by-name only; List is not exposed for this resource.
+type effectiveSecurityPolicyStorage struct {
+	store *storage.EffectiveSecurityPolicyStorage
+}
+
+func (r *effectiveSecurityPolicyStorage) New() runtime.Object {
+	return &easv1alpha1.EffectiveSecurityPolicy{}
+}
+func (r *effectiveSecurityPolicyStorage) Destroy()                {}
+func (r *effectiveSecurityPolicyStorage) NamespaceScoped() bool   { return true }
+func (r *effectiveSecurityPolicyStorage) GetSingularName() string { return "effectivesecuritypolicy" }
+
+func (r *effectiveSecurityPolicyStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	return r.store.Get(ctx, ns, name)
+}
+
+func (r *effectiveSecurityPolicyStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: effectiveSecurityPolicyColumns}
+	obj, ok := object.(*easv1alpha1.EffectiveSecurityPolicy)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %T for EffectiveSecurityPolicy table", object)
+	}
+	table.Rows = []metav1.TableRow{tableRow(obj.Name, obj.Namespace, obj.PolicyType,
+		len(obj.Sections), len(obj.Rules), len(obj.Groups), effectiveSecurityPolicyRealizedState(obj))}
+	return table, nil
+}
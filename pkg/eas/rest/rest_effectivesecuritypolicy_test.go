@@ -0,0 +1,62 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+func newEffectiveSecurityPolicyREST() *effectiveSecurityPolicyStorage {
+	return NewEffectiveSecurityPolicyStorage(
+		storage.NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newTestFakeK8sClient().Build()),
+	)
+}
+
+func TestEffectiveSecurityPolicyStorage_Metadata(t *testing.T) {
+	r := newEffectiveSecurityPolicyREST()
+	assert.IsType(t, &easv1alpha1.EffectiveSecurityPolicy{}, r.New())
+	assert.True(t, r.NamespaceScoped())
+	assert.Equal(t, "effectivesecuritypolicy", r.GetSingularName())
+	r.Destroy()
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_NoPolicy(t *testing.T) {
+	r := newEffectiveSecurityPolicyREST()
+	ctx := request.WithNamespace(context.Background(), "ns1")
+	_, err := r.Get(ctx, "sp1", &metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+func TestEffectiveSecurityPolicyStorage_ConvertToTable_Success(t *testing.T) {
+	r := newEffectiveSecurityPolicyREST()
+	obj := &easv1alpha1.EffectiveSecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "sp1", Namespace: "ns1"},
+		PolicyType: "SecurityPolicy",
+		Sections:   []easv1alpha1.EffectiveSection{{Name: "s1", RealizedState: "REALIZED"}, {Name: "s2", RealizedState: "IN_PROGRESS"}},
+		Rules:      []easv1alpha1.EffectiveRule{{Name: "r1"}},
+		Groups:     []easv1alpha1.EffectiveGroup{{Name: "g1"}, {Name: "g2"}},
+	}
+	table, err := r.ConvertToTable(context.Background(), obj, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, []interface{}{"sp1", "SecurityPolicy", 2, 1, 2, "IN_PROGRESS"}, table.Rows[0].Cells)
+	assert.Equal(t, effectiveSecurityPolicyColumns, table.ColumnDefinitions)
+}
+
+func TestEffectiveSecurityPolicyStorage_ConvertToTable_Error(t *testing.T) {
+	r := newEffectiveSecurityPolicyREST()
+	_, err := r.ConvertToTable(context.Background(), &easv1alpha1.VPCIPAddressUsage{}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported type")
+}
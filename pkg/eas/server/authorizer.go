@@ -14,7 +14,8 @@ import (
 // # Authorization model
 //
 // EAS exposes read-only resources (VPCIPAddressUsage, IPBlockUsage,
-// SubnetIPPools, SubnetDHCPServerStats).  All write paths are absent by design.
+// SubnetIPPools, SubnetDHCPServerStats, EffectiveSecurityPolicy).  All write
+// paths are absent by design.
 //
 // In the Kubernetes aggregated-API-server model, every request reaches the EAS
 // server only after the kube-apiserver has already:
@@ -30,6 +31,9 @@ import (
 //
 // This authorizer therefore allows every request that arrives here, trusting
 // that the kube-apiserver aggregation layer has already enforced access control.
+// The one exception is EffectiveSecurityPolicy, which exposes the NSX rules and
+// group members of a policy: its storage reviews the caller's access to the
+// underlying SecurityPolicy or NetworkPolicy before serving it.
 type easAuthorizer struct{}
 
 // Authorize always returns DecisionAllow.  The upstream kube-apiserver
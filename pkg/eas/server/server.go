@@ -48,6 +48,7 @@ type EASServer struct {
 	ipBlockUsage    *storage.IPBlockUsageStorage
 	subnetIPPools   *storage.SubnetIPPoolsStorage
 	subnetDHCPStats *storage.SubnetDHCPStatsStorage
+	effectiveSP     *storage.EffectiveSecurityPolicyStorage
 	// nsxHealthChecker is added to the generic API server's /readyz endpoint
 	// so that the pod is removed from Service endpoints when NSX is unreachable.
 	nsxHealthChecker healthz.HealthChecker
@@ -90,6 +91,7 @@ func NewEASServer(
 		ipBlockUsage:    storage.NewIPBlockUsageStorage(nsxClient, vpcProvider),
 		subnetIPPools:   storage.NewSubnetIPPoolsStorage(nsxClient, k8sClient),
 		subnetDHCPStats: storage.NewSubnetDHCPStatsStorage(nsxClient, k8sClient),
+		effectiveSP:     storage.NewEffectiveSecurityPolicyStorage(nsxClient, k8sClient),
 		// /readyz reports not-ready when NSX is unreachable, causing kube-proxy
 		// to stop routing traffic to this pod until connectivity is restored.
 		nsxHealthChecker: healthz.NamedCheck("nsx", nsxClient.NSXChecker.CheckNSXHealth),
@@ -233,10 +235,11 @@ func (s *EASServer) buildGenericAPIServer() (*genericapiserver.GenericAPIServer,
 		codecs,
 	)
 	apiGroupInfo.VersionedResourcesStorageMap[easv1alpha1.GroupVersion.Version] = map[string]apirest.Storage{
-		"vpcipaddressusages":    rest.NewVPCIPUsageStorage(s.vpcIPUsage, s.vpcProvider),
-		"ipblockusages":         rest.NewIPBlockUsageStorage(s.ipBlockUsage, s.vpcProvider),
-		"subnetippools":         rest.NewSubnetIPPoolsStorage(s.subnetIPPools),
-		"subnetdhcpserverstats": rest.NewSubnetDHCPStatsStorage(s.subnetDHCPStats),
+		"vpcipaddressusages":        rest.NewVPCIPUsageStorage(s.vpcIPUsage, s.vpcProvider),
+		"ipblockusages":             rest.NewIPBlockUsageStorage(s.ipBlockUsage, s.vpcProvider),
+		"subnetippools":             rest.NewSubnetIPPoolsStorage(s.subnetIPPools),
+		"subnetdhcpserverstats":     rest.NewSubnetDHCPStatsStorage(s.subnetDHCPStats),
+		"effectivesecuritypolicies": rest.NewEffectiveSecurityPolicyStorage(s.effectiveSP),
 	}
 
 	if err := srv.InstallAPIGroup(&apiGroupInfo); err != nil {
@@ -50,6 +50,7 @@ func TestNewEASServer(t *testing.T) {
 	assert.NotNil(t, s.ipBlockUsage)
 	assert.NotNil(t, s.subnetIPPools)
 	assert.NotNil(t, s.subnetDHCPStats)
+	assert.NotNil(t, s.effectiveSP)
 }
 
 func TestBuildGenericAPIServer_ErrorsWithoutCert(t *testing.T) {
@@ -0,0 +1,268 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxcommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+const (
+	policyTypeSecurityPolicy = "SecurityPolicy"
+	policyTypeNetworkPolicy  = "NetworkPolicy"
+)
+
+// EffectiveSecurityPolicyStorage implements REST operations for EffectiveSecurityPolicy.
+// Only Get is supported; List is not.
+type EffectiveSecurityPolicyStorage struct {
+	nsxClient *nsx.Client
+	k8sClient k8sclient.Client
+}
+
+// NewEffectiveSecurityPolicyStorage creates a new storage instance.
+func NewEffectiveSecurityPolicyStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client) *EffectiveSecurityPolicyStorage {
+	return &EffectiveSecurityPolicyStorage{
+		nsxClient: nsxClient,
+		k8sClient: k8sClient,
+	}
+}
+
+// Get returns the NSX SecurityPolicies, rules, groups and shares generated for the policy identified by name.
+// name must be the name of a SecurityPolicy CR, or of a NetworkPolicy if no such SecurityPolicy CR exists.
+// The NSX resources are searched by the owner UID tag, and the member IPs of each group and the realized state
+// of each NSX SecurityPolicy are read from NSX on every call.
+func (s *EffectiveSecurityPolicyStorage) Get(ctx context.Context, namespace, name string) (*easv1alpha1.EffectiveSecurityPolicy, error) {
+	log := logger.Log
+
+	policyType, indexScope, uids, err := s.resolvePolicy(ctx, namespace, name)
+	if err != nil {
+		// A missing policy is only reported to the user who can get both SecurityPolicies and NetworkPolicies, the
+		// others get the same Forbidden error as for an existing policy, so that its existence is not disclosed.
+		if apierrors.IsNotFound(err) {
+			for _, t := range []string{policyTypeSecurityPolicy, policyTypeNetworkPolicy} {
+				if authErr := s.authorizePolicy(ctx, t, namespace, name); authErr != nil {
+					return nil, authErr
+				}
+			}
+		}
+		return nil, err
+	}
+	if err := s.authorizePolicy(ctx, policyType, namespace, name); err != nil {
+		return nil, err
+	}
+	log.Debug("Fetching effective security policy", "namespace", namespace, "name", name, "policyType", policyType)
+
+	resources, err := securitypolicy.SearchEffectiveResources(nsxcommon.Service{NSXClient: s.nsxClient}, indexScope, uids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search NSX resources for %s %s/%s: %w", policyType, namespace, name, err)
+	}
+	if len(resources.SecurityPolicies) == 0 {
+		return nil, fmt.Errorf("EffectiveSecurityPolicy %s/%s not found: no NSX SecurityPolicy is realized for the %s", namespace, name, policyType)
+	}
+
+	result := &easv1alpha1.EffectiveSecurityPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "EffectiveSecurityPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		PolicyType: policyType,
+	}
+	for _, sp := range resources.SecurityPolicies {
+		result.Sections = append(result.Sections, easv1alpha1.EffectiveSection{
+			Name:           DerefString(sp.Id),
+			Path:           DerefString(sp.Path),
+			SequenceNumber: DerefInt64(sp.SequenceNumber),
+			AppliedTo:      sp.Scope,
+			RealizedState:  s.realizedState(DerefString(sp.Path)),
+		})
+	}
+	for _, rule := range resources.Rules {
+		result.Rules = append(result.Rules, ConvertEffectiveRule(rule))
+	}
+	for _, group := range resources.Groups {
+		effectiveGroup := ConvertEffectiveGroup(group)
+		effectiveGroup.MemberIPs = s.groupMemberIPs(effectiveGroup.Path)
+		result.Groups = append(result.Groups, effectiveGroup)
+	}
+	for _, share := range resources.Shares {
+		result.Shares = append(result.Shares, easv1alpha1.EffectiveShare{
+			Name:       DerefString(share.Id),
+			Path:       DerefString(share.Path),
+			SharedWith: share.SharedWith,
+		})
+	}
+	return result, nil
+}
+
+// resolvePolicy returns the policy type, the NSX tag scope of the owner UID and the owner UIDs of the NSX
+// resources generated for the SecurityPolicy CR or the NetworkPolicy with the given name.
+func (s *EffectiveSecurityPolicyStorage) resolvePolicy(ctx context.Context, namespace, name string) (string, string, []string, error) {
+	key := k8sclient.ObjectKey{Namespace: namespace, Name: name}
+	sp := &vpcv1alpha1.SecurityPolicy{}
+	err := s.k8sClient.Get(ctx, key, sp)
+	if err == nil {
+		return policyTypeSecurityPolicy, nsxcommon.TagScopeSecurityPolicyUID, []string{string(sp.UID)}, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", "", nil, fmt.Errorf("failed to get SecurityPolicy %s/%s: %w", namespace, name, err)
+	}
+
+	np := &networkingv1.NetworkPolicy{}
+	if err := s.k8sClient.Get(ctx, key, np); err != nil {
+		return "", "", nil, fmt.Errorf("SecurityPolicy or NetworkPolicy %s/%s not found: %w", namespace, name, err)
+	}
+	// A NetworkPolicy is realized to an allow section and an isolation section, each tagged with its own UID.
+	svc := &securitypolicy.SecurityPolicyService{}
+	uids := []string{
+		svc.BuildNetworkPolicyAllowPolicyID(string(np.UID)),
+		svc.BuildNetworkPolicyIsolationPolicyID(string(np.UID)),
+	}
+	return policyTypeNetworkPolicy, nsxcommon.TagScopeNetworkPolicyUID, uids, nil
+}
+
+// authorizePolicy checks with a SubjectAccessReview that the requesting user can get the SecurityPolicy CR or the
+// NetworkPolicy. The NSX rules, groups and member IPs are read with the credentials of the EAS server, so the access
+// to EffectiveSecurityPolicy alone must not expose the policies of the namespaces the user can't read.
+func (s *EffectiveSecurityPolicyStorage) authorizePolicy(ctx context.Context, policyType, namespace, name string) error {
+	resource := schema.GroupResource{Group: vpcv1alpha1.GroupVersion.Group, Resource: "securitypolicies"}
+	if policyType == policyTypeNetworkPolicy {
+		resource = schema.GroupResource{Group: networkingv1.GroupName, Resource: "networkpolicies"}
+	}
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: "effectivesecuritypolicies"}, name,
+		fmt.Errorf("user cannot get %s %s/%s", resource.String(), namespace, name))
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return forbidden
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(u.GetExtra()))
+	for key, value := range u.GetExtra() {
+		extra[key] = value
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   u.GetName(),
+			UID:    u.GetUID(),
+			Groups: u.GetGroups(),
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "get",
+				Group:     resource.Group,
+				Resource:  resource.Resource,
+				Name:      name,
+			},
+		},
+	}
+	if err := s.k8sClient.Create(ctx, sar); err != nil {
+		return fmt.Errorf("failed to review access to %s %s/%s: %w", resource.String(), namespace, name, err)
+	}
+	if !sar.Status.Allowed {
+		logger.Log.Info("Denied EffectiveSecurityPolicy request", "user", u.GetName(), "namespace", namespace, "name", name, "reason", sar.Status.Reason)
+		return forbidden
+	}
+	return nil
+}
+
+// realizedState summarizes the realized state of the entities of the NSX intent path: ERROR if any entity is in
+// error, REALIZED if all entities are realized, otherwise the state of the first unrealized entity.
+func (s *EffectiveSecurityPolicyStorage) realizedState(intentPath string) string {
+	if intentPath == "" {
+		return ""
+	}
+	results, err := s.nsxClient.RealizedEntitiesClient.List(intentPath, nil)
+	if err != nil {
+		logger.Log.Error(err, "Failed to get realized state from NSX", "path", intentPath)
+		return ""
+	}
+	state := ""
+	for _, entity := range results.Results {
+		entityState := DerefString(entity.State)
+		if entityState == model.GenericPolicyRealizedResource_STATE_ERROR {
+			return entityState
+		}
+		if state == "" || state == model.GenericPolicyRealizedResource_STATE_REALIZED {
+			state = entityState
+		}
+	}
+	return state
+}
+
+// groupMemberIPs returns the effective member IPs of the NSX group. The IPs are best-effort: a failure is
+// logged and an empty list is returned, so that the rest of the preview is still served.
+func (s *EffectiveSecurityPolicyStorage) groupMemberIPs(groupPath string) []string {
+	if groupPath == "" {
+		return nil
+	}
+	memberIPs, err := securitypolicy.GetGroupMemberIPs(nsxcommon.Service{NSXClient: s.nsxClient}, groupPath)
+	if err != nil {
+		logger.Log.Error(err, "Failed to get group member IPs from NSX", "path", groupPath)
+		return nil
+	}
+	return memberIPs
+}
+
+// ConvertEffectiveRule converts an NSX Rule to an EffectiveRule.
+func ConvertEffectiveRule(rule *model.Rule) easv1alpha1.EffectiveRule {
+	return easv1alpha1.EffectiveRule{
+		Name:              DerefString(rule.Id),
+		DisplayName:       DerefString(rule.DisplayName),
+		Path:              DerefString(rule.Path),
+		Action:            DerefString(rule.Action),
+		Direction:         DerefString(rule.Direction),
+		SequenceNumber:    DerefInt64(rule.SequenceNumber),
+		SourceGroups:      rule.SourceGroups,
+		DestinationGroups: rule.DestinationGroups,
+		AppliedTo:         rule.Scope,
+		ServiceEntries:    encodeStructValues(rule.ServiceEntries),
+	}
+}
+
+// ConvertEffectiveGroup converts an NSX Group to an EffectiveGroup without the member IPs.
+func ConvertEffectiveGroup(group *model.Group) easv1alpha1.EffectiveGroup {
+	return easv1alpha1.EffectiveGroup{
+		Name:        DerefString(group.Id),
+		Path:        DerefString(group.Path),
+		Expressions: encodeStructValues(group.Expression),
+	}
+}
+
+// encodeStructValues renders NSX polymorphic values, e.g. group expressions and rule service entries, in JSON.
+func encodeStructValues(values []*data.StructValue) []string {
+	encoder := cleanjson.NewDataValueToJsonEncoder()
+	var result []string
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		encoded, err := encoder.Encode(value)
+		if err != nil {
+			logger.Log.Error(err, "Failed to encode NSX value")
+			continue
+		}
+		result = append(result, encoded)
+	}
+	return result
+}
@@ -0,0 +1,239 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+type fakeRealizedEntitiesClient struct {
+	states []string
+	err    error
+}
+
+func (f *fakeRealizedEntitiesClient) List(string, *string) (model.GenericPolicyRealizedResourceListResult, error) {
+	result := model.GenericPolicyRealizedResourceListResult{}
+	for i := range f.states {
+		result.Results = append(result.Results, model.GenericPolicyRealizedResource{State: &f.states[i]})
+	}
+	return result, f.err
+}
+
+// testAdmin is allowed to get the SecurityPolicies and NetworkPolicies by the SubjectAccessReviews of the fake client.
+const testAdmin = "admin"
+
+func newEffectiveSPFakeK8sClient(objs ...k8sclient.Object) k8sclient.Client {
+	scheme := runtime.NewScheme()
+	_ = vpcv1alpha1.AddToScheme(scheme)
+	_ = networkingv1.AddToScheme(scheme)
+	_ = authorizationv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c k8sclient.WithWatch, obj k8sclient.Object, opts ...k8sclient.CreateOption) error {
+			if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+				sar.Status.Allowed = sar.Spec.User == testAdmin
+				return nil
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+}
+
+func userContext(name string) context.Context {
+	return request.WithUser(context.Background(), &user.DefaultInfo{Name: name, Groups: []string{user.AllAuthenticated}})
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_PolicyNotFound(t *testing.T) {
+	s := NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newEffectiveSPFakeK8sClient())
+	_, err := s.Get(userContext(testAdmin), "ns1", "sp1")
+	require.Error(t, err)
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.Contains(t, err.Error(), "SecurityPolicy or NetworkPolicy ns1/sp1 not found")
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_SearchError(t *testing.T) {
+	sp := &vpcv1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sp1", Namespace: "ns1", UID: "sp-uid"}}
+	patches := gomonkey.ApplyFunc(securitypolicy.SearchEffectiveResources, func(common.Service, string, ...string) (*securitypolicy.EffectiveResources, error) {
+		return nil, fmt.Errorf("search error")
+	})
+	defer patches.Reset()
+
+	s := NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newEffectiveSPFakeK8sClient(sp))
+	_, err := s.Get(userContext(testAdmin), "ns1", "sp1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "search error")
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_NotRealized(t *testing.T) {
+	sp := &vpcv1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sp1", Namespace: "ns1", UID: "sp-uid"}}
+	patches := gomonkey.ApplyFunc(securitypolicy.SearchEffectiveResources, func(common.Service, string, ...string) (*securitypolicy.EffectiveResources, error) {
+		return &securitypolicy.EffectiveResources{}, nil
+	})
+	defer patches.Reset()
+
+	s := NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newEffectiveSPFakeK8sClient(sp))
+	_, err := s.Get(userContext(testAdmin), "ns1", "sp1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no NSX SecurityPolicy is realized")
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_SecurityPolicy(t *testing.T) {
+	sp := &vpcv1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sp1", Namespace: "ns1", UID: "sp-uid"}}
+	resources := &securitypolicy.EffectiveResources{
+		SecurityPolicies: []*model.SecurityPolicy{{
+			Id:    strPtr("sp1_sp-uid"),
+			Path:  strPtr("/orgs/default/projects/p1/vpcs/vpc1/security-policies/sp1_sp-uid"),
+			Scope: []string{"/orgs/default/projects/p1/vpcs/vpc1/groups/sp1_sp-uid_scope"},
+		}},
+		Rules: []*model.Rule{{
+			Id:             strPtr("sp1_sp-uid_0_0"),
+			Action:         strPtr("ALLOW"),
+			Direction:      strPtr("IN"),
+			SequenceNumber: int64Ptr(1),
+			SourceGroups:   []string{"/orgs/default/projects/p1/vpcs/vpc1/groups/sp1_sp-uid_0_src"},
+			ServiceEntries: []*data.StructValue{data.NewStructValue("", map[string]data.DataValue{
+				"l4_protocol": data.NewStringValue("TCP"),
+			})},
+		}},
+		Groups: []*model.Group{{
+			Id:   strPtr("sp1_sp-uid_0_src"),
+			Path: strPtr("/orgs/default/projects/p1/vpcs/vpc1/groups/sp1_sp-uid_0_src"),
+		}},
+		Shares: []*model.Share{{
+			Id:         strPtr("share1"),
+			SharedWith: []string{"/orgs/default/projects/p1/vpcs/vpc1"},
+		}},
+	}
+	var searchScope string
+	var searchUIDs []string
+	patches := gomonkey.ApplyFunc(securitypolicy.SearchEffectiveResources, func(_ common.Service, indexScope string, uids ...string) (*securitypolicy.EffectiveResources, error) {
+		searchScope = indexScope
+		searchUIDs = uids
+		return resources, nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(securitypolicy.GetGroupMemberIPs, func(_ common.Service, groupPath string) ([]string, error) {
+		assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc1/groups/sp1_sp-uid_0_src", groupPath)
+		return []string{"10.0.0.1"}, nil
+	})
+
+	c := &nsx.Client{}
+	c.RealizedEntitiesClient = &fakeRealizedEntitiesClient{states: []string{"REALIZED", "REALIZED"}}
+	s := NewEffectiveSecurityPolicyStorage(c, newEffectiveSPFakeK8sClient(sp))
+	result, err := s.Get(userContext(testAdmin), "ns1", "sp1")
+	require.NoError(t, err)
+	assert.Equal(t, common.TagScopeSecurityPolicyUID, searchScope)
+	assert.Equal(t, []string{"sp-uid"}, searchUIDs)
+	assert.Equal(t, "sp1", result.Name)
+	assert.Equal(t, "SecurityPolicy", result.PolicyType)
+	require.Len(t, result.Sections, 1)
+	assert.Equal(t, "REALIZED", result.Sections[0].RealizedState)
+	require.Len(t, result.Rules, 1)
+	assert.Equal(t, "ALLOW", result.Rules[0].Action)
+	assert.Equal(t, []string{`{"l4_protocol":"TCP"}`}, result.Rules[0].ServiceEntries)
+	require.Len(t, result.Groups, 1)
+	assert.Equal(t, []string{"10.0.0.1"}, result.Groups[0].MemberIPs)
+	require.Len(t, result.Shares, 1)
+	assert.Equal(t, "share1", result.Shares[0].Name)
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_NetworkPolicy(t *testing.T) {
+	np := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "np1", Namespace: "ns1", UID: "np-uid"}}
+	var searchScope string
+	var searchUIDs []string
+	patches := gomonkey.ApplyFunc(securitypolicy.SearchEffectiveResources, func(_ common.Service, indexScope string, uids ...string) (*securitypolicy.EffectiveResources, error) {
+		searchScope = indexScope
+		searchUIDs = uids
+		return &securitypolicy.EffectiveResources{
+			SecurityPolicies: []*model.SecurityPolicy{{Id: strPtr("np1_np-uid_allow")}},
+		}, nil
+	})
+	defer patches.Reset()
+
+	s := NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newEffectiveSPFakeK8sClient(np))
+	result, err := s.Get(userContext(testAdmin), "ns1", "np1")
+	require.NoError(t, err)
+	assert.Equal(t, common.TagScopeNetworkPolicyUID, searchScope)
+	assert.Equal(t, []string{"np-uid_allow", "np-uid_drop"}, searchUIDs)
+	assert.Equal(t, "NetworkPolicy", result.PolicyType)
+	require.Len(t, result.Sections, 1)
+	assert.Equal(t, "", result.Sections[0].RealizedState)
+}
+
+func TestEffectiveSecurityPolicyStorage_Get_Forbidden(t *testing.T) {
+	sp := &vpcv1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: "sp1", Namespace: "ns1", UID: "sp-uid"}}
+	np := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "np1", Namespace: "ns1", UID: "np-uid"}}
+	searched := false
+	patches := gomonkey.ApplyFunc(securitypolicy.SearchEffectiveResources, func(common.Service, string, ...string) (*securitypolicy.EffectiveResources, error) {
+		searched = true
+		return &securitypolicy.EffectiveResources{}, nil
+	})
+	defer patches.Reset()
+
+	s := NewEffectiveSecurityPolicyStorage(&nsx.Client{}, newEffectiveSPFakeK8sClient(sp, np))
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		policy string
+	}{
+		{name: "unauthorized user on SecurityPolicy", ctx: userContext("developer"), policy: "sp1"},
+		{name: "unauthorized user on NetworkPolicy", ctx: userContext("developer"), policy: "np1"},
+		{name: "no user", ctx: context.Background(), policy: "sp1"},
+		// The unauthorized user can't tell a missing policy from an existing one.
+		{name: "unauthorized user on missing policy", ctx: userContext("developer"), policy: "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.Get(tt.ctx, "ns1", tt.policy)
+			require.Error(t, err)
+			assert.True(t, apierrors.IsForbidden(err))
+			// The NSX resources are never read for the unauthorized user.
+			assert.False(t, searched)
+		})
+	}
+}
+
+func TestEffectiveSecurityPolicyStorage_realizedState(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []string
+		err    error
+		want   string
+	}{
+		{name: "all realized", states: []string{"REALIZED", "REALIZED"}, want: "REALIZED"},
+		{name: "in progress", states: []string{"REALIZED", "IN_PROGRESS"}, want: "IN_PROGRESS"},
+		{name: "error wins", states: []string{"IN_PROGRESS", "ERROR"}, want: "ERROR"},
+		{name: "nsx error", err: fmt.Errorf("nsx error"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &nsx.Client{}
+			c.RealizedEntitiesClient = &fakeRealizedEntitiesClient{states: tt.states, err: tt.err}
+			s := NewEffectiveSecurityPolicyStorage(c, newEffectiveSPFakeK8sClient())
+			assert.Equal(t, tt.want, s.realizedState("/path"))
+		})
+	}
+}
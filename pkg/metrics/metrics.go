@@ -21,6 +21,23 @@ const (
 	ControllerDeleteTotalKey        = "controller_delete_total"
 	ControllerDeleteSuccessTotalKey = "controller_delete_success_total"
 	ControllerDeleteFailTotalKey    = "controller_delete_fail_total"
+	ControllerReconcileDurationKey  = "controller_reconcile_duration_seconds"
+	ControllerRealizationKey        = "controller_event_to_realization_seconds"
+	ControllerQueueDepthKey         = "controller_queue_depth"
+	ControllerQueueRetriesTotalKey  = "controller_queue_retries_total"
+	ControllerBackoffTotalKey       = "controller_backoff_total"
+	NSXAPIRateLimitKey              = "nsx_api_rate_limit"
+	NSXAPIRateLimitWaitKey          = "nsx_api_rate_limit_wait_seconds"
+	NSXAPIRequestDurationKey        = "nsx_api_request_duration_seconds"
+	NSXAPIResponseTotalKey          = "nsx_api_response_total"
+	NSXEndpointUpKey                = "nsx_endpoint_up"
+	NSXEndpointTransitionsTotalKey  = "nsx_endpoint_status_transitions_total"
+	NSXAuthSessionTotalKey          = "nsx_auth_session_total"
+	NSXEndpointConnectionsKey       = "nsx_endpoint_connections"
+	NSXDriftTotalKey                = "nsx_drift_total"
+	NSXDriftResourcesKey            = "nsx_drift_resources"
+	NSXOrphanResourcesKey           = "nsx_orphan_resources"
+	NSXOrphanDeletedTotalKey        = "nsx_orphan_deleted_total"
 	ScrapeTimeout                   = 30
 )
 
@@ -98,6 +115,162 @@ var (
 		},
 		[]string{"res_type"},
 	)
+	ControllerReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerReconcileDurationKey,
+			Help:      "Duration of reconciling a K8s resource by NSX Operator, the result is 'success' or 'requeue'",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 15),
+		},
+		[]string{"res_type", "result"},
+	)
+	ControllerRealizationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerRealizationKey,
+			Help:      "Duration from the first K8s event of a resource being queued to the resource being successfully realized on NSX",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		},
+		[]string{"res_type"},
+	)
+	ControllerQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerQueueDepthKey,
+			Help:      "Number of K8s resources waiting in the workqueue of NSX Operator controller",
+		},
+		[]string{"res_type"},
+	)
+	ControllerQueueRetriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerQueueRetriesTotalKey,
+			Help:      "Total number of K8s resources requeued with rate limit by NSX Operator controller",
+		},
+		[]string{"res_type"},
+	)
+	ControllerBackoffTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerBackoffTotalKey,
+			Help:      "Total number of K8s resources delayed by the backoff of NSX Operator controller rate limiter",
+		},
+		[]string{"res_type"},
+	)
+	NSXAPIRateLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRateLimitKey,
+			Help:      "Current rate limit per second of the NSX API calls to the NSX manager endpoint, 0 if rate limiter is disabled",
+		},
+		[]string{"endpoint"},
+	)
+	NSXAPIRateLimitWait = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRateLimitWaitKey,
+			Help:      "Time in seconds the last NSX API call waited for the rate limiter of the NSX manager endpoint",
+		},
+		[]string{"endpoint"},
+	)
+	NSXAPIRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRequestDurationKey,
+			Help:      "Duration of the NSX API calls to the NSX manager endpoint excluding the rate limiter wait, by HTTP method and API path template",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+		},
+		[]string{"endpoint", "method", "path"},
+	)
+	NSXAPIResponseTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIResponseTotalKey,
+			Help:      "Total number of the NSX API responses from the NSX manager endpoint by HTTP status code, the code is 'error' if no response is received",
+		},
+		[]string{"endpoint", "method", "code"},
+	)
+	NSXEndpointUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointUpKey,
+			Help:      "Status of the NSX manager endpoint, 1 for UP and 0 for DOWN",
+		},
+		[]string{"endpoint"},
+	)
+	NSXEndpointTransitionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointTransitionsTotalKey,
+			Help:      "Total number of the status changes of the NSX manager endpoint by the new status",
+		},
+		[]string{"endpoint", "status"},
+	)
+	NSXAuthSessionTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAuthSessionTotalKey,
+			Help:      "Total number of the auth sessions created with the NSX manager endpoint, the result is 'success' or 'failure'",
+		},
+		[]string{"endpoint", "result"},
+	)
+	NSXEndpointConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointConnectionsKey,
+			Help:      "Number of the NSX API calls in flight to the NSX manager endpoint",
+		},
+		[]string{"endpoint"},
+	)
+	NSXDriftTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXDriftTotalKey,
+			Help:      "Total number of the NSX resources found different from the store of NSX Operator by the drift audit",
+		},
+		[]string{"res_type", "drift_type"},
+	)
+	NSXDriftResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXDriftResourcesKey,
+			Help:      "Number of the NSX resources different from the store of NSX Operator in the last drift audit",
+		},
+		[]string{"res_type", "drift_type"},
+	)
+	NSXOrphanResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXOrphanResourcesKey,
+			Help:      "Number of the NSX resources whose owner K8s objects no longer exist in the last orphan collection",
+		},
+		[]string{"res_type"},
+	)
+	NSXOrphanDeletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXOrphanDeletedTotalKey,
+			Help:      "Total number of the orphan NSX resources deleted by the orphan collection, the result is 'success' or 'failure'",
+		},
+		[]string{"res_type", "result"},
+	)
 )
 
 var registerMetrics sync.Once
@@ -121,6 +294,24 @@ func InitializePrometheusMetrics() {
 		ControllerDeleteTotal,
 		ControllerDeleteSuccessTotal,
 		ControllerDeleteFailTotal,
+		ControllerReconcileDuration,
+		ControllerRealizationDuration,
+		ControllerQueueDepth,
+		ControllerQueueRetriesTotal,
+		ControllerBackoffTotal,
+		NSXAPIRateLimit,
+		NSXAPIRateLimitWait,
+		NSXAPIRequestDuration,
+		NSXAPIResponseTotal,
+		NSXEndpointUp,
+		NSXEndpointTransitionsTotal,
+		NSXAuthSessionTotal,
+		NSXEndpointConnections,
+		ManagedResources,
+		NSXDriftTotal,
+		NSXDriftResources,
+		NSXOrphanResources,
+		NSXOrphanDeletedTotal,
 	)
 }
 
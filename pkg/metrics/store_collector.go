@@ -0,0 +1,144 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package metrics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	ManagedResourcesKey            = "managed_resources"
+	ManagedResourcesByNamespaceKey = "managed_resources_by_namespace"
+	ManagedResourcesByVPCKey       = "managed_resources_by_vpc"
+)
+
+// ManagedResources reports the number of the NSX resources cached in the stores of the services.
+var ManagedResources = NewStoreCollector()
+
+// StoreCollector is a prometheus collector which counts the objects in the registered stores when the metrics are
+// scraped, so that the stores don't need to update any gauge on the write path.
+type StoreCollector struct {
+	mu     sync.RWMutex
+	stores map[string]*storeIndex
+
+	totalDesc       *prometheus.Desc
+	byNamespaceDesc *prometheus.Desc
+	byVPCDesc       *prometheus.Desc
+}
+
+type storeIndex struct {
+	indexer          cache.Indexer
+	namespaceIndexes []string
+	vpcIndex         string
+}
+
+func NewStoreCollector() *StoreCollector {
+	return &StoreCollector{
+		stores: map[string]*storeIndex{},
+		totalDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(MetricNamespace, MetricSubsystem, ManagedResourcesKey),
+			"Number of the NSX resources managed by NSX Operator in the local store",
+			[]string{"res_type"}, nil,
+		),
+		byNamespaceDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(MetricNamespace, MetricSubsystem, ManagedResourcesByNamespaceKey),
+			"Number of the NSX resources managed by NSX Operator in the local store for each K8s namespace",
+			[]string{"res_type", "namespace"}, nil,
+		),
+		byVPCDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(MetricNamespace, MetricSubsystem, ManagedResourcesByVPCKey),
+			"Number of the NSX resources managed by NSX Operator in the local store for each NSX VPC",
+			[]string{"res_type", "vpc_path"}, nil,
+		),
+	}
+}
+
+// AddStore registers the store of the resource type, it replaces the store registered before with the same type.
+// The resources are counted per namespace with the namespaceIndexes and per VPC with the vpcIndex, an index which
+// the indexer doesn't have is ignored.
+func (c *StoreCollector) AddStore(resType string, indexer cache.Indexer, namespaceIndexes []string, vpcIndex string) {
+	store := &storeIndex{indexer: indexer}
+	indexers := indexer.GetIndexers()
+	for _, index := range namespaceIndexes {
+		if _, ok := indexers[index]; ok {
+			store.namespaceIndexes = append(store.namespaceIndexes, index)
+		}
+	}
+	if _, ok := indexers[vpcIndex]; ok {
+		store.vpcIndex = vpcIndex
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stores[resType] = store
+}
+
+func (c *StoreCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.totalDesc
+	ch <- c.byNamespaceDesc
+	ch <- c.byVPCDesc
+}
+
+func (c *StoreCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resTypes := make([]string, 0, len(c.stores))
+	for resType := range c.stores {
+		resTypes = append(resTypes, resType)
+	}
+	sort.Strings(resTypes)
+	for _, resType := range resTypes {
+		store := c.stores[resType]
+		ch <- prometheus.MustNewConstMetric(c.totalDesc, prometheus.GaugeValue, float64(len(store.indexer.ListKeys())), resType)
+		for namespace, count := range countByIndexes(store.indexer, store.namespaceIndexes) {
+			ch <- prometheus.MustNewConstMetric(c.byNamespaceDesc, prometheus.GaugeValue, float64(count), resType, namespace)
+		}
+		if store.vpcIndex == "" {
+			continue
+		}
+		for vpcPath, count := range countByIndexes(store.indexer, []string{store.vpcIndex}) {
+			ch <- prometheus.MustNewConstMetric(c.byVPCDesc, prometheus.GaugeValue, float64(count), resType, vpcPath)
+		}
+	}
+}
+
+// countByIndexes counts the objects for each index value. An object indexed with the same value by several indexes,
+// e.g. a SubnetPort for a Pod and a VM in the same namespace, is counted once.
+func countByIndexes(indexer cache.Indexer, indexes []string) map[string]int {
+	counts := map[string]int{}
+	for _, value := range valuesOfIndexes(indexer, indexes) {
+		objs := map[interface{}]struct{}{}
+		for _, index := range indexes {
+			items, err := indexer.ByIndex(index, value)
+			if err != nil {
+				log.Error(err, "Failed to count the objects by index", "index", index, "value", value)
+				continue
+			}
+			for _, item := range items {
+				objs[item] = struct{}{}
+			}
+		}
+		if len(objs) > 0 {
+			counts[value] = len(objs)
+		}
+	}
+	return counts
+}
+
+func valuesOfIndexes(indexer cache.Indexer, indexes []string) []string {
+	values := map[string]struct{}{}
+	for _, index := range indexes {
+		for _, value := range indexer.ListIndexFuncValues(index) {
+			values[value] = struct{}{}
+		}
+	}
+	result := make([]string, 0, len(values))
+	for value := range values {
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakeResource struct {
+	name      string
+	namespace string
+	vmNS      string
+	vpcPath   string
+}
+
+func indexByField(field func(*fakeResource) string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		if value := field(obj.(*fakeResource)); value != "" {
+			return []string{value}, nil
+		}
+		return []string{}, nil
+	}
+}
+
+func TestStoreCollector(t *testing.T) {
+	indexer := cache.NewIndexer(func(obj interface{}) (string, error) {
+		return obj.(*fakeResource).name, nil
+	}, cache.Indexers{
+		"namespace":   indexByField(func(r *fakeResource) string { return r.namespace }),
+		"vmNamespace": indexByField(func(r *fakeResource) string { return r.vmNS }),
+		"vpc":         indexByField(func(r *fakeResource) string { return r.vpcPath }),
+	})
+	for _, r := range []*fakeResource{
+		{name: "port-1", namespace: "ns-1", vpcPath: "/vpcs/vpc-1"},
+		{name: "port-2", namespace: "ns-1", vmNS: "ns-1", vpcPath: "/vpcs/vpc-1"},
+		{name: "port-3", vmNS: "ns-2", vpcPath: "/vpcs/vpc-2"},
+	} {
+		require.NoError(t, indexer.Add(r))
+	}
+	noIndexer := cache.NewIndexer(func(obj interface{}) (string, error) {
+		return obj.(*fakeResource).name, nil
+	}, cache.Indexers{})
+	require.NoError(t, noIndexer.Add(&fakeResource{name: "record-1"}))
+
+	collector := NewStoreCollector()
+	collector.AddStore("VpcSubnetPort", indexer, []string{"namespace", "vmNamespace"}, "vpc")
+	collector.AddStore("ProjectDnsRecord", noIndexer, []string{"namespace"}, "vpc")
+
+	expected := `
+# HELP nsx_operator_managed_resources Number of the NSX resources managed by NSX Operator in the local store
+# TYPE nsx_operator_managed_resources gauge
+nsx_operator_managed_resources{res_type="ProjectDnsRecord"} 1
+nsx_operator_managed_resources{res_type="VpcSubnetPort"} 3
+# HELP nsx_operator_managed_resources_by_namespace Number of the NSX resources managed by NSX Operator in the local store for each K8s namespace
+# TYPE nsx_operator_managed_resources_by_namespace gauge
+nsx_operator_managed_resources_by_namespace{namespace="ns-1",res_type="VpcSubnetPort"} 2
+nsx_operator_managed_resources_by_namespace{namespace="ns-2",res_type="VpcSubnetPort"} 1
+# HELP nsx_operator_managed_resources_by_vpc Number of the NSX resources managed by NSX Operator in the local store for each NSX VPC
+# TYPE nsx_operator_managed_resources_by_vpc gauge
+nsx_operator_managed_resources_by_vpc{res_type="VpcSubnetPort",vpc_path="/vpcs/vpc-1"} 2
+nsx_operator_managed_resources_by_vpc{res_type="VpcSubnetPort",vpc_path="/vpcs/vpc-2"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+
+	require.NoError(t, indexer.Delete(&fakeResource{name: "port-3", vmNS: "ns-2", vpcPath: "/vpcs/vpc-2"}))
+	// the total of the two stores, ns-1 and vpc-1 are left.
+	assert.Equal(t, 4, testutil.CollectAndCount(collector))
+}
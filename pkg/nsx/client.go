@@ -43,7 +43,6 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
-	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
@@ -62,10 +61,12 @@ const (
 	StaticIPReservation
 	StatefulSetPod
 	IPv6
+	FQDNFilter
+	SubnetExpansion
 	AllFeatures
 )
 
-var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6"}
+var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6", "FQDN_FILTER", "SUBNET_EXPANSION"}
 
 type Client struct {
 	NsxConfig     *config.NSXOperatorConfig
@@ -175,6 +176,10 @@ func restConnectorAllowOverwrite(c *Cluster) client.Connector {
 	return c.NewRestConnectorAllowOverwrite()
 }
 
+func interactiveRestConnector(c *Cluster, allowOverwrite bool) client.Connector {
+	return c.NewInteractiveRestConnector(allowOverwrite)
+}
+
 func GetClient(cf *config.NSXOperatorConfig) *Client {
 	// Set log level for vsphere-automation-sdk-go
 	logger := logrus.New()
@@ -186,13 +191,17 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 		defaultHttpTimeout = cf.HttpTimeout
 	}
 	c := NewConfig(strings.Join(cf.NsxApiManagers, ","), cf.NsxApiUser, cf.NsxApiPassword, cf.CaFile, 10, 3, defaultHttpTimeout, 20, true, true, true,
-		ratelimiter.AIMD, cf.GetTokenProvider(), nil, cf.Thumbprint)
+		cf.GetAPIRateMode(), cf.GetTokenProvider(), nil, cf.Thumbprint)
+	c.RateLimiters = cf.GetRateLimiterConfigs()
 	c.EnvoyHost = cf.EnvoyHost
 	c.EnvoyPort = cf.EnvoyPort
 	cluster, _ := NewCluster(c)
 
 	connector := restConnector(cluster)
 	connectorAllowOverwrite := restConnectorAllowOverwrite(cluster)
+	// SubnetPort requests block Pod creation, they are served by the rate limiter before the bulk requests.
+	interactiveConnector := interactiveRestConnector(cluster, false)
+	interactiveConnectorAllowOverwrite := interactiveRestConnector(cluster, true)
 
 	queryClient := search.NewQueryClient(connector)
 	groupClient := domains.NewGroupsClient(connector)
@@ -224,8 +233,8 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 	staticRouteClient := vpcs.NewStaticRoutesClient(connector)
 	natRulesClient := nat.NewNatRulesClient(connector)
 	vpcGroupClient := vpcs.NewGroupsClient(connector)
-	portClient := subnets.NewPortsClient(connectorAllowOverwrite)
-	portStateClient := ports.NewStateClient(connector)
+	portClient := subnets.NewPortsClient(interactiveConnectorAllowOverwrite)
+	portStateClient := ports.NewStateClient(interactiveConnector)
 	ipPoolClient := subnets.NewIpPoolsClient(connector)
 	ipAllocationClient := ip_pools.NewIpAllocationsClient(connector)
 	statsClient := dhcp_server_config.NewStatsClient(connector)
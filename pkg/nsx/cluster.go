@@ -20,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	policyclient "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -84,8 +85,7 @@ func NewCluster(config *Config) (*Cluster, error) {
 	cluster.client = cluster.createHTTPClient(cluster.transport, time.Duration(config.HTTPTimeout))
 	cluster.noBalancerClient = cluster.createNoBalancerClient(time.Duration(config.HTTPTimeout), time.Duration(config.ConnIdleTimeout))
 
-	r := ratelimiter.NewRateLimiter(config.APIRateMode)
-	eps, err := cluster.createEndpoints(config.APIManagers, cluster.client, cluster.noBalancerClient, r, config.TokenProvider)
+	eps, err := cluster.createEndpoints(config.APIManagers, cluster.client, cluster.noBalancerClient, createRateLimiters(config), config.TokenProvider)
 	if err != nil {
 		log.Error(err, "Failed to create cluster")
 		return nil, err
@@ -191,6 +191,25 @@ func (cluster *Cluster) NewRestConnectorAllowOverwrite() policyclient.Connector
 	return connector
 }
 
+// SetInteractivePriorityHeader tags the request as interactive for the rate limiter.
+func SetInteractivePriorityHeader(req *http.Request) error {
+	req.Header.Set(ratelimiter.PriorityHeader, ratelimiter.PriorityInteractiveValue)
+	return nil
+}
+
+// NewInteractiveRestConnector creates a RestConnector whose requests are served by the rate limiter before the bulk
+// ones, it is used by the SDK clients of latency-sensitive requests, e.g. SubnetPort for Pod.
+func (cluster *Cluster) NewInteractiveRestConnector(allowOverwrite bool) policyclient.Connector {
+	nsxtUrl := cluster.CreateServerUrl(cluster.endpoints[0].Host(), cluster.endpoints[0].Scheme())
+	processors := []core.RequestProcessor{SetInteractivePriorityHeader}
+	if allowOverwrite {
+		processors = append(processors, SetAllowOverwriteHeader)
+	}
+	connector := policyclient.NewConnector(nsxtUrl, policyclient.UsingRest(nil), policyclient.WithHttpClient(cluster.client), policyclient.WithRequestProcessors(processors...))
+	connector.NewExecutionContext()
+	return connector
+}
+
 func (cluster *Cluster) UsingEnvoy() bool {
 	return cluster.config.EnvoyPort != 0
 }
@@ -319,10 +338,32 @@ func (cluster *Cluster) createNoBalancerClient(timeout, idle time.Duration) *htt
 	return &noBClient
 }
 
-func (cluster *Cluster) createEndpoints(apiManagers []string, client *http.Client, noBClient *http.Client, r ratelimiter.RateLimiter, tokenProvider auth.TokenProvider) ([]*Endpoint, error) {
+// createRateLimiters creates the rate limiter for each API manager, see Config.RateLimiters.
+func createRateLimiters(config *Config) []ratelimiter.RateLimiter {
+	limiters := make([]ratelimiter.RateLimiter, len(config.APIManagers))
+	var shared ratelimiter.RateLimiter
+	switch len(config.RateLimiters) {
+	case 0:
+		shared = ratelimiter.NewRateLimiter(config.APIRateMode)
+	case 1:
+		shared = ratelimiter.NewRateLimiterWithConfig(config.RateLimiters[0])
+	}
+	for i := range limiters {
+		if shared != nil {
+			limiters[i] = shared
+		} else if i < len(config.RateLimiters) {
+			limiters[i] = ratelimiter.NewRateLimiterWithConfig(config.RateLimiters[i])
+		} else {
+			limiters[i] = ratelimiter.NewRateLimiter(config.APIRateMode)
+		}
+	}
+	return limiters
+}
+
+func (cluster *Cluster) createEndpoints(apiManagers []string, client *http.Client, noBClient *http.Client, limiters []ratelimiter.RateLimiter, tokenProvider auth.TokenProvider) ([]*Endpoint, error) {
 	eps := make([]*Endpoint, len(apiManagers))
 	for i := range eps {
-		ep, err := NewEndpoint(apiManagers[i], client, noBClient, r, tokenProvider)
+		ep, err := NewEndpoint(apiManagers[i], client, noBClient, limiters[i], tokenProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -571,6 +612,12 @@ func (nsxVersion *NsxVersion) featureSupported(feature int) bool {
 	case IPv6:
 		minVersion = nsx920Version
 		validFeature = true
+	case FQDNFilter:
+		minVersion = nsx910Version
+		validFeature = true
+	case SubnetExpansion:
+		minVersion = nsx920Version
+		validFeature = true
 	}
 
 	if validFeature {
@@ -42,6 +42,27 @@ func TestNewCluster(t *testing.T) {
 	assert.True(t, err == nil, fmt.Sprintf("Created cluster failed %v", err))
 }
 
+func TestCluster_createRateLimiters(t *testing.T) {
+	config := NewConfig("10.0.0.1,10.0.0.2", "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.FIXRATE, nil, nil, nil)
+	limiters := createRateLimiters(config)
+	assert.Equal(t, 2, len(limiters))
+	assert.Same(t, limiters[0], limiters[1])
+	assert.Equal(t, ratelimiter.MAXRATELIMIT, limiters[0].Rate())
+
+	// one config is shared by all the endpoints
+	config.RateLimiters = []ratelimiter.Config{{Type: ratelimiter.FIXRATE, MaxRate: 30}}
+	limiters = createRateLimiters(config)
+	assert.Same(t, limiters[0], limiters[1])
+	assert.Equal(t, 30, limiters[1].Rate())
+
+	// one config per endpoint
+	config.RateLimiters = []ratelimiter.Config{{Type: ratelimiter.FIXRATE, MaxRate: 30}, {Type: ratelimiter.FIXRATE, MaxRate: 60}}
+	limiters = createRateLimiters(config)
+	assert.NotSame(t, limiters[0], limiters[1])
+	assert.Equal(t, 30, limiters[0].Rate())
+	assert.Equal(t, 60, limiters[1].Rate())
+}
+
 func TestCluster_getThumbprint(t *testing.T) {
 	// one api server, one thumbprint
 	thumbprint := []string{"123"}
@@ -200,9 +221,16 @@ func TestCluster_enableFeature(t *testing.T) {
 	assert.True(t, nsxVersion.featureSupported(ServiceAccountRestore))
 	assert.True(t, nsxVersion.featureSupported(ServiceAccountCertRotation))
 	assert.False(t, nsxVersion.featureSupported(IPv6))
+	assert.False(t, nsxVersion.featureSupported(FQDNFilter))
+
+	nsxVersion.NodeVersion = "9.1.0"
+	assert.False(t, nsxVersion.featureSupported(IPv6))
+	assert.True(t, nsxVersion.featureSupported(FQDNFilter))
+	assert.False(t, nsxVersion.featureSupported(SubnetExpansion))
 
 	nsxVersion.NodeVersion = "9.2.0"
 	assert.True(t, nsxVersion.featureSupported(IPv6))
+	assert.True(t, nsxVersion.featureSupported(SubnetExpansion))
 
 	// Test case for invalid feature
 	feature := 3
@@ -50,6 +50,10 @@ type Config struct {
 	// sent, and will be decreased by half after 429/503 error for each period. The rate has hard max limit of
 	// min(100/s, param api_rate_limit_per_endpoint).
 	APIRateMode ratelimiter.Type
+	// RateLimiters tunes the rate limiters of the endpoints. If it is empty, all the endpoints share one rate limiter
+	// of APIRateMode with default values. If it has one item, all the endpoints share one rate limiter created from
+	// it. Otherwise, it has one item per API manager, and each endpoint has its own rate limiter.
+	RateLimiters []ratelimiter.Config
 	// None, or instance of implemented AbstractJWTProvider which will return the JSON Web Token used in the requests
 	// in NSX for authorization.
 	TokenProvider auth.TokenProvider
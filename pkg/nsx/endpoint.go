@@ -9,11 +9,13 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
@@ -215,6 +217,12 @@ func (ep *Endpoint) setStatus(s EndpointStatus) {
 	if ep.status != s {
 		log.Info("Endpoint status is changing", "endpoint", ep.Host(), "oldStatus", ep.status, "newStatus", s)
 		ep.status = s
+		up := 0.0
+		if s == UP {
+			up = 1
+		}
+		metrics.NSXEndpointUp.WithLabelValues(ep.Host()).Set(up)
+		metrics.NSXEndpointTransitionsTotal.WithLabelValues(ep.Host(), string(s)).Inc()
 	}
 	ep.Unlock()
 }
@@ -239,12 +247,28 @@ func (ep *Endpoint) Status() EndpointStatus {
 	return ep.status
 }
 
-func (ep *Endpoint) wait() {
+func (ep *Endpoint) wait(priority ratelimiter.Priority) {
+	if limiter, ok := ep.ratelimiter.(ratelimiter.PriorityRateLimiter); ok {
+		limiter.WaitWithPriority(priority)
+		return
+	}
 	ep.ratelimiter.Wait()
 }
 
 func (ep *Endpoint) adjustRate(wait time.Duration, status int) {
 	ep.ratelimiter.AdjustRate(wait, status)
+	metrics.NSXAPIRateLimit.WithLabelValues(ep.Host()).Set(float64(ep.ratelimiter.Rate()))
+	metrics.NSXAPIRateLimitWait.WithLabelValues(ep.Host()).Set(wait.Seconds())
+}
+
+// observeRequest records the latency and the status code of an NSX API call, code is 0 if no response is received.
+func (ep *Endpoint) observeRequest(method string, path string, code int, duration time.Duration) {
+	if code == 0 {
+		metrics.NSXAPIResponseTotal.WithLabelValues(ep.Host(), method, "error").Inc()
+		return
+	}
+	metrics.NSXAPIRequestDuration.WithLabelValues(ep.Host(), method, apiPathTemplate(path)).Observe(duration.Seconds())
+	metrics.NSXAPIResponseTotal.WithLabelValues(ep.Host(), method, strconv.Itoa(code)).Inc()
 }
 
 func (ep *Endpoint) setAliveTime(time time.Time) {
@@ -261,11 +285,13 @@ func (ep *Endpoint) setUserPassword(user, password string) {
 }
 
 func (ep *Endpoint) increaseConnNumber() {
-	atomic.AddInt32(&ep.connnumber, 1)
+	conn := atomic.AddInt32(&ep.connnumber, 1)
+	metrics.NSXEndpointConnections.WithLabelValues(ep.Host()).Set(float64(conn))
 }
 
 func (ep *Endpoint) decreaseConnNumber() {
-	atomic.AddInt32(&ep.connnumber, -1)
+	conn := atomic.AddInt32(&ep.connnumber, -1)
+	metrics.NSXEndpointConnections.WithLabelValues(ep.Host()).Set(float64(conn))
 }
 
 // ConnNumber get the connection number of nsx-t.
@@ -283,6 +309,17 @@ func (ep *Endpoint) createAuthSession(certProvider auth.ClientCertProvider, toke
 		return nil
 	}
 
+	err := ep.requestAuthSession(username, password, jar)
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metrics.NSXAuthSessionTotal.WithLabelValues(ep.Host(), result).Inc()
+	return err
+}
+
+// requestAuthSession creates a session with the user and password, and saves the XSRF token and the cookies.
+func (ep *Endpoint) requestAuthSession(username string, password string, jar *Jar) error {
 	u := &url.URL{Host: ep.Host(), Scheme: ep.Scheme()}
 	postValues := url.Values{}
 	postValues.Add("j_username", username)
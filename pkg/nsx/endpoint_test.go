@@ -13,9 +13,11 @@ import (
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth/jwt"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
@@ -155,7 +157,25 @@ func TestCreateAuthSession(t *testing.T) {
 
 	err = ep.createAuthSession(nil, nil, "admin", "password", jar)
 	assert.Equal(err.Error(), "no token in response", "Auth should be failed")
+	assert.Equal(float64(2), testutil.ToFloat64(metrics.NSXAuthSessionTotal.WithLabelValues(ep.Host(), "failure")))
+}
+
+func TestEndpoint_setStatusMetrics(t *testing.T) {
+	ep, err := NewEndpoint("10.0.0.2", nil, nil, ratelimiter.NewFixRateLimiter(10), nil)
+	assert.NoError(t, err)
 
+	ep.setStatus(UP)
+	ep.setStatus(UP)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.NSXEndpointUp.WithLabelValues("10.0.0.2")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.NSXEndpointTransitionsTotal.WithLabelValues("10.0.0.2", string(UP))))
+	ep.setStatus(DOWN)
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.NSXEndpointUp.WithLabelValues("10.0.0.2")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.NSXEndpointTransitionsTotal.WithLabelValues("10.0.0.2", string(DOWN))))
+
+	ep.increaseConnNumber()
+	ep.increaseConnNumber()
+	ep.decreaseConnNumber()
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.NSXEndpointConnections.WithLabelValues("10.0.0.2")))
 }
 
 func TestKeepAlive(t *testing.T) {
@@ -218,3 +238,28 @@ func TestKeepAlive(t *testing.T) {
 	ep.KeepAlive()
 	assert.Equal(ep.Status(), DOWN)
 }
+
+type priorityRateLimiter struct {
+	ratelimiter.RateLimiter
+	priorities []ratelimiter.Priority
+}
+
+func (l *priorityRateLimiter) WaitWithPriority(priority ratelimiter.Priority) {
+	l.priorities = append(l.priorities, priority)
+}
+
+func TestEndpoint_wait(t *testing.T) {
+	rl := &priorityRateLimiter{RateLimiter: ratelimiter.NewFixRateLimiter(0)}
+	ep, err := NewEndpoint("10.0.0.1", nil, nil, rl, nil)
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodPatch, "https://10.0.0.1/policy/api/v1/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/p1", nil)
+	assert.NoError(t, SetInteractivePriorityHeader(req))
+	ep.wait(ratelimiter.PriorityFromRequest(req))
+	ep.wait(ratelimiter.PriorityBulk)
+	assert.Equal(t, []ratelimiter.Priority{ratelimiter.PriorityInteractive, ratelimiter.PriorityBulk}, rl.priorities)
+
+	// the rate limiter without priority lanes
+	ep, _ = NewEndpoint("10.0.0.1", nil, nil, ratelimiter.NewFixRateLimiter(0), nil)
+	ep.wait(ratelimiter.PriorityInteractive)
+}
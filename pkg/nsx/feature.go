@@ -30,3 +30,9 @@ func RestoreVifFeatureEnabled(client *Client, operatorConfig *config.NSXOperator
 	}
 	return operatorConfig.NsxConfig.RestoreVifEnabled()
 }
+
+// FQDNFilterFeatureEnabled is true when NSX supports the context profiles with DOMAIN_NAME attributes
+// used by the FQDN peers of SecurityPolicy rules.
+func FQDNFilterFeatureEnabled(client *Client) bool {
+	return client != nil && client.NSXCheckVersion(FQDNFilter)
+}
@@ -72,3 +72,20 @@ func TestRestoreVifFeatureEnabled(t *testing.T) {
 		assert.False(t, RestoreVifFeatureEnabled(nsxClient, &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}))
 	})
 }
+
+func TestFQDNFilterFeatureEnabled(t *testing.T) {
+	nsxClient := &Client{}
+	assert.False(t, FQDNFilterFeatureEnabled(nil))
+
+	p := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *Client, feature int) bool {
+		return feature == FQDNFilter
+	})
+	assert.True(t, FQDNFilterFeatureEnabled(nsxClient))
+	p.Reset()
+
+	p = gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *Client, feature int) bool {
+		return false
+	})
+	defer p.Reset()
+	assert.False(t, FQDNFilterFeatureEnabled(nsxClient))
+}
@@ -5,6 +5,8 @@ package ratelimiter
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +36,15 @@ const (
 
 	// MAXRATELIMIT means max rate for rate limiter.
 	MAXRATELIMIT = 100
+
+	// DEFAULTINCREASESTEP is the default rate added by AIMD rate limiter when increasing rate.
+	DEFAULTINCREASESTEP = 1
+
+	// DEFAULTDECREASEFACTOR is the default factor multiplied by AIMD rate limiter when decreasing rate.
+	DEFAULTDECREASEFACTOR = 0.5
+
+	// DEFAULTBURST is the default burst of token bucket rate limiter.
+	DEFAULTBURST = 10
 )
 
 // Type is rate limiter type.
@@ -44,13 +55,44 @@ const (
 	FIXRATE Type = iota
 	// AIMD is a limiter which rate will adjuct depending on wait time and http status code.
 	AIMD Type = 1
+	// TOKENBUCKET is a limiter which has fix rate and burst, and serves interactive requests before bulk ones.
+	TOKENBUCKET Type = 2
 )
 
+// ParseType parses the rate limiter type from config, "AIMD", "FIXRATE" or "TOKENBUCKET" case-insensitively. Empty string means AIMD.
+func ParseType(s string) (Type, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "", "AIMD":
+		return AIMD, nil
+	case "FIXRATE", "FIX":
+		return FIXRATE, nil
+	case "TOKENBUCKET":
+		return TOKENBUCKET, nil
+	}
+	return AIMD, fmt.Errorf("invalid rate limiter type %q, it should be AIMD, FIXRATE or TOKENBUCKET", s)
+}
+
+// Config tunes a rate limiter, zero values of Period, IncreaseStep and DecreaseFactor mean the default ones.
+type Config struct {
+	Type Type
+	// MaxRate is the max rate per second, it is capped by MAXRATELIMIT, 0 disables rate limiter.
+	MaxRate int
+	// Period is the period(seconds) to adjust rate for AIMD rate limiter.
+	Period float64
+	// IncreaseStep is added to the rate if the calls were blocked in a period for AIMD rate limiter.
+	IncreaseStep int
+	// DecreaseFactor is multiplied by the rate if 429/503 is returned in a period for AIMD rate limiter.
+	DecreaseFactor float64
+	// Burst is the bucket size of token bucket rate limiter.
+	Burst int
+}
+
 // RateLimiter limits the REST API speed.
 type RateLimiter interface {
 	Wait()
 	AdjustRate(time.Duration, int)
-	rate() int
+	// Rate returns the current rate, 0 means rate limiter is disabled.
+	Rate() int
 }
 
 // FixRateLimiter is rate limiter which has fix rate.
@@ -67,6 +109,8 @@ type AIMDRateLimter struct {
 	max            int
 	period         float64
 	lastAdjuctRate time.Time
+	increaseStep   int
+	decreaseFactor float64
 	pos            int
 	neg            int
 	sync.Mutex
@@ -77,9 +121,38 @@ func NewRateLimiter(rateLimiterType Type) RateLimiter {
 	if rateLimiterType == FIXRATE {
 		return NewFixRateLimiter(MAXRATELIMIT)
 	}
+	if rateLimiterType == TOKENBUCKET {
+		return NewTokenBucketRateLimiter(MAXRATELIMIT, DEFAULTBURST)
+	}
 	return NewAIMDRateLimiter(MAXRATELIMIT, DEFAULTUPDATEPERIOD)
 }
 
+// NewRateLimiterWithConfig creates rate limiter based on Config.
+func NewRateLimiterWithConfig(c Config) RateLimiter {
+	if c.Type == FIXRATE {
+		return NewFixRateLimiter(c.MaxRate)
+	}
+	if c.Type == TOKENBUCKET {
+		burst := c.Burst
+		if burst <= 0 {
+			burst = DEFAULTBURST
+		}
+		return NewTokenBucketRateLimiter(c.MaxRate, burst)
+	}
+	period := c.Period
+	if period <= 0 {
+		period = DEFAULTUPDATEPERIOD
+	}
+	limiter := NewAIMDRateLimiter(c.MaxRate, period).(*AIMDRateLimter)
+	if c.IncreaseStep > 0 {
+		limiter.increaseStep = c.IncreaseStep
+	}
+	if c.DecreaseFactor > 0 && c.DecreaseFactor < 1 {
+		limiter.decreaseFactor = c.DecreaseFactor
+	}
+	return limiter
+}
+
 // NewFixRateLimiter creates AIMD rate limiter.
 // max ==0 disables rate limiter.
 func NewFixRateLimiter(max int) RateLimiter {
@@ -106,10 +179,8 @@ func NewAIMDRateLimiter(max int, period float64) RateLimiter {
 		m = max
 	}
 	limiter := rate.NewLimiter(1, 1)
-	if max == 0 {
-		return &AIMDRateLimter{l: limiter, max: m, disable: true, period: period, lastAdjuctRate: time.Now()}
-	}
-	return &AIMDRateLimter{l: limiter, max: m, disable: false, period: period, lastAdjuctRate: time.Now()}
+	return &AIMDRateLimter{l: limiter, max: m, disable: max == 0, period: period, lastAdjuctRate: time.Now(),
+		increaseStep: DEFAULTINCREASESTEP, decreaseFactor: DEFAULTDECREASEFACTOR}
 }
 
 // Wait blocks the caller until a token is gained.
@@ -126,7 +197,8 @@ func (limiter *FixRateLimiter) Wait() {
 	}
 }
 
-func (limiter *FixRateLimiter) rate() int {
+// Rate returns the fix rate.
+func (limiter *FixRateLimiter) Rate() int {
 	if limiter.disable {
 		return 0
 	}
@@ -172,13 +244,13 @@ func (limiter *AIMDRateLimter) AdjustRate(waitTime time.Duration, statusCode int
 		r := int(limiter.l.Limit())
 		if limiter.pos > 0 {
 			if r < limiter.max {
-				r++
+				r = min(r+limiter.increaseStep, limiter.max)
 				limiter.l.SetLimit(rate.Limit(r))
 				log.Debug("Increasing API rate limit", "rateLimit", r, "statusCode", statusCode)
 			}
 		} else if limiter.neg > 0 {
 			if r > 1 {
-				r = r / 2
+				r = max(int(float64(r)*limiter.decreaseFactor), 1)
 				limiter.l.SetLimit(rate.Limit(r))
 				log.Debug("Decreasing API rate limit", "rateLimit", r, "statusCode", statusCode)
 			}
@@ -189,7 +261,8 @@ func (limiter *AIMDRateLimter) AdjustRate(waitTime time.Duration, statusCode int
 	}
 }
 
-func (limiter *AIMDRateLimter) rate() int {
+// Rate returns the current adjusted rate.
+func (limiter *AIMDRateLimter) Rate() int {
 	if limiter.disable {
 		return 0
 	}
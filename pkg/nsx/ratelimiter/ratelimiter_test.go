@@ -20,12 +20,12 @@ func TestAdjustRate(t *testing.T) {
 	// normal adjust case
 	time.Sleep(100 * time.Millisecond)
 	limiter.AdjustRate(waitTime, 200)
-	re := limiter.rate()
+	re := limiter.Rate()
 	assert.Equal(re, 2, "Set rate error.")
 
 	// the interval less than period, should not adjust
 	limiter.AdjustRate(time.Millisecond, 200)
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, 2, "Set rate error.")
 
 	// the upper rate should be equal to max
@@ -33,13 +33,13 @@ func TestAdjustRate(t *testing.T) {
 		time.Sleep(100 * time.Millisecond)
 		limiter.AdjustRate(waitTime, 201)
 	}
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, max, fmt.Sprintf("Rate should not be %d.\n", re))
 
 	// decrease the rate
 	time.Sleep(100 * time.Millisecond)
 	limiter.AdjustRate(0, 429)
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, max/2, "Set rate error.")
 }
 
@@ -78,29 +78,29 @@ func TestWait(t *testing.T) {
 
 func TestRateLimiter_NewFixRateLimiter(t *testing.T) {
 	limiter := NewFixRateLimiter(120)
-	assert.Equal(t, limiter.rate(), MAXRATELIMIT)
+	assert.Equal(t, limiter.Rate(), MAXRATELIMIT)
 
 	limiter = NewFixRateLimiter(80)
-	assert.Equal(t, limiter.rate(), 80)
+	assert.Equal(t, limiter.Rate(), 80)
 
 	limiter = NewFixRateLimiter(0)
 	l, ok := limiter.(*FixRateLimiter)
 	assert.Equal(t, ok, true)
-	assert.Equal(t, limiter.rate(), 0)
+	assert.Equal(t, limiter.Rate(), 0)
 	assert.Equal(t, l.disable, true)
 }
 
 func TestRateLimiter_NewAIMDRateLimiter(t *testing.T) {
 	limiter := NewAIMDRateLimiter(120, 1.0)
-	assert.Equal(t, limiter.rate(), 1)
+	assert.Equal(t, limiter.Rate(), 1)
 
 	limiter = NewAIMDRateLimiter(80, 1.0)
-	assert.Equal(t, limiter.rate(), 1)
+	assert.Equal(t, limiter.Rate(), 1)
 
 	limiter = NewAIMDRateLimiter(0, 1.0)
 	l, ok := limiter.(*AIMDRateLimter)
 	assert.Equal(t, ok, true)
-	assert.Equal(t, limiter.rate(), 0)
+	assert.Equal(t, limiter.Rate(), 0)
 	assert.Equal(t, l.disable, true)
 }
 
@@ -128,3 +128,55 @@ func TestRateLimiter_FixRateLimiterWait(t *testing.T) {
 	d = after.Sub(before)
 	assert.True(t, d > time.Millisecond*90)
 }
+
+func TestParseType(t *testing.T) {
+	for _, s := range []string{"", "AIMD", "aimd"} {
+		rt, err := ParseType(s)
+		assert.NoError(t, err)
+		assert.Equal(t, AIMD, rt)
+	}
+	for _, s := range []string{"FIXRATE", "fix"} {
+		rt, err := ParseType(s)
+		assert.NoError(t, err)
+		assert.Equal(t, FIXRATE, rt)
+	}
+	_, err := ParseType("token")
+	assert.ErrorContains(t, err, "invalid rate limiter type")
+}
+
+func TestRateLimiter_NewRateLimiterWithConfig(t *testing.T) {
+	limiter := NewRateLimiterWithConfig(Config{Type: FIXRATE, MaxRate: 20})
+	assert.Equal(t, 20, limiter.Rate())
+
+	limiter = NewRateLimiterWithConfig(Config{Type: AIMD, MaxRate: 20})
+	l := limiter.(*AIMDRateLimter)
+	assert.Equal(t, DEFAULTUPDATEPERIOD, l.period)
+	assert.Equal(t, DEFAULTINCREASESTEP, l.increaseStep)
+	assert.Equal(t, DEFAULTDECREASEFACTOR, l.decreaseFactor)
+
+	limiter = NewRateLimiterWithConfig(Config{Type: AIMD, MaxRate: 20, Period: 0.1, IncreaseStep: 8, DecreaseFactor: 0.25})
+	// increase by the step, and capped by the max rate
+	for i := 0; i < 3; i++ {
+		time.Sleep(100 * time.Millisecond)
+		limiter.AdjustRate(20*time.Millisecond, 200)
+	}
+	assert.Equal(t, 20, limiter.Rate())
+	// decrease by the factor
+	time.Sleep(100 * time.Millisecond)
+	limiter.AdjustRate(0, 503)
+	assert.Equal(t, 5, limiter.Rate())
+}
+
+func TestRateLimiter_NewTokenBucketRateLimiter(t *testing.T) {
+	rt, err := ParseType("TokenBucket")
+	assert.NoError(t, err)
+	limiter := NewRateLimiterWithConfig(Config{Type: rt, MaxRate: 200})
+	l, ok := limiter.(*TokenBucketRateLimiter)
+	assert.True(t, ok)
+	assert.Equal(t, MAXRATELIMIT, limiter.Rate())
+	assert.Equal(t, DEFAULTBURST, l.l.Burst())
+
+	limiter = NewRateLimiter(TOKENBUCKET)
+	_, ok = limiter.(PriorityRateLimiter)
+	assert.True(t, ok)
+}
@@ -0,0 +1,119 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ratelimiter
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+)
+
+// Priority is the lane of a request in the rate limiter.
+type Priority int32
+
+const (
+	// PriorityBulk is the default priority, e.g. for resync and batch requests. It yields to PriorityInteractive.
+	PriorityBulk Priority = iota
+	// PriorityInteractive is for latency-sensitive requests, e.g. creating SubnetPort for Pod.
+	PriorityInteractive
+)
+
+const (
+	// PriorityHeader tags the priority of the request, it is removed from the copy of the request sent to NSX.
+	PriorityHeader = "X-NSX-Operator-Priority"
+	// PriorityInteractiveValue is the PriorityHeader value of PriorityInteractive.
+	PriorityInteractiveValue = "interactive"
+)
+
+// PriorityFromRequest returns the priority tagged by PriorityHeader, the request is not modified.
+func PriorityFromRequest(r *http.Request) Priority {
+	if strings.EqualFold(r.Header.Get(PriorityHeader), PriorityInteractiveValue) {
+		return PriorityInteractive
+	}
+	return PriorityBulk
+}
+
+// PriorityRateLimiter is rate limiter which serves the requests by priority.
+type PriorityRateLimiter interface {
+	RateLimiter
+	// WaitWithPriority blocks the caller until a token is gained in the lane of the priority.
+	WaitWithPriority(Priority)
+}
+
+// TokenBucketRateLimiter is rate limiter which has fix rate and burst. The interactive requests wait for the tokens in
+// order, while the bulk requests only take the token available now when no interactive request is waiting, so they
+// never hold the future tokens and delay the interactive ones.
+type TokenBucketRateLimiter struct {
+	l       *rate.Limiter
+	disable bool
+	clock   clock.Clock
+	// interactiveWaiting is the number of interactive requests waiting for a token.
+	interactiveWaiting atomic.Int32
+}
+
+// NewTokenBucketRateLimiter creates token bucket rate limiter.
+// maxRate ==0 disables rate limiter.
+func NewTokenBucketRateLimiter(maxRate int, burst int) RateLimiter {
+	m := min(maxRate, MAXRATELIMIT)
+	return &TokenBucketRateLimiter{l: rate.NewLimiter(rate.Limit(m), max(burst, 1)), disable: maxRate == 0, clock: clock.RealClock{}}
+}
+
+// Wait blocks the caller until a token is gained in the bulk lane.
+func (limiter *TokenBucketRateLimiter) Wait() {
+	limiter.WaitWithPriority(PriorityBulk)
+}
+
+// WaitWithPriority blocks the caller until a token is gained in the lane of the priority.
+func (limiter *TokenBucketRateLimiter) WaitWithPriority(priority Priority) {
+	if limiter.disable {
+		return
+	}
+	if priority == PriorityInteractive {
+		limiter.interactiveWaiting.Add(1)
+		defer limiter.interactiveWaiting.Add(-1)
+		now := limiter.clock.Now()
+		reservation := limiter.l.ReserveN(now, 1)
+		delay := reservation.DelayFrom(now)
+		if !reservation.OK() || delay > time.Second*RateLimiterTimeout {
+			reservation.CancelAt(now)
+			log.Debug("Wait for token timeout", "priority", priority, "delay", delay)
+			return
+		}
+		if delay > 0 {
+			<-limiter.clock.After(delay)
+		}
+		return
+	}
+
+	timeout := limiter.clock.NewTimer(time.Second * RateLimiterTimeout)
+	defer timeout.Stop()
+	interval := time.Duration(float64(time.Second) / float64(limiter.l.Limit()))
+	for {
+		if limiter.interactiveWaiting.Load() == 0 && limiter.l.AllowN(limiter.clock.Now(), 1) {
+			return
+		}
+		select {
+		case <-timeout.C():
+			log.Debug("Wait for token timeout", "priority", priority)
+			return
+		case <-limiter.clock.After(interval):
+		}
+	}
+}
+
+// AdjustRate adjust upper limit for rate limiter, it's empty for TokenBucketRateLimiter.
+func (limiter *TokenBucketRateLimiter) AdjustRate(waitTime time.Duration, statusCode int) {
+}
+
+// Rate returns the fix rate.
+func (limiter *TokenBucketRateLimiter) Rate() int {
+	if limiter.disable {
+		return 0
+	}
+	return int(limiter.l.Limit())
+}
@@ -0,0 +1,105 @@
+package ratelimiter
+
+import (
+	"net/http"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestPriorityFromRequest(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://nsx/policy/api/v1/infra", nil)
+	assert.Equal(t, PriorityBulk, PriorityFromRequest(req))
+
+	req.Header.Set(PriorityHeader, PriorityInteractiveValue)
+	assert.Equal(t, PriorityInteractive, PriorityFromRequest(req))
+	assert.Equal(t, PriorityInteractiveValue, req.Header.Get(PriorityHeader))
+}
+
+func TestTokenBucketRateLimiter_Burst(t *testing.T) {
+	limiter := NewTokenBucketRateLimiter(10, 5)
+	assert.Equal(t, 10, limiter.Rate())
+	before := time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.Wait()
+	}
+	assert.True(t, time.Since(before) < 50*time.Millisecond)
+
+	before = time.Now()
+	limiter.Wait()
+	assert.True(t, time.Since(before) > 50*time.Millisecond)
+
+	limiter = NewTokenBucketRateLimiter(0, 5)
+	assert.Equal(t, 0, limiter.Rate())
+	before = time.Now()
+	limiter.Wait()
+	assert.True(t, time.Since(before) < time.Millisecond)
+}
+
+func TestTokenBucketRateLimiter_Priority(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	limiter := NewTokenBucketRateLimiter(20, 1).(*TokenBucketRateLimiter)
+	limiter.clock = fakeClock
+	interval := 50 * time.Millisecond
+	// drain the bucket
+	limiter.Wait()
+
+	var mu sync.Mutex
+	var order []Priority
+	wait := func(wg *sync.WaitGroup, priority Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter.WaitWithPriority(priority)
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+		}()
+	}
+	waitDone := func(wg *sync.WaitGroup) <-chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		return done
+	}
+
+	// The bulk requests wait first, each of them waits for the timeout and the next poll.
+	bulkWG := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wait(&bulkWG, PriorityBulk)
+	}
+	require.Eventually(t, func() bool { return fakeClock.Waiters() == 6 }, time.Second, time.Millisecond)
+	// The interactive requests reserve the next tokens in order.
+	interactiveWG := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		wait(&interactiveWG, PriorityInteractive)
+	}
+	require.Eventually(t, func() bool { return fakeClock.Waiters() == 9 }, time.Second, time.Millisecond)
+
+	// The tokens of the three intervals are all taken by the interactive requests.
+	fakeClock.Step(3 * interval)
+	<-waitDone(&interactiveWG)
+	mu.Lock()
+	assert.Equal(t, []Priority{PriorityInteractive, PriorityInteractive, PriorityInteractive}, order)
+	mu.Unlock()
+
+	// The bulk requests take the tokens of the next intervals.
+	bulkDone := waitDone(&bulkWG)
+	for done := false; !done; {
+		select {
+		case <-bulkDone:
+			done = true
+		default:
+			fakeClock.Step(interval)
+			runtime.Gosched()
+		}
+	}
+	assert.Equal(t, []Priority{PriorityInteractive, PriorityInteractive, PriorityInteractive, PriorityBulk, PriorityBulk, PriorityBulk}, order)
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CleanupFilter selects a subset of the NSX resources to clean up, by the VPCs the resources belong to or by the
+// namespaces the resources are created for. A nil CleanupFilter selects all the resources.
+type CleanupFilter struct {
+	VPCPaths   sets.Set[string]
+	Namespaces sets.Set[string]
+}
+
+// NewCleanupFilter returns a CleanupFilter with the given VPC paths and namespaces.
+func NewCleanupFilter(vpcPaths []string, namespaces []string) *CleanupFilter {
+	return &CleanupFilter{
+		VPCPaths:   sets.New[string](vpcPaths...),
+		Namespaces: sets.New[string](namespaces...),
+	}
+}
+
+// Match returns true if the NSX resource is under one of the selected VPCs, or it is tagged with one of the selected
+// namespaces.
+func (f *CleanupFilter) Match(obj interface{}) bool {
+	if f == nil {
+		return true
+	}
+	if path := getNSXResourcePath(obj); path != nil && f.matchVPCPath(*path) {
+		return true
+	}
+	for _, tag := range getNSXResourceTags(obj) {
+		if tag.Scope == nil || tag.Tag == nil {
+			continue
+		}
+		if (*tag.Scope == TagScopeNamespace || *tag.Scope == TagScopeVMNamespace) && f.Namespaces.Has(*tag.Tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *CleanupFilter) matchVPCPath(path string) bool {
+	for vpcPath := range f.VPCPaths {
+		if path == vpcPath || strings.HasPrefix(path, strings.TrimSuffix(vpcPath, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the objects selected by the filter.
+func (f *CleanupFilter) Filter(objs []interface{}) []interface{} {
+	if f == nil {
+		return objs
+	}
+	selected := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		if f.Match(obj) {
+			selected = append(selected, obj)
+		}
+	}
+	return selected
+}
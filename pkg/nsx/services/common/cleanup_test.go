@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestCleanupFilter(t *testing.T) {
+	var nilFilter *CleanupFilter
+	port := &model.VpcSubnetPort{Path: String("/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1")}
+	assert.True(t, nilFilter.Match(port))
+
+	rule := &model.Rule{
+		Path: String("/orgs/default/projects/p1/vpcs/vpc-2/security-policies/sp-1/rules/rule-1"),
+		Tags: []model.Tag{{Scope: String(TagScopeNamespace), Tag: String("ns-1")}},
+	}
+	otherVPCPort := &model.VpcSubnetPort{Path: String("/orgs/default/projects/p1/vpcs/vpc-10/subnets/s1/ports/port-1")}
+	vmPort := &model.VpcSubnetPort{
+		Path: String("/orgs/default/projects/p1/vpcs/shared/subnets/s1/ports/port-2"),
+		Tags: []model.Tag{{Scope: String(TagScopeVMNamespace), Tag: String("ns-1")}},
+	}
+
+	filter := NewCleanupFilter([]string{"/orgs/default/projects/p1/vpcs/vpc-1"}, []string{"ns-1"})
+	assert.True(t, filter.Match(port))
+	assert.True(t, filter.Match(rule))
+	assert.True(t, filter.Match(vmPort))
+	assert.False(t, filter.Match(otherVPCPort))
+	assert.Equal(t, []interface{}{port, rule, vmPort}, filter.Filter([]interface{}{port, otherVPCPort, rule, vmPort}))
+}
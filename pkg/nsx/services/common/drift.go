@@ -0,0 +1,116 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DriftType is the type of the difference between a resource realized on NSX and the resource in the store.
+type DriftType string
+
+const (
+	// DriftTypeMissing means the resource is in the store but it has been deleted on NSX.
+	DriftTypeMissing DriftType = "Missing"
+	// DriftTypeModified means the resource on NSX has been changed and is different from the store.
+	DriftTypeModified DriftType = "Modified"
+	// DriftTypeUnexpected means the resource is tagged for the cluster on NSX but it is not in the store.
+	DriftTypeUnexpected DriftType = "Unexpected"
+)
+
+// Drift is a resource which is different on NSX from the store.
+type Drift struct {
+	Type         DriftType
+	ResourceType string
+	Path         string
+	// Tags are the tags of the resource in the store, or on NSX if the resource is unexpected. They are used to find
+	// the CR owning the resource.
+	Tags []model.Tag
+	// Cached is the resource in the store, nil if the resource is unexpected. Realized is the resource on NSX, nil if
+	// the resource is missing.
+	Cached   interface{}
+	Realized interface{}
+}
+
+// DriftTarget describes how to audit the resources of a store.
+type DriftTarget struct {
+	// ResourceType is the NSX resource type of the store.
+	ResourceType string
+	Store        *ResourceStore
+	// QueryTags are the additional tags to query the resources from NSX, they should be the same as the tags used to
+	// initialize the store.
+	QueryTags []model.Tag
+	// Inspect returns the path, the tags and the Comparable of an object in the store. The objects are matched by the
+	// path, and are compared by Comparable.Value().
+	Inspect func(obj interface{}) (path string, tags []model.Tag, comparable Comparable)
+}
+
+// driftStore holds the resources queried from NSX, it is keyed by the path of the resource.
+type driftStore struct {
+	ResourceStore
+}
+
+func (s *driftStore) Apply(obj interface{}) error {
+	return s.Add(obj)
+}
+
+// DetectDrift queries the resources from NSX with the same query used to initialize the store, and compares them
+// with the resources in the store.
+func (service *Service) DetectDrift(target DriftTarget) ([]Drift, error) {
+	realized := &driftStore{ResourceStore: ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			path, _, _ := target.Inspect(obj)
+			if path == "" {
+				return "", fmt.Errorf("%s without path", target.ResourceType)
+			}
+			return path, nil
+		}, cache.Indexers{}),
+		BindingType: target.Store.BindingType,
+	}}
+	query := service.buildStoreQuery("", "", target.ResourceType, target.QueryTags, realized)
+	if _, err := service.SearchResource(target.ResourceType, query, realized, nil); err != nil {
+		return nil, err
+	}
+
+	var drifts []Drift
+	existing := map[string]struct{}{}
+	for _, obj := range target.Store.List() {
+		path, tags, cached := target.Inspect(obj)
+		if path == "" {
+			continue
+		}
+		existing[path] = struct{}{}
+		realizedObj, found, _ := realized.Indexer.GetByKey(path)
+		if !found {
+			drifts = append(drifts, Drift{Type: DriftTypeMissing, ResourceType: target.ResourceType, Path: path, Tags: tags, Cached: obj})
+			continue
+		}
+		if _, _, actual := target.Inspect(realizedObj); CompareResource(cached, actual) {
+			drifts = append(drifts, Drift{Type: DriftTypeModified, ResourceType: target.ResourceType, Path: path, Tags: tags, Cached: obj, Realized: realizedObj})
+		}
+	}
+	for _, obj := range realized.List() {
+		path, tags, _ := target.Inspect(obj)
+		if _, ok := existing[path]; !ok {
+			drifts = append(drifts, Drift{Type: DriftTypeUnexpected, ResourceType: target.ResourceType, Path: path, Tags: tags, Realized: obj})
+		}
+	}
+	return drifts, nil
+}
+
+// Refresh updates the store with the drifted resource on NSX, so that the owner reconciler compares the CR with NSX
+// instead of the stale resource in the store. The missing resource is removed from the store to be recreated, and the
+// modified resource is replaced with the one on NSX to be reverted. The unexpected resource is not added to the store.
+func (target DriftTarget) Refresh(drift Drift) error {
+	switch drift.Type {
+	case DriftTypeMissing:
+		return target.Store.Delete(drift.Cached)
+	case DriftTypeModified:
+		return target.Store.Update(drift.Realized)
+	}
+	return nil
+}
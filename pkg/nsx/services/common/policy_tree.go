@@ -62,6 +62,8 @@ func getNSXResourcePath[T any](obj T) *string {
 		return v.Path
 	case *model.StaticIpAddressReservation:
 		return v.Path
+	case *model.PolicyContextProfile:
+		return v.Path
 	default:
 		log.Error(nil, "Get NSX resource path", "unknown NSX resource type", v)
 		return nil
@@ -160,6 +162,54 @@ func getNSXResourceName[T any](obj T) *string {
 	}
 }
 
+func getNSXResourceTags[T any](obj T) []model.Tag {
+	switch v := any(obj).(type) {
+	case *model.ProjectDnsRecord:
+		return v.Tags
+	case *model.VpcIpAddressAllocation:
+		return v.Tags
+	case *model.VpcSubnet:
+		return v.Tags
+	case *model.VpcSubnetPort:
+		return v.Tags
+	case *model.SubnetConnectionBindingMap:
+		return v.Tags
+	case *model.Vpc:
+		return v.Tags
+	case *model.StaticRoutes:
+		return v.Tags
+	case *model.SecurityPolicy:
+		return v.Tags
+	case *model.Group:
+		return v.Tags
+	case *model.Rule:
+		return v.Tags
+	case *model.Share:
+		return v.Tags
+	case *model.LBService:
+		return v.Tags
+	case *model.LBVirtualServer:
+		return v.Tags
+	case *model.LBPool:
+		return v.Tags
+	case *model.TlsCertificate:
+		return v.Tags
+	case *model.SharedResource:
+		return v.Tags
+	case *model.Domain:
+		return v.Tags
+	case *model.DynamicIpAddressReservation:
+		return v.Tags
+	case *model.StaticIpAddressReservation:
+		return v.Tags
+	case *model.PolicyContextProfile:
+		return v.Tags
+	default:
+		log.Error(nil, "Get NSX resource tags", "unknown NSX resource type", v)
+		return nil
+	}
+}
+
 func leafWrapper[T any](obj T) (*data.StructValue, error) {
 	switch v := any(obj).(type) {
 	case *model.ProjectDnsRecord:
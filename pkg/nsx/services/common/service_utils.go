@@ -6,6 +6,8 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 func (service *Service) GetNamespaceUID(ns string) (nsUid types.UID) {
@@ -29,3 +31,56 @@ func GetNamespaceUIDFromTag(tags []model.Tag) string {
 	}
 	return ""
 }
+
+// ListNSXResourcePaths returns the NSX paths of the given cached objects, the objects without a path are ignored.
+func ListNSXResourcePaths(objs []interface{}) []string {
+	paths := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		if path := getNSXResourcePath(obj); path != nil {
+			paths = append(paths, *path)
+		}
+	}
+	return paths
+}
+
+// GetSubnetIPv4PoolUsage returns the number of the allocated IPv4 addresses and the size of the IPv4 pool of the NSX
+// Subnet with the parsed path subnetInfo. The usage is read from the DHCP server stats for a DHCPServer Subnet, and from the static IP pool for a
+// DHCP deactivated Subnet, the caller checks if the static IP allocation is enabled. The size is 0 for other Subnets.
+func (service *Service) GetSubnetIPv4PoolUsage(subnetInfo VPCResourceInfo, nsxSubnet *model.VpcSubnet) (int, int, error) {
+	dhcpMode := model.SubnetDhcpConfig_MODE_DEACTIVATED
+	if nsxSubnet.SubnetDhcpConfig != nil && nsxSubnet.SubnetDhcpConfig.Mode != nil {
+		dhcpMode = *nsxSubnet.SubnetDhcpConfig.Mode
+	}
+	var used, total int
+	switch dhcpMode {
+	case model.SubnetDhcpConfig_MODE_SERVER:
+		stats, err := service.NSXClient.DhcpServerConfigStatsClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, nil, nil, nil, nil, nil, nil, nil)
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			log.Error(err, "Failed to get Subnet dhcp-server-config stats", "Subnet", *nsxSubnet.Path)
+			return 0, 0, err
+		}
+		for _, poolStats := range stats.IpPoolStats {
+			if poolStats.AllocatedNumber != nil {
+				used += int(*poolStats.AllocatedNumber)
+			}
+			if poolStats.PoolSize != nil {
+				total += int(*poolStats.PoolSize)
+			}
+		}
+	case model.SubnetDhcpConfig_MODE_DEACTIVATED:
+		staticIPPool, err := service.NSXClient.IPPoolClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, "static-ipv4-default")
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			log.Error(err, "Failed to get Subnet static IP Pool static-ipv4-default", "Subnet", *nsxSubnet.Path)
+			return 0, 0, err
+		}
+		if staticIPPool.PoolUsage != nil && staticIPPool.PoolUsage.RequestedIpAllocations != nil {
+			used = int(*staticIPPool.PoolUsage.RequestedIpAllocations)
+		}
+		if staticIPPool.PoolUsage != nil && staticIPPool.PoolUsage.TotalIps != nil {
+			total = int(*staticIPPool.PoolUsage.TotalIps)
+		}
+	}
+	return used, total, nil
+}
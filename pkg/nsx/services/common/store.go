@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
@@ -62,6 +63,12 @@ func (resourceStore *ResourceStore) TransResourceToStore(entity *data.StructValu
 	return nil
 }
 
+// RegisterStoreMetrics reports the number of the resources in the store per namespace and per VPC if the store is
+// indexed by them.
+func RegisterStoreMetrics(resourceType string, store *ResourceStore) {
+	metrics.ManagedResources.AddStore(resourceType, store.Indexer, []string{TagScopeNamespace, TagScopeVMNamespace}, IndexByVPCPathFuncKey)
+}
+
 func DecrementPageSize(pageSize *int64) {
 	*pageSize -= 100
 	if int(*pageSize) <= 0 {
@@ -192,6 +199,13 @@ func (service *Service) PopulateResourcetoStore(wg *sync.WaitGroup, fatalErrors
 
 // InitializeCommonStore is the common method used by InitializeResourceStore and InitializeVPCResourceStore
 func (service *Service) InitializeCommonStore(wg *sync.WaitGroup, fatalErrors chan error, org string, project string, resourceTypeValue string, tags []model.Tag, store Store) {
+	queryParam := service.buildStoreQuery(org, project, resourceTypeValue, tags, store)
+	service.PopulateResourcetoStore(wg, fatalErrors, resourceTypeValue, queryParam, store, nil)
+}
+
+// buildStoreQuery builds the search query of the resources created by the cluster, the resources are filtered by
+// the tags, and by the project if org or project is set.
+func (service *Service) buildStoreQuery(org string, project string, resourceTypeValue string, tags []model.Tag, store Store) string {
 	var tagParams []string
 	// Check for specific tag scopes
 	if !containsTagScope(tags, TagScopeCluster, TagScopeNCPCluster) {
@@ -224,7 +238,7 @@ func (service *Service) InitializeCommonStore(wg *sync.WaitGroup, fatalErrors ch
 	if store.IsPolicyAPI() {
 		queryParam += " AND marked_for_delete:false"
 	}
-	service.PopulateResourcetoStore(wg, fatalErrors, resourceTypeValue, queryParam, store, nil)
+	return queryParam
 }
 
 // Helper function to check if any tag has the specified scopes
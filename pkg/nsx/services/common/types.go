@@ -15,100 +15,129 @@ import (
 )
 
 const (
-	HashLength                         int    = 8
-	UUIDHashLength                     int    = 5
-	MaxTagsCount                       int    = 26
-	MaxTagScopeLength                  int    = 128
-	MaxTagValueLength                  int    = 256
-	MaxIdLength                        int    = 255
-	MaxNameLength                      int    = 255
-	MaxSubnetNameLength                int    = 80
-	VPCLbResourcePathMinSegments       int    = 8
-	PriorityNetworkPolicyAllowRule     int    = 2010
-	PriorityNetworkPolicyIsolationRule int    = 2090
-	TagScopeNCPCluster                 string = "ncp/cluster"
-	TagScopeNCPProjectUID              string = "ncp/project_uid"
-	TagScopeNCPCreateFor               string = "ncp/created_for"
-	TagScopeNCPVIFProjectUID           string = "ncp/vif_project_uid"
-	TagScopeNCPPod                     string = "ncp/pod"
-	TagScopeNCPVNETInterface           string = "ncp/vnet_interface"
-	TagScopeCreatedFor                 string = "nsx-op/created_for"
-	TagScopeVersion                    string = "nsx-op/version"
-	TagScopeCluster                    string = "nsx-op/cluster"
-	TagScopeNamespace                  string = "nsx-op/namespace"
-	TagScopeNamespaceUID               string = "nsx-op/namespace_uid"
-	TagScopeSecurityPolicyCRName       string = "nsx-op/security_policy_cr_name"
-	TagScopeSecurityPolicyCRUID        string = "nsx-op/security_policy_cr_uid"
-	TagScopeSecurityPolicyName         string = "nsx-op/security_policy_name"
-	TagScopeSecurityPolicyUID          string = "nsx-op/security_policy_uid"
-	TagScopeNetworkPolicyName          string = "nsx-op/network_policy_name"
-	TagScopeNetworkPolicyUID           string = "nsx-op/network_policy_uid"
-	TagScopeStaticRouteCRName          string = "nsx-op/static_route_name"
-	TagScopeStaticRouteCRUID           string = "nsx-op/static_route_uid"
-	TagScopeRuleID                     string = "nsx-op/rule_id"
-	TagScopeRuleHash                   string = "nsx-op/rule_hash"
-	TagScopeGroupType                  string = "nsx-op/group_type"
-	TagScopeSelectorHash               string = "nsx-op/selector_hash"
-	TagScopeNSXServiceAccountCRName    string = "nsx-op/nsx_service_account_name"
-	TagScopeNSXServiceAccountCRUID     string = "nsx-op/nsx_service_account_uid"
-	TagScopeNSXShareCreatedFor         string = "nsx-op/nsx_share_created_for"
-	TagScopeSubnetPortCRName           string = "nsx-op/subnetport_name"
-	TagScopeSubnetPortCRUID            string = "nsx-op/subnetport_uid"
-	TagScopeIPAddressAllocationCRName  string = "nsx-op/ipaddressallocation_name"
-	TagScopeIPAddressAllocationCRUID   string = "nsx-op/ipaddressallocation_uid"
-	TagScopeAddressBindingCRName       string = "nsx-op/addressbinding_name"
-	TagScopeAddressBindingCRUID        string = "nsx-op/addressbinding_uid"
-	TagScopeVMNamespaceUID             string = "nsx-op/vm_namespace_uid"
-	TagScopeVMNamespace                string = "nsx-op/vm_namespace"
-	TagScopeManagedBy                  string = "nsx/managed-by"
-	TagScopeEnable                     string = "ENABLED"
-	AutoCreatedTagValue                string = "nsx-op"
-	LabelDefaultSubnetSet              string = "nsxoperator.vmware.com/default-subnetset-for"
-	LabelDefaultNetwork                string = "nsx.vmware.com/default-network"
-	LabelImageFetcher                  string = "iaas.vmware.com/image-fetcher"
-	LabelDefaultVMSubnetSet            string = "VirtualMachine"
-	LabelDefaultPodSubnetSet           string = "Pod"
-	DefaultVMNetwork                   string = "vm"
-	DefaultPodNetwork                  string = "pod"
-	LabelLbIngressIpMode               string = "nsx.vmware.com/ingress-ip-mode"
-	LabelLbIngressIpModeVipValue       string = "vip"
-	LabelLbIngressIpModeProxyValue     string = "proxy"
-	DefaultPodSubnetSet                string = "pod-default"
-	DefaultVMSubnetSet                 string = "vm-default"
-	SystemVPCNetworkConfigurationName  string = "system"
-	TagScopeSubnetCRUID                string = "nsx-op/subnet_uid"
-	TagScopeSubnetCRName               string = "nsx-op/subnet_name"
-	TagScopeSubnetSetCRName            string = "nsx-op/subnetset_name"
-	TagScopeSubnetSetCRUID             string = "nsx-op/subnetset_uid"
-	TagScopeSubnetBindingCRName        string = "nsx-op/subnetbinding_name"
-	TagScopeSubnetBindingCRUID         string = "nsx-op/subnetbinding_uid"
-	TagScopeSubnetIPReservationCRUID   string = "nsx-op/subnetipreservation_uid"
-	TagScopeSubnetIPReservationCRName  string = "nsx-op/subnetipreservation_name"
-	TagValueGroupScope                 string = "scope"
-	TagValueGroupSource                string = "source"
-	TagValueGroupDestination           string = "destination"
-	TagValueShareCreatedForInfra       string = "infra"
-	TagValueShareCreatedForProject     string = "project"
-	TagValueShareNotCreated            string = "notShared"
-	TagValueDLB                        string = "DLB"
-	TagValueSLB                        string = "SLB"
-	TagValueL3InVlanBackedVPCMode      string = "WCP_L3_SUBNET_IN_VLAN_BACKED_VPC_MODE"
-	AnnotationVPCNetworkConfig         string = "nsx.vmware.com/vpc_network_config"
-	AnnotationSharedVPCNamespace       string = "nsx.vmware.com/shared_vpc_namespace"
-	AnnotationDefaultNetworkConfig     string = "nsx.vmware.com/default"
-	AnnotationAttachmentRef            string = "nsx.vmware.com/attachment_ref"
-	AnnotationAssociatedResource       string = "nsx.vmware.com/associated-resource"
-	AnnotationReconfigureNic           string = "nsx/reconfigure-nic"
-	AnnotationPodMAC                   string = "nsx.vmware.com/mac"
-	AnnotationAttachment               string = "nsx.vmware.com/attachment"
-	LabelCPVM                          string = "iaas.vmware.com/is-cpvm-subnetport"
-	TagScopePodName                    string = "nsx-op/pod_name"
-	TagScopePodUID                     string = "nsx-op/pod_uid"
-	TagScopeStatefulSetName            string = "nsx-op/sts_name"
-	TagScopeStatefulSetUID             string = "nsx-op/sts_uid"
+	HashLength                             int    = 8
+	UUIDHashLength                         int    = 5
+	MaxTagsCount                           int    = 26
+	MaxTagScopeLength                      int    = 128
+	MaxTagValueLength                      int    = 256
+	MaxIdLength                            int    = 255
+	MaxNameLength                          int    = 255
+	MaxSubnetNameLength                    int    = 80
+	VPCLbResourcePathMinSegments           int    = 8
+	PriorityNetworkPolicyAllowRule         int    = 2010
+	PriorityNetworkPolicyIsolationRule     int    = 2090
+	PriorityBaselineAdminNetworkPolicy     int    = 2095
+	TagScopeNCPCluster                     string = "ncp/cluster"
+	TagScopeNCPProjectUID                  string = "ncp/project_uid"
+	TagScopeNCPCreateFor                   string = "ncp/created_for"
+	TagScopeNCPVIFProjectUID               string = "ncp/vif_project_uid"
+	TagScopeNCPPod                         string = "ncp/pod"
+	TagScopeNCPVNETInterface               string = "ncp/vnet_interface"
+	TagScopeCreatedFor                     string = "nsx-op/created_for"
+	TagScopeVersion                        string = "nsx-op/version"
+	TagScopeCluster                        string = "nsx-op/cluster"
+	TagScopeNamespace                      string = "nsx-op/namespace"
+	TagScopeNamespaceUID                   string = "nsx-op/namespace_uid"
+	TagScopeSecurityPolicyCRName           string = "nsx-op/security_policy_cr_name"
+	TagScopeSecurityPolicyCRUID            string = "nsx-op/security_policy_cr_uid"
+	TagScopeSecurityPolicyName             string = "nsx-op/security_policy_name"
+	TagScopeSecurityPolicyUID              string = "nsx-op/security_policy_uid"
+	TagScopeNetworkPolicyName              string = "nsx-op/network_policy_name"
+	TagScopeNetworkPolicyUID               string = "nsx-op/network_policy_uid"
+	TagScopeAdminNetworkPolicyName         string = "nsx-op/admin_network_policy_name"
+	TagScopeAdminNetworkPolicyUID          string = "nsx-op/admin_network_policy_uid"
+	TagScopeBaselineAdminNetworkPolicyName string = "nsx-op/baseline_admin_network_policy_name"
+	TagScopeStaticRouteCRName              string = "nsx-op/static_route_name"
+	TagScopeStaticRouteCRUID               string = "nsx-op/static_route_uid"
+	TagScopeRuleID                         string = "nsx-op/rule_id"
+	TagScopeRuleHash                       string = "nsx-op/rule_hash"
+	TagScopeGroupType                      string = "nsx-op/group_type"
+	TagScopeSelectorHash                   string = "nsx-op/selector_hash"
+	TagScopeNSXServiceAccountCRName        string = "nsx-op/nsx_service_account_name"
+	TagScopeNSXServiceAccountCRUID         string = "nsx-op/nsx_service_account_uid"
+	TagScopeNSXShareCreatedFor             string = "nsx-op/nsx_share_created_for"
+	TagScopeSubnetPortCRName               string = "nsx-op/subnetport_name"
+	TagScopeSubnetPortCRUID                string = "nsx-op/subnetport_uid"
+	TagScopeIPAddressAllocationCRName      string = "nsx-op/ipaddressallocation_name"
+	TagScopeIPAddressAllocationCRUID       string = "nsx-op/ipaddressallocation_uid"
+	TagScopeAddressBindingCRName           string = "nsx-op/addressbinding_name"
+	TagScopeAddressBindingCRUID            string = "nsx-op/addressbinding_uid"
+	TagScopeVMNamespaceUID                 string = "nsx-op/vm_namespace_uid"
+	TagScopeVMNamespace                    string = "nsx-op/vm_namespace"
+	TagScopeManagedBy                      string = "nsx/managed-by"
+	TagScopeEnable                         string = "ENABLED"
+	AutoCreatedTagValue                    string = "nsx-op"
+	LabelDefaultSubnetSet                  string = "nsxoperator.vmware.com/default-subnetset-for"
+	LabelDefaultNetwork                    string = "nsx.vmware.com/default-network"
+	LabelImageFetcher                      string = "iaas.vmware.com/image-fetcher"
+	LabelDefaultVMSubnetSet                string = "VirtualMachine"
+	LabelDefaultPodSubnetSet               string = "Pod"
+	DefaultVMNetwork                       string = "vm"
+	DefaultPodNetwork                      string = "pod"
+	LabelLbIngressIpMode                   string = "nsx.vmware.com/ingress-ip-mode"
+	LabelLbIngressIpModeVipValue           string = "vip"
+	LabelLbIngressIpModeProxyValue         string = "proxy"
+	DefaultPodSubnetSet                    string = "pod-default"
+	DefaultVMSubnetSet                     string = "vm-default"
+	SystemVPCNetworkConfigurationName      string = "system"
+	TagScopeSubnetCRUID                    string = "nsx-op/subnet_uid"
+	TagScopeSubnetCRName                   string = "nsx-op/subnet_name"
+	TagScopeSubnetSetCRName                string = "nsx-op/subnetset_name"
+	TagScopeSubnetSetCRUID                 string = "nsx-op/subnetset_uid"
+	TagScopeSubnetBindingCRName            string = "nsx-op/subnetbinding_name"
+	TagScopeSubnetBindingCRUID             string = "nsx-op/subnetbinding_uid"
+	TagScopeSubnetIPReservationCRUID       string = "nsx-op/subnetipreservation_uid"
+	TagScopeSubnetIPReservationCRName      string = "nsx-op/subnetipreservation_name"
+	TagValueGroupScope                     string = "scope"
+	TagValueGroupSource                    string = "source"
+	TagValueGroupDestination               string = "destination"
+	TagValueShareCreatedForInfra           string = "infra"
+	TagValueShareCreatedForProject         string = "project"
+	TagValueShareNotCreated                string = "notShared"
+	TagValueDLB                            string = "DLB"
+	TagValueSLB                            string = "SLB"
+	TagValueL3InVlanBackedVPCMode          string = "WCP_L3_SUBNET_IN_VLAN_BACKED_VPC_MODE"
+	AnnotationVPCNetworkConfig             string = "nsx.vmware.com/vpc_network_config"
+	AnnotationSharedVPCNamespace           string = "nsx.vmware.com/shared_vpc_namespace"
+	AnnotationDefaultNetworkConfig         string = "nsx.vmware.com/default"
+	AnnotationAttachmentRef                string = "nsx.vmware.com/attachment_ref"
+	AnnotationAssociatedResource           string = "nsx.vmware.com/associated-resource"
+	AnnotationReconfigureNic               string = "nsx/reconfigure-nic"
+	AnnotationPodMAC                       string = "nsx.vmware.com/mac"
+	AnnotationAttachment                   string = "nsx.vmware.com/attachment"
+	LabelCPVM                              string = "iaas.vmware.com/is-cpvm-subnetport"
+	TagScopePodName                        string = "nsx-op/pod_name"
+	TagScopePodUID                         string = "nsx-op/pod_uid"
+	TagScopeStatefulSetName                string = "nsx-op/sts_name"
+	TagScopeStatefulSetUID                 string = "nsx-op/sts_uid"
+
+	// AnnotationPodNetworks is the list of the Subnets or SubnetSets which additional interfaces of the Pod are
+	// attached to, and AnnotationPodNetworkStatus is the IP, MAC and gateway of each additional interface.
+	AnnotationPodNetworks      string = "nsx.vmware.com/networks"
+	AnnotationPodNetworkStatus string = "nsx.vmware.com/network-status"
+	// AnnotationPodInterface is the interface name of the Pod which a SubnetPort CR is created for.
+	AnnotationPodInterface string = "nsx.vmware.com/pod-interface"
+
+	// AnnotationStickyIP opts a standalone Pod, or the Pods of a Deployment through the Pod template, in to keeping
+	// the SubnetPort and its IP and MAC after the Pod is deleted. The value is "true" for the default grace period,
+	// or the grace period such as "30m". The SubnetPort is reused by the next Pod with the same identity.
+	AnnotationStickyIP string = "nsx.vmware.com/sticky-ip"
+	// TagScopeStickyIdentity is the "Pod/<name>" or "Deployment/<name>" identity the SubnetPort is retained for,
+	// TagScopeStickyGracePeriod is the grace period in seconds, and TagScopeStickyReleasedAt is the Unix time when
+	// the Pod using the SubnetPort was deleted.
+	TagScopeStickyIdentity    string = "nsx-op/sticky_identity"
+	TagScopeStickyGracePeriod string = "nsx-op/sticky_grace_period"
+	TagScopeStickyReleasedAt  string = "nsx-op/sticky_released_at"
+
+	// AnnotationStaticIPs is the comma-separated IP addresses or IP ranges of the Pods of a StatefulSet, the n-th IP is
+	// for the Pod of the n-th ordinal, and AnnotationStaticIPSubnet is the Subnet which the Pods are attached to.
+	// Alternatively, AnnotationStaticIPReservation references a SubnetIPReservation whose Subnet and IPs are used.
+	AnnotationStaticIPs           string = "nsx.vmware.com/static-ips"
+	AnnotationStaticIPSubnet      string = "nsx.vmware.com/static-ip-subnet"
+	AnnotationStaticIPReservation string = "nsx.vmware.com/static-ip-reservation"
 
 	// Tags and annotations for DNS record use case.
-	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, xxroutes
+	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, ingress, xxroutes
 	TagScopeDNSRecordGatewayIndexList   string = "nsx-op/dns_gateway_index_list"
 	TagScopeDNSRecordOwnerNamespace     string = "nsx-op/dns_owner_namespace"
 	TagScopeDNSRecordOwnerName          string = "nsx-op/dns_owner_name"
@@ -118,9 +147,17 @@ const (
 	TagValueDNSRecordForGRPCRoute       string = "grpcroute"
 	TagValueDNSRecordForTLSRoute        string = "tlsroute"
 	TagValueDNSRecordForService         string = "service"
+	TagValueDNSRecordForIngress         string = "ingress"
 	AnnotationDNSHostnameKey            string = "nsx.vmware.com/hostname"
 	AnnotationDNSHostnameSourceKey      string = "nsx.vmware.com/gateway-hostname-source"
 	AnnotationsDNSSkip                  string = "nsx.vmware.com/skip"
+	// AnnotationDNSTTLKey is the TTL of the DNS records of the object, in seconds or a duration such as "5m".
+	AnnotationDNSTTLKey string = "nsx.vmware.com/dns-ttl"
+	// AnnotationDNSCNAMEKey is the comma-separated aliases of a LoadBalancer Service, a CNAME record pointing to the
+	// first nsx.vmware.com/hostname is created for each alias.
+	AnnotationDNSCNAMEKey string = "nsx.vmware.com/dns-cname"
+	// AnnotationDNSSRVKey set to "true" creates an SRV record for each named port of a LoadBalancer Service.
+	AnnotationDNSSRVKey string = "nsx.vmware.com/dns-srv"
 
 	// TagScopePodIndex is the NSX tag scope for Pod label apps.kubernetes.io/pod-index when synced onto the port (not set in BuildBasicTags).
 	TagScopePodIndex   string = "apps.kubernetes.io/pod-index"
@@ -158,6 +195,7 @@ const (
 	RuleActionAllow        = "allow"
 	RuleActionDrop         = "isolation"
 	RuleActionReject       = "reject"
+	RuleActionPass         = "pass"
 	RuleAnyPorts           = "all"
 	DefaultProject         = "default"
 	DefaultVpcAttachmentId = "default"
@@ -189,6 +227,8 @@ var (
 	ResourceTypeDomain                           = "Domain"
 	ResourceTypeSecurityPolicy                   = "SecurityPolicy"
 	ResourceTypeNetworkPolicy                    = "NetworkPolicy"
+	ResourceTypeAdminNetworkPolicy               = "AdminNetworkPolicy"
+	ResourceTypeBaselineAdminNetworkPolicy       = "BaselineAdminNetworkPolicy"
 	ResourceTypeGroup                            = "Group"
 	ResourceTypeRule                             = "Rule"
 	ResourceTypeIPBlock                          = "IpAddressBlock"
@@ -202,6 +242,7 @@ var (
 	ResourceTypeLBService                        = "LBService"
 	ResourceTypeVpcAttachment                    = "VpcAttachment"
 	ResourceTypeShare                            = "Share"
+	ResourceTypeContextProfile                   = "PolicyContextProfile"
 	ResourceTypeSharedResource                   = "SharedResource"
 	ResourceTypeStaticRoutes                     = "StaticRoutes"
 	ResourceTypeChildLBPool                      = "ChildLBPool"
@@ -211,6 +252,7 @@ var (
 	ResourceTypeChildShare                       = "ChildShare"
 	ResourceTypeChildRule                        = "ChildRule"
 	ResourceTypeChildGroup                       = "ChildGroup"
+	ResourceTypeChildContextProfile              = "ChildPolicyContextProfile"
 	ResourceTypeChildSecurityPolicy              = "ChildSecurityPolicy"
 	ResourceTypeChildStaticRoutes                = "ChildStaticRoutes"
 	ResourceTypeChildSubnetConnectionBindingMap  = "ChildSubnetConnectionBindingMap"
@@ -258,6 +300,8 @@ type Service struct {
 	Client    client.Client
 	NSXClient *nsx.Client
 	NSXConfig *config.NSXOperatorConfig
+	// CleanupFilter is only set by the cleanup to select a subset of the resources to delete.
+	CleanupFilter *CleanupFilter
 }
 
 func NewConverter() *bindings.TypeConverter {
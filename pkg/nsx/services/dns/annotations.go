@@ -0,0 +1,22 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extannotations "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// RecordTTLFromAnnotations returns the TTL in nsx.vmware.com/dns-ttl of the DNS owner, DefaultRecordTtL is used for
+// the records if it is not set or invalid.
+func RecordTTLFromAnnotations(obj metav1.Object) extdns.TTL {
+	ttl, err := extannotations.TTLFromAnnotations(obj.GetAnnotations(), common.AnnotationDNSTTLKey)
+	if err != nil {
+		log.Info("Ignoring invalid DNS TTL annotation", "namespace", obj.GetNamespace(), "name", obj.GetName(), "error", err.Error())
+	}
+	return ttl
+}
@@ -14,6 +14,10 @@ import (
 
 const (
 	DefaultRecordTtL = 300
+
+	// The record types of ProjectDnsRecord which have no constants in the NSX SDK model.
+	nsxRecordTypeTXT = "TXT"
+	nsxRecordTypeSRV = "SRV"
 )
 
 // BuildProjectDnsRecord builds one *model.ProjectDnsRecord for row using batchOwner or row.effectiveOwner.
@@ -89,6 +93,10 @@ func getNSXDnsRecordType(recType string) string {
 		return model.ProjectDnsRecord_RECORD_TYPE_NS
 	case extdns.RecordTypePTR:
 		return model.ProjectDnsRecord_RECORD_TYPE_PTR
+	case extdns.RecordTypeTXT:
+		return nsxRecordTypeTXT
+	case extdns.RecordTypeSRV:
+		return nsxRecordTypeSRV
 	case extdns.RecordTypeA:
 		return model.ProjectDnsRecord_RECORD_TYPE_A
 	default:
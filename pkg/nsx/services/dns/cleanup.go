@@ -19,7 +19,7 @@ func (s *DNSRecordService) CleanupInfraResources(ctx context.Context) error {
 	}
 	toDelete := make([]*model.ProjectDnsRecord, 0, len(objs))
 	for _, rec := range objs {
-		if rec == nil {
+		if rec == nil || !s.CleanupFilter.Match(rec) {
 			continue
 		}
 		cp := *rec
@@ -31,3 +31,17 @@ func (s *DNSRecordService) CleanupInfraResources(ctx context.Context) error {
 		s.DNSRecordStore.DeleteMultipleObjects(deletedObjs)
 	})
 }
+
+// PlanCleanupInfraResources returns the paths of the cached ProjectDnsRecord objects which CleanupInfraResources would delete.
+func (s *DNSRecordService) PlanCleanupInfraResources(_ context.Context) (map[string][]string, error) {
+	paths := make([]string, 0)
+	for _, rec := range s.DNSRecordStore.ListDNSRecords() {
+		if rec == nil || rec.Path == nil || !s.CleanupFilter.Match(rec) {
+			continue
+		}
+		paths = append(paths, *rec.Path)
+	}
+	return map[string][]string{
+		common.ResourceTypeProjectDnsRecord: paths,
+	}, nil
+}
@@ -0,0 +1,123 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// dnsConflict is an FQDN rejected for a claimant because the DNS record is owned by another resource.
+type dnsConflict struct {
+	fqdn       string
+	recordType string
+	zonePath   string
+	owner      v1alpha1.DNSRecordOwner
+	claimant   v1alpha1.DNSRecordOwner
+	reason     string
+}
+
+// dnsConflictTracker keeps the conflicts found in the last validation of the endpoints of each claimant, keyed by
+// the owner index key of the claimant. The zero value is ready to use.
+type dnsConflictTracker struct {
+	mu         sync.Mutex
+	byClaimant map[string][]dnsConflict
+}
+
+func (t *dnsConflictTracker) add(claimantKey string, c dnsConflict) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.byClaimant == nil {
+		t.byClaimant = make(map[string][]dnsConflict)
+	}
+	t.byClaimant[claimantKey] = append(t.byClaimant[claimantKey], c)
+}
+
+func (t *dnsConflictTracker) reset(claimantKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.byClaimant, claimantKey)
+}
+
+func (t *dnsConflictTracker) list() []dnsConflict {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []dnsConflict
+	for _, conflicts := range t.byClaimant {
+		out = append(out, conflicts...)
+	}
+	return out
+}
+
+// dnsRecordOwnerFromRecord returns the owner of an existing DNS record from its NSX tags.
+func dnsRecordOwnerFromRecord(rec *model.ProjectDnsRecord) v1alpha1.DNSRecordOwner {
+	return v1alpha1.DNSRecordOwner{
+		Kind:      resourceKindFromCreatedForTag(dnsRecordCreatedForValue(rec)),
+		Namespace: firstTagValue(rec.Tags, common.TagScopeDNSRecordOwnerNamespace),
+		Name:      firstTagValue(rec.Tags, common.TagScopeDNSRecordOwnerName),
+		Cluster:   firstTagValue(rec.Tags, common.TagScopeCluster),
+	}
+}
+
+// recordConflict records that fqdn of the claimant is rejected because rec is owned by another resource.
+func (s *DNSRecordService) recordConflict(claimant *ResourceRef, zonePath, fqdn, recordType string, rec *model.ProjectDnsRecord, err error) {
+	s.conflicts.add(ownerNNIndexKeyForResourceRef(claimant), dnsConflict{
+		fqdn:       strings.ToLower(fqdn),
+		recordType: recordType,
+		zonePath:   zonePath,
+		owner:      dnsRecordOwnerFromRecord(rec),
+		claimant: v1alpha1.DNSRecordOwner{
+			Kind:      claimant.Kind,
+			Namespace: claimant.GetNamespace(),
+			Name:      claimant.GetName(),
+			Cluster:   getCluster(s),
+		},
+		reason: err.Error(),
+	})
+}
+
+// ListDNSConflicts returns the DNS conflicts found in the last validation of each claimant, grouped by the namespace
+// of the claimants. The claimants of the same FQDN, record type and owner are merged into one DNSConflict.
+func (s *DNSRecordService) ListDNSConflicts() map[string][]v1alpha1.DNSConflict {
+	type conflictKey struct {
+		namespace, fqdn, recordType, zonePath string
+		owner                                 v1alpha1.DNSRecordOwner
+	}
+	merged := make(map[conflictKey]*v1alpha1.DNSConflict)
+	for _, c := range s.conflicts.list() {
+		key := conflictKey{namespace: c.claimant.Namespace, fqdn: c.fqdn, recordType: c.recordType, zonePath: c.zonePath, owner: c.owner}
+		conflict, ok := merged[key]
+		if !ok {
+			conflict = &v1alpha1.DNSConflict{FQDN: c.fqdn, RecordType: c.recordType, DNSZone: c.zonePath, Owner: c.owner, Reason: c.reason}
+			merged[key] = conflict
+		}
+		if !slices.Contains(conflict.Claimants, c.claimant) {
+			conflict.Claimants = append(conflict.Claimants, c.claimant)
+		}
+	}
+	out := make(map[string][]v1alpha1.DNSConflict)
+	for key, conflict := range merged {
+		slices.SortFunc(conflict.Claimants, compareDNSRecordOwner)
+		out[key.namespace] = append(out[key.namespace], *conflict)
+	}
+	for _, conflicts := range out {
+		slices.SortFunc(conflicts, func(a, b v1alpha1.DNSConflict) int {
+			return cmp.Or(cmp.Compare(a.FQDN, b.FQDN), cmp.Compare(a.RecordType, b.RecordType),
+				cmp.Compare(a.DNSZone, b.DNSZone), compareDNSRecordOwner(a.Owner, b.Owner))
+		})
+	}
+	return out
+}
+
+func compareDNSRecordOwner(a, b v1alpha1.DNSRecordOwner) int {
+	return cmp.Or(cmp.Compare(a.Cluster, b.Cluster), cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+}
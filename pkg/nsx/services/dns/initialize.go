@@ -36,6 +36,7 @@ func InitializeDNSRecordService(commonService common.Service, vpcService common.
 
 	wg.Add(1)
 	go commonService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeProjectDnsRecord, tags, s.DNSRecordStore)
+	common.RegisterStoreMetrics(common.ResourceTypeProjectDnsRecord, &s.DNSRecordStore.ResourceStore)
 
 	go func() {
 		wg.Wait()
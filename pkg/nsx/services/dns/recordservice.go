@@ -70,6 +70,8 @@ type DNSRecordService struct {
 	DNSRecordStore          *RecordStore
 	DNSZoneMap              *dnsZoneCache
 	ProjectDnsRecordBuilder *common.PolicyTreeBuilder[*model.ProjectDnsRecord]
+	// conflicts keeps the FQDNs rejected for each owner, reported on the NetworkInfo of the owner's namespace.
+	conflicts dnsConflictTracker
 }
 
 // CreateOrUpdateRecords upserts batch rows into the store and NSX placeholder. Returns (storeMutated, err).
@@ -168,12 +170,14 @@ func (s *DNSRecordService) validateEndpointRowConflict(zonePath string, ep *extd
 		if !slices.Equal(extRecValues, newRecValues) {
 			err := fmt.Errorf("FQDN %s is configured with different values in DNS zone %s", fqdn, zonePath)
 			log.Error(err, "FQDN targets conflict with existing record", "resource", getDNSRecordOwnerNamespacedName(rec))
+			s.recordConflict(owner, zonePath, fqdn, ep.RecordType, rec, err)
 			return nil, err
 		}
 		effectiveOwner, ok := resourceRefFromDNSRecord(rec)
 		if !ok {
 			err := fmt.Errorf("FQDN %s has an existing DNS record with incomplete owner metadata in DNS zone %s", fqdn, zonePath)
 			log.Error(err, "cannot adopt shared DNS record")
+			s.recordConflict(owner, zonePath, fqdn, ep.RecordType, rec, err)
 			return nil, err
 		}
 		log.Info("Adopting shared DNS record", "fqdn", fqdn, "zone", zonePath,
@@ -185,6 +189,13 @@ func (s *DNSRecordService) validateEndpointRowConflict(zonePath string, ep *extd
 		row.contributingOwnerKeys = mergeContributingOwnerKeys(existingContributions, currentNNKey, primaryNN)
 		return row, nil
 	}
+	if ownerRec, err := s.checkTXTOwner(zonePath, recordName, ep.RecordType); err != nil {
+		log.Error(err, "DNS name is owned by another cluster", "fqdn", fqdn, "zone", zonePath)
+		if ownerRec != nil {
+			s.recordConflict(owner, zonePath, fqdn, ep.RecordType, ownerRec, err)
+		}
+		return nil, err
+	}
 	return NewEndpointRow(ep, zonePath, recordName), nil
 }
 
@@ -235,6 +246,7 @@ func (s *DNSRecordService) applyDNSUpsertRows(batch *AggregatedDNSEndpoints) ([]
 			desiredRecs = append(desiredRecs, rec)
 		}
 	}
+	desiredRecs = s.withTXTOwnerRecords(desiredRecs)
 
 	// Collect all records where batch.Owner is primary or contributing.
 	owner := batch.Owner
@@ -266,6 +278,9 @@ func (s *DNSRecordService) collectRecordsByOwner(ownerKind, ownerNamespace, owne
 
 // DeleteRecordByOwnerNN deletes or retags rows for kind/ns/name. Returns (storeMutated, err).
 func (s *DNSRecordService) DeleteRecordByOwnerNN(ctx context.Context, kind, namespace, name string) (bool, error) {
+	if createdFor := resourceKindToCreatedFor(kind); createdFor != "" {
+		s.conflicts.reset(dnsRecordOwnerKey(createdFor, dnsRecordOwnerNamespacedNameKey(namespace, name)))
+	}
 	toUpdate, toDelete, err := s.calculateRecordsForDeletion(kind, namespace, name)
 	if err != nil {
 		return false, err
@@ -783,6 +783,8 @@ func TestGetNSXDnsRecordType_table(t *testing.T) {
 		{extdns.RecordTypeCNAME, model.ProjectDnsRecord_RECORD_TYPE_CNAME},
 		{extdns.RecordTypeNS, model.ProjectDnsRecord_RECORD_TYPE_NS},
 		{extdns.RecordTypePTR, model.ProjectDnsRecord_RECORD_TYPE_PTR},
+		{extdns.RecordTypeTXT, nsxRecordTypeTXT},
+		{extdns.RecordTypeSRV, nsxRecordTypeSRV},
 		{"UNKNOWN", model.ProjectDnsRecord_RECORD_TYPE_A},
 		{"", model.ProjectDnsRecord_RECORD_TYPE_A},
 	}
@@ -803,6 +805,7 @@ func TestResourceKindFromCreatedForTag_table(t *testing.T) {
 		{servicecommon.TagValueDNSRecordForTLSRoute, ResourceKindTLSRoute},
 		{servicecommon.TagValueDNSRecordForGateway, ResourceKindGateway},
 		{servicecommon.TagValueDNSRecordForService, ResourceKindService},
+		{servicecommon.TagValueDNSRecordForIngress, ResourceKindIngress},
 		{"unknown_kind", ""},
 		{"", ""},
 	}
@@ -1171,13 +1174,19 @@ func TestValidateEndpointRowConflict_table(t *testing.T) {
 				require.NoError(t, env.DNSRecordStore.Add(r))
 			}
 			row, err := env.validateEndpointRowConflict(zonePath, epA, "svc", tc.owner)
+			conflicts := env.ListDNSConflicts()
 			if tc.wantErr {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tc.errSub)
+				require.Len(t, conflicts["ns"], 1)
+				assert.Equal(t, fqdn, conflicts["ns"][0].FQDN)
+				assert.Equal(t, []v1alpha1.DNSRecordOwner{{Kind: ResourceKindService, Namespace: "ns", Name: "svcA", Cluster: "unit-test"}},
+					conflicts["ns"][0].Claimants)
 				return
 			}
 			require.NoError(t, err)
 			require.NotNil(t, row)
+			assert.Empty(t, conflicts)
 			if tc.wantShared {
 				require.NotNil(t, row.effectiveOwner, "adoption: effectiveOwner should be set")
 				require.NotEmpty(t, row.contributingOwnerKeys)
@@ -1339,3 +1348,40 @@ func TestRouteRecordWithGatewayAndContributions(t *testing.T) {
 	require.Len(t, recByOwner3, 1)
 	require.Empty(t, store.ListRecordsReferencingContributingOwner(owner3NNKey))
 }
+
+func TestListDNSConflicts(t *testing.T) {
+	s := newTestDNSRecordService(t, BuildDNSRecordStore()).DNSRecordService
+	svcOwnerRec := &model.ProjectDnsRecord{Tags: []model.Tag{
+		modelTag(servicecommon.TagScopeDNSRecordFor, servicecommon.TagValueDNSRecordForService),
+		modelTag(servicecommon.TagScopeDNSRecordOwnerNamespace, "ns0"),
+		modelTag(servicecommon.TagScopeDNSRecordOwnerName, "owner"),
+		modelTag(servicecommon.TagScopeCluster, "c2"),
+	}}
+	claimant := func(ns, name string) *ResourceRef {
+		return &ResourceRef{Kind: ResourceKindGateway, Object: &metav1.ObjectMeta{Namespace: ns, Name: name}}
+	}
+	conflictErr := errors.New("conflict")
+	s.recordConflict(claimant("ns1", "gw2"), testDNSZonePathT, "A.example.com", extdns.RecordTypeA, svcOwnerRec, conflictErr)
+	s.recordConflict(claimant("ns1", "gw1"), testDNSZonePathT, "a.example.com", extdns.RecordTypeA, svcOwnerRec, conflictErr)
+	s.recordConflict(claimant("ns1", "gw1"), testDNSZonePathT, "b.example.com", extdns.RecordTypeA, svcOwnerRec, conflictErr)
+	s.recordConflict(claimant("ns2", "gw1"), testDNSZonePathT, "a.example.com", extdns.RecordTypeA, svcOwnerRec, conflictErr)
+
+	owner := v1alpha1.DNSRecordOwner{Kind: ResourceKindService, Namespace: "ns0", Name: "owner", Cluster: "c2"}
+	gw := func(ns, name string) v1alpha1.DNSRecordOwner {
+		return v1alpha1.DNSRecordOwner{Kind: ResourceKindGateway, Namespace: ns, Name: name, Cluster: "unit-test"}
+	}
+	conflicts := s.ListDNSConflicts()
+	assert.Equal(t, []v1alpha1.DNSConflict{
+		{FQDN: "a.example.com", RecordType: extdns.RecordTypeA, DNSZone: testDNSZonePathT, Owner: owner, Claimants: []v1alpha1.DNSRecordOwner{gw("ns1", "gw1"), gw("ns1", "gw2")}, Reason: "conflict"},
+		{FQDN: "b.example.com", RecordType: extdns.RecordTypeA, DNSZone: testDNSZonePathT, Owner: owner, Claimants: []v1alpha1.DNSRecordOwner{gw("ns1", "gw1")}, Reason: "conflict"},
+	}, conflicts["ns1"])
+	assert.Len(t, conflicts["ns2"], 1)
+
+	// The conflicts of a claimant are removed when its DNS records are deleted.
+	_, err := s.DeleteRecordByOwnerNN(context.Background(), ResourceKindGateway, "ns1", "gw1")
+	require.NoError(t, err)
+	conflicts = s.ListDNSConflicts()
+	require.Len(t, conflicts["ns1"], 1)
+	assert.Equal(t, []v1alpha1.DNSRecordOwner{gw("ns1", "gw2")}, conflicts["ns1"][0].Claimants)
+	assert.Len(t, conflicts["ns2"], 1)
+}
@@ -0,0 +1,137 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// The TXT ownership registry is similar to the TXT registry of ExternalDNS: each DNS record has a TXT record named
+// "<record type>-<record name>" in the same zone, whose value records the owner cluster. Before a new DNS name is
+// published, the DNS zone is searched on NSX and the name is refused if it or its TXT record is owned by another
+// cluster, as the records of the clusters sharing a zone have the same NSX IDs.
+const (
+	txtOwnerHeritage    = "heritage=nsx-operator"
+	txtOwnerClusterKey  = "nsx-operator/owner"
+	txtOwnerResourceKey = "nsx-operator/resource"
+)
+
+func (s *DNSRecordService) txtOwnerEnabled() bool {
+	return s.NSXConfig != nil && s.NSXConfig.NsxConfig != nil && s.NSXConfig.DNSTXTOwnerRecord
+}
+
+// txtOwnerRecordName returns the name of the TXT ownership record of a record, e.g. "a-www" for the A record "www".
+func txtOwnerRecordName(recordName, recordType string) string {
+	return strings.ToLower(recordType) + "-" + recordName
+}
+
+// txtOwnerValue returns the value of the TXT ownership record of the owner.
+func txtOwnerValue(cluster, createdFor, namespace, name string) string {
+	return fmt.Sprintf("%s,%s=%s,%s=%s/%s/%s", txtOwnerHeritage, txtOwnerClusterKey, cluster, txtOwnerResourceKey, createdFor, namespace, name)
+}
+
+// txtOwnerCluster returns the owner cluster in the value of a TXT ownership record, false if the value is not written
+// by nsx-operator.
+func txtOwnerCluster(value string) (string, bool) {
+	value = strings.Trim(strings.TrimSpace(value), `"`)
+	parts := strings.Split(value, ",")
+	if len(parts) == 0 || parts[0] != txtOwnerHeritage {
+		return "", false
+	}
+	for _, part := range parts[1:] {
+		if cluster, ok := strings.CutPrefix(part, txtOwnerClusterKey+"="); ok {
+			return cluster, true
+		}
+	}
+	return "", false
+}
+
+// buildTXTOwnerRecord returns the TXT ownership record of rec, it has the same tags as rec so that it is updated and
+// deleted together with rec. nil is returned for TXT records.
+func (s *DNSRecordService) buildTXTOwnerRecord(rec *model.ProjectDnsRecord) *model.ProjectDnsRecord {
+	if rec.RecordType == nil || *rec.RecordType == nsxRecordTypeTXT || rec.RecordName == nil || rec.ZonePath == nil {
+		return nil
+	}
+	createdFor, ns, name, ok := ownerCreatedForAndNNFromDNSRecord(rec)
+	if !ok {
+		return nil
+	}
+	txtName := txtOwnerRecordName(*rec.RecordName, *rec.RecordType)
+	recID, path, rt := getRecordIDAndPathAndType(txtName, extdns.RecordTypeTXT, *rec.ZonePath)
+	txt := &model.ProjectDnsRecord{
+		Id:           common.String(recID),
+		Path:         common.String(path),
+		RecordName:   common.String(txtName),
+		DisplayName:  common.String(txtName),
+		Tags:         append([]model.Tag(nil), rec.Tags...),
+		RecordType:   common.String(rt),
+		RecordValues: []string{txtOwnerValue(getCluster(s), createdFor, ns, name)},
+		ZonePath:     rec.ZonePath,
+		Ttl:          rec.Ttl,
+	}
+	if rec.Fqdn != nil {
+		txt.Fqdn = common.String(txtOwnerRecordName(*rec.Fqdn, *rec.RecordType))
+	}
+	return txt
+}
+
+// withTXTOwnerRecords appends the TXT ownership records of recs if the TXT registry is enabled.
+func (s *DNSRecordService) withTXTOwnerRecords(recs []*model.ProjectDnsRecord) []*model.ProjectDnsRecord {
+	if !s.txtOwnerEnabled() {
+		return recs
+	}
+	out := append([]*model.ProjectDnsRecord(nil), recs...)
+	for _, rec := range recs {
+		if txt := s.buildTXTOwnerRecord(rec); txt != nil {
+			out = append(out, txt)
+		}
+	}
+	return out
+}
+
+// checkTXTOwner returns an error and the conflicting record if the record or its TXT ownership record in the zone is
+// created by another cluster. It is only called for the DNS names which are not in the store, so NSX is searched once
+// per new name.
+func (s *DNSRecordService) checkTXTOwner(zonePath, recordName, recordType string) (*model.ProjectDnsRecord, error) {
+	if !s.txtOwnerEnabled() {
+		return nil, nil
+	}
+	nsxRecordType := getNSXDnsRecordType(recordType)
+	txtName := txtOwnerRecordName(recordName, nsxRecordType)
+	found := BuildDNSRecordStore()
+	query := fmt.Sprintf("%s:%s AND zone_path:%s AND record_name:(%s OR %s)", common.ResourceType, common.ResourceTypeProjectDnsRecord,
+		escapeSearchValue(zonePath), escapeSearchValue(recordName), escapeSearchValue(txtName))
+	if _, err := s.SearchResource(common.ResourceTypeProjectDnsRecord, query, found, nil); err != nil {
+		return nil, fmt.Errorf("failed to search DNS records of %s in DNS zone %s: %w", recordName, zonePath, err)
+	}
+	cluster := getCluster(s)
+	for _, obj := range found.List() {
+		rec := obj.(*model.ProjectDnsRecord)
+		if firstTagValue(rec.Tags, common.TagScopeCluster) == cluster || rec.RecordName == nil || rec.RecordType == nil {
+			continue
+		}
+		switch {
+		case *rec.RecordName == txtName && *rec.RecordType == nsxRecordTypeTXT:
+			for _, value := range rec.RecordValues {
+				if owner, ok := txtOwnerCluster(value); ok && owner != cluster {
+					return rec, fmt.Errorf("DNS name %s in DNS zone %s is owned by cluster %s", recordName, zonePath, owner)
+				}
+			}
+		case *rec.RecordName == recordName && *rec.RecordType == nsxRecordType:
+			return rec, fmt.Errorf("DNS name %s in DNS zone %s is not owned by cluster %s", recordName, zonePath, cluster)
+		}
+	}
+	return nil, nil
+}
+
+// escapeSearchValue escapes the reserved characters of the NSX search query in a value.
+func escapeSearchValue(value string) string {
+	return strings.NewReplacer("/", `\/`, ":", `\:`, "-", `\-`).Replace(value)
+}
@@ -0,0 +1,69 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestTXTOwnerCluster_table(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		want      string
+		wantFound bool
+	}{
+		{name: "written_by_operator", value: txtOwnerValue("c1", "service", "ns", "svc"), want: "c1", wantFound: true},
+		{name: "quoted", value: `"` + txtOwnerValue("c2", "ingress", "ns", "ing") + `"`, want: "c2", wantFound: true},
+		{name: "external_dns", value: "heritage=external-dns,external-dns/owner=default"},
+		{name: "no_owner", value: txtOwnerHeritage},
+		{name: "empty"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := txtOwnerCluster(tc.value)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWithTXTOwnerRecords(t *testing.T) {
+	rec := &model.ProjectDnsRecord{
+		RecordName: servicecommon.String("www"),
+		RecordType: servicecommon.String(model.ProjectDnsRecord_RECORD_TYPE_A),
+		Fqdn:       servicecommon.String("www.example.com"),
+		ZonePath:   servicecommon.String(testDNSZonePathT),
+		Ttl:        servicecommon.Int64(60),
+		Tags: []model.Tag{
+			{Scope: servicecommon.String(servicecommon.TagScopeDNSRecordFor), Tag: servicecommon.String(servicecommon.TagValueDNSRecordForService)},
+			{Scope: servicecommon.String(servicecommon.TagScopeDNSRecordOwnerNamespace), Tag: servicecommon.String("ns")},
+			{Scope: servicecommon.String(servicecommon.TagScopeDNSRecordOwnerName), Tag: servicecommon.String("svc")},
+		},
+	}
+	s := &DNSRecordService{}
+	s.NSXConfig = &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "c1"}, NsxConfig: &config.NsxConfig{}}
+	assert.Len(t, s.withTXTOwnerRecords([]*model.ProjectDnsRecord{rec}), 1)
+
+	s.NSXConfig.DNSTXTOwnerRecord = true
+	recs := s.withTXTOwnerRecords([]*model.ProjectDnsRecord{rec})
+	require.Len(t, recs, 2)
+	txt := recs[1]
+	assert.Equal(t, "a-www", *txt.RecordName)
+	assert.Equal(t, "a-www.example.com", *txt.Fqdn)
+	assert.Equal(t, nsxRecordTypeTXT, *txt.RecordType)
+	assert.Equal(t, rec.Tags, txt.Tags)
+	assert.Equal(t, rec.Ttl, txt.Ttl)
+	assert.Equal(t, []string{txtOwnerValue("c1", servicecommon.TagValueDNSRecordForService, "ns", "svc")}, txt.RecordValues)
+
+	// TXT records have no ownership record.
+	assert.Nil(t, s.buildTXTOwnerRecord(txt))
+}
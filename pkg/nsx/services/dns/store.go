@@ -264,6 +264,8 @@ func resourceKindFromCreatedForTag(createdFor string) string {
 		return ResourceKindGateway
 	case common.TagValueDNSRecordForService:
 		return ResourceKindService
+	case common.TagValueDNSRecordForIngress:
+		return ResourceKindIngress
 	default:
 		return ""
 	}
@@ -282,6 +284,8 @@ func resourceKindToCreatedFor(kind string) string {
 		return common.TagValueDNSRecordForTLSRoute
 	case ResourceKindService:
 		return common.TagValueDNSRecordForService
+	case ResourceKindIngress:
+		return common.TagValueDNSRecordForIngress
 	default:
 		return ""
 	}
@@ -20,6 +20,7 @@ const (
 	ResourceKindGRPCRoute = "GRPCRoute"
 	ResourceKindTLSRoute  = "TLSRoute"
 	ResourceKindService   = "Service"
+	ResourceKindIngress   = "Ingress"
 	// DNSRecordPathSegment is the NSX Policy path segment for project-scoped ProjectDnsRecord (same as common.PathSegmentProjectDnsRecords).
 	DNSRecordPathSegment = common.PathSegmentProjectDnsRecords
 )
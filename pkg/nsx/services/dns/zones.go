@@ -78,6 +78,8 @@ func (s *DNSRecordService) getZonePathForHostname(z extprovider.ZoneIDName, host
 func (s *DNSRecordService) ValidateEndpointsByZone(namespace string, owner *ResourceRef, eps []*extdns.Endpoint) ([]EndpointRow, map[string]string, error) {
 	log.Info("Validating DNS endpoints by zone", "namespace", namespace,
 		"owner", owner.GetName(), "endpoints", len(eps))
+	// The conflicts of the owner are recomputed by this validation.
+	s.conflicts.reset(ownerNNIndexKeyForResourceRef(owner))
 	vpcConfig, err := s.VPCService.GetVPCNetworkConfigByNamespace(namespace)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to find VPCNetworkConfiguration for the Namespace %s", namespace)
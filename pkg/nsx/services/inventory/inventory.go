@@ -402,6 +402,18 @@ func (s *InventoryService) UpdatePendingAdd(externalId string, inventoryObject i
 	s.pendingAdd[externalId] = inventoryObject
 }
 
+// PlanCleanupBeforeVPCDeletion returns the API path of the inventory cluster which CleanupBeforeVPCDeletion would delete.
+func (s *InventoryService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	paths := make([]string, 0)
+	for _, obj := range s.ClusterStore.List() {
+		cluster := obj.(*containerinventory.ContainerCluster)
+		paths = append(paths, fmt.Sprintf(baseUrl, cluster.ExternalId))
+	}
+	return map[string][]string{
+		string(ContainerCluster): paths,
+	}, nil
+}
+
 // CleanupBeforeVPCDeletion cleans up all clusters registered in the inventory. Since the resources in inventory
 // has no dependency on the exact VPC, we will perform the operation before cleaning up VPCs.
 func (s *InventoryService) CleanupBeforeVPCDeletion(ctx context.Context) error {
@@ -31,7 +31,7 @@ func (service *IPAddressAllocationService) CleanupVPCChildResources(ctx context.
 
 	allocations := make([]*model.VpcIpAddressAllocation, 0)
 	// Mark the resources for delete.
-	for _, obj := range service.ipAddressAllocationStore.List() {
+	for _, obj := range service.CleanupFilter.Filter(service.ipAddressAllocationStore.List()) {
 		allocation := obj.(*model.VpcIpAddressAllocation)
 		allocation.MarkedForDelete = &MarkedForDelete
 		allocations = append(allocations, allocation)
@@ -42,3 +42,15 @@ func (service *IPAddressAllocationService) CleanupVPCChildResources(ctx context.
 		service.ipAddressAllocationStore.DeleteMultipleObjects(deletedObjs)
 	})
 }
+
+// PlanCleanupVPCChildResources returns the paths of the NSX VpcIPAddressAllocations which CleanupVPCChildResources would
+// delete with the given vpcPath.
+func (service *IPAddressAllocationService) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	objs := service.CleanupFilter.Filter(service.ipAddressAllocationStore.List())
+	if vpcPath != "" {
+		objs = service.ipAddressAllocationStore.GetByIndex(common.IndexByVPCPathFuncKey, vpcPath)
+	}
+	return map[string][]string{
+		common.ResourceTypeIPAddressAllocation: common.ListNSXResourcePaths(objs),
+	}, nil
+}
@@ -33,3 +33,18 @@ func IpAddressAllocationToComparable(iap *model.VpcIpAddressAllocation) Comparab
 func ComparableToIpAddressAllocation(iap Comparable) *model.VpcIpAddressAllocation {
 	return (*model.VpcIpAddressAllocation)(iap.(*IpAddressAllocation))
 }
+
+// DriftTarget returns the target to audit the IPAddressAllocations in the store against NSX.
+func (service *IPAddressAllocationService) DriftTarget() common.DriftTarget {
+	return common.DriftTarget{
+		ResourceType: ResourceTypeIPAddressAllocation,
+		Store:        &service.ipAddressAllocationStore.ResourceStore,
+		Inspect: func(obj interface{}) (string, []model.Tag, common.Comparable) {
+			allocation := obj.(*model.VpcIpAddressAllocation)
+			if allocation.Path == nil {
+				return "", nil, nil
+			}
+			return *allocation.Path, allocation.Tags, IpAddressAllocationToComparable(allocation)
+		},
+	}
+}
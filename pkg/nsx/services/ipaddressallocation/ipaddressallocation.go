@@ -50,6 +50,7 @@ func InitializeIPAddressAllocation(service common.Service, vpcService common.VPC
 	go ipAddressAllocationService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeIPAddressAllocation,
 		[]model.Tag{{Scope: String(common.TagScopeCluster), Tag: String(service.NSXClient.NsxConfig.Cluster)}},
 		ipAddressAllocationService.ipAddressAllocationStore)
+	common.RegisterStoreMetrics(ResourceTypeIPAddressAllocation, &ipAddressAllocationService.ipAddressAllocationStore.ResourceStore)
 
 	go func() {
 		wg.Wait()
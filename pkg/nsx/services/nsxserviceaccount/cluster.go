@@ -661,6 +661,18 @@ func (s *NSXServiceAccountService) UpdateProxyEndpointsIfNeeded(ctx context.Cont
 	return nil
 }
 
+// PlanCleanupBeforeVPCDeletion returns the paths of the ClusterControlPlanes which CleanupBeforeVPCDeletion would delete.
+func (s *NSXServiceAccountService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	paths := make([]string, 0)
+	for _, obj := range s.ClusterControlPlaneStore.List() {
+		ccp := obj.(*model.ClusterControlPlane)
+		paths = append(paths, fmt.Sprintf("/infra/sites/%s/enforcement-points/%s/cluster-control-planes/%s", siteId, enforcementpointId, *ccp.Id))
+	}
+	return map[string][]string{
+		common.ResourceTypeClusterControlPlane: paths,
+	}, nil
+}
+
 func (s *NSXServiceAccountService) CleanupBeforeVPCDeletion(ctx context.Context) error {
 	ccpList := s.ClusterControlPlaneStore.List()
 	log.Info("Starting cluster control plane cleanup", "count", len(ccpList), "status", "attempting")
@@ -0,0 +1,364 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// securityPolicyCategoryEnvironment is the DFW category of the NSX SecurityPolicies created for AdminNetworkPolicies.
+const securityPolicyCategoryEnvironment = "Environment"
+
+// adminNetworkPolicyRule is the common form of the AdminNetworkPolicy and BaselineAdminNetworkPolicy rules, the
+// ingress peers are stored in the form of the egress peers which is a superset of them.
+type adminNetworkPolicyRule struct {
+	name      string
+	action    v1alpha1.RuleAction
+	direction v1alpha1.RuleDirection
+	peers     []policyv1alpha1.AdminNetworkPolicyEgressPeer
+	ports     *[]policyv1alpha1.AdminNetworkPolicyPort
+}
+
+// adminNetworkPolicy is the common form of the AdminNetworkPolicy and BaselineAdminNetworkPolicy.
+type adminNetworkPolicy struct {
+	metav1.ObjectMeta
+	createdFor string
+	priority   int
+	subject    policyv1alpha1.AdminNetworkPolicySubject
+	rules      []adminNetworkPolicyRule
+}
+
+func newAdminNetworkPolicy(anp *policyv1alpha1.AdminNetworkPolicy) (*adminNetworkPolicy, error) {
+	policy := &adminNetworkPolicy{
+		ObjectMeta: anp.ObjectMeta,
+		createdFor: common.ResourceTypeAdminNetworkPolicy,
+		priority:   int(anp.Spec.Priority),
+		subject:    anp.Spec.Subject,
+	}
+	for _, ingress := range anp.Spec.Ingress {
+		action, err := convertAdminNetworkPolicyRuleAction(string(ingress.Action))
+		if err != nil {
+			return nil, err
+		}
+		rule := adminNetworkPolicyRule{name: ingress.Name, action: action, direction: v1alpha1.RuleDirectionIn, ports: ingress.Ports}
+		for _, from := range ingress.From {
+			rule.peers = append(rule.peers, policyv1alpha1.AdminNetworkPolicyEgressPeer{Namespaces: from.Namespaces, Pods: from.Pods})
+		}
+		policy.rules = append(policy.rules, rule)
+	}
+	for _, egress := range anp.Spec.Egress {
+		action, err := convertAdminNetworkPolicyRuleAction(string(egress.Action))
+		if err != nil {
+			return nil, err
+		}
+		policy.rules = append(policy.rules, adminNetworkPolicyRule{name: egress.Name, action: action, direction: v1alpha1.RuleDirectionOut, peers: egress.To, ports: egress.Ports})
+	}
+	return policy, nil
+}
+
+func newBaselineAdminNetworkPolicy(banp *policyv1alpha1.BaselineAdminNetworkPolicy) (*adminNetworkPolicy, error) {
+	policy := &adminNetworkPolicy{
+		ObjectMeta: banp.ObjectMeta,
+		createdFor: common.ResourceTypeBaselineAdminNetworkPolicy,
+		priority:   common.PriorityBaselineAdminNetworkPolicy,
+		subject:    banp.Spec.Subject,
+	}
+	for _, ingress := range banp.Spec.Ingress {
+		action, err := convertAdminNetworkPolicyRuleAction(string(ingress.Action))
+		if err != nil {
+			return nil, err
+		}
+		rule := adminNetworkPolicyRule{name: ingress.Name, action: action, direction: v1alpha1.RuleDirectionIn, ports: ingress.Ports}
+		for _, from := range ingress.From {
+			rule.peers = append(rule.peers, policyv1alpha1.AdminNetworkPolicyEgressPeer{Namespaces: from.Namespaces, Pods: from.Pods})
+		}
+		policy.rules = append(policy.rules, rule)
+	}
+	for _, egress := range banp.Spec.Egress {
+		action, err := convertAdminNetworkPolicyRuleAction(string(egress.Action))
+		if err != nil {
+			return nil, err
+		}
+		rule := adminNetworkPolicyRule{name: egress.Name, action: action, direction: v1alpha1.RuleDirectionOut, ports: egress.Ports}
+		for _, to := range egress.To {
+			rule.peers = append(rule.peers, policyv1alpha1.AdminNetworkPolicyEgressPeer{
+				Namespaces: to.Namespaces,
+				Pods:       to.Pods,
+				Nodes:      to.Nodes,
+				Networks:   to.Networks,
+			})
+		}
+		policy.rules = append(policy.rules, rule)
+	}
+	return policy, nil
+}
+
+// convertAdminNetworkPolicyRuleAction converts the rule action, the Pass action is only valid in AdminNetworkPolicies,
+// which is ensured by the CRD validation of BaselineAdminNetworkPolicies.
+func convertAdminNetworkPolicyRuleAction(action string) (v1alpha1.RuleAction, error) {
+	switch action {
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionAllow):
+		return v1alpha1.RuleActionAllow, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionDeny):
+		return v1alpha1.RuleActionDrop, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionPass):
+		return ruleActionJumpToApplication, nil
+	default:
+		return "", &nsxutil.ValidationError{Desc: fmt.Sprintf("unsupported AdminNetworkPolicy rule action %s", action)}
+	}
+}
+
+func (service *SecurityPolicyService) convertAdminNetworkPolicyPeer(peer *policyv1alpha1.AdminNetworkPolicyEgressPeer) (*v1alpha1.SecurityPolicyPeer, error) {
+	switch {
+	case peer.Namespaces != nil:
+		return &v1alpha1.SecurityPolicyPeer{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{},
+			},
+			NamespaceSelector: peer.Namespaces,
+		}, nil
+	case peer.Pods != nil:
+		return &v1alpha1.SecurityPolicyPeer{
+			PodSelector:       &peer.Pods.PodSelector,
+			NamespaceSelector: &peer.Pods.NamespaceSelector,
+		}, nil
+	case len(peer.Networks) > 0:
+		spPeer := &v1alpha1.SecurityPolicyPeer{}
+		for _, network := range peer.Networks {
+			spPeer.IPBlocks = append(spPeer.IPBlocks, v1alpha1.IPBlock{CIDR: string(network)})
+		}
+		return spPeer, nil
+	case len(peer.DomainNames) > 0:
+		spPeer := &v1alpha1.SecurityPolicyPeer{}
+		for _, domainName := range peer.DomainNames {
+			spPeer.FQDNs = append(spPeer.FQDNs, string(domainName))
+		}
+		return spPeer, nil
+	case peer.Nodes != nil:
+		return nil, &nsxutil.ValidationError{Desc: "nodes peer of AdminNetworkPolicy is not supported"}
+	}
+	return nil, &nsxutil.ValidationError{Desc: "empty AdminNetworkPolicy peer"}
+}
+
+func (service *SecurityPolicyService) convertAdminNetworkPolicyPort(port *policyv1alpha1.AdminNetworkPolicyPort) (*v1alpha1.SecurityPolicyPort, error) {
+	switch {
+	case port.PortNumber != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Protocol: port.PortNumber.Protocol,
+			Port:     intstr.FromInt32(port.PortNumber.Port),
+		}, nil
+	case port.NamedPort != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Port: intstr.FromString(*port.NamedPort),
+		}, nil
+	case port.PortRange != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Protocol: port.PortRange.Protocol,
+			Port:     intstr.FromInt32(port.PortRange.Start),
+			EndPort:  int(port.PortRange.End),
+		}, nil
+	}
+	return nil, &nsxutil.ValidationError{Desc: "empty AdminNetworkPolicy port"}
+}
+
+func (service *SecurityPolicyService) convertAdminNetworkPolicyRule(rule *adminNetworkPolicyRule) (*v1alpha1.SecurityPolicyRule, error) {
+	action := rule.action
+	direction := rule.direction
+	spRule := &v1alpha1.SecurityPolicyRule{
+		Action:    &action,
+		Direction: &direction,
+		Name:      rule.name,
+	}
+	for i := range rule.peers {
+		spPeer, err := service.convertAdminNetworkPolicyPeer(&rule.peers[i])
+		if err != nil {
+			return nil, err
+		}
+		if direction == v1alpha1.RuleDirectionIn {
+			spRule.From = append(spRule.From, *spPeer)
+		} else {
+			spRule.To = append(spRule.To, *spPeer)
+		}
+	}
+	if rule.ports != nil {
+		for i := range *rule.ports {
+			spPort, err := service.convertAdminNetworkPolicyPort(&(*rule.ports)[i])
+			if err != nil {
+				return nil, err
+			}
+			spRule.Ports = append(spRule.Ports, *spPort)
+		}
+	}
+	return spRule, nil
+}
+
+// BuildAdminNetworkPolicyInternalID returns the UID of the internal SecurityPolicy converted from the
+// AdminNetworkPolicy or BaselineAdminNetworkPolicy for the namespace.
+func (service *SecurityPolicyService) BuildAdminNetworkPolicyInternalID(uid, namespace string) string {
+	return strings.Join([]string{uid, namespace}, common.ConnectorUnderline)
+}
+
+// parseAdminNetworkPolicyInternalID returns the AdminNetworkPolicy or BaselineAdminNetworkPolicy UID in the UID of
+// the internal SecurityPolicy.
+func parseAdminNetworkPolicyInternalID(id string) string {
+	uid, _ := parseSuffixInUid(types.UID(id))
+	return uid
+}
+
+// resolveAdminNetworkPolicySubject returns the namespaces with VPC and the pod selector of the subject.
+func (service *SecurityPolicyService) resolveAdminNetworkPolicySubject(subject *policyv1alpha1.AdminNetworkPolicySubject) ([]string, *metav1.LabelSelector, error) {
+	var nsSelector, podSelector *metav1.LabelSelector
+	switch {
+	case subject.Namespaces != nil:
+		nsSelector = subject.Namespaces
+		podSelector = &metav1.LabelSelector{}
+	case subject.Pods != nil:
+		nsSelector = &subject.Pods.NamespaceSelector
+		podSelector = &subject.Pods.PodSelector
+	default:
+		return nil, nil, &nsxutil.ValidationError{Desc: "empty AdminNetworkPolicy subject"}
+	}
+	nsList, err := service.ResolveNamespace(nsSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+	var namespaces []string
+	for _, ns := range nsList.Items {
+		if !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// The namespaces without VPC, e.g. the system namespaces, are not managed by the SecurityPolicy service.
+		if len(service.vpcService.ListVPCInfo(ns.Name)) == 0 {
+			log.Debug("Skip the AdminNetworkPolicy subject namespace without VPC", "namespace", ns.Name)
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+	return namespaces, podSelector, nil
+}
+
+// convertAdminNetworkPolicyToInternalSecurityPolicies converts the policy to one internal SecurityPolicy for each
+// namespace of the subject, since the NSX SecurityPolicies are created in the VPCs of the namespaces.
+func (service *SecurityPolicyService) convertAdminNetworkPolicyToInternalSecurityPolicies(policy *adminNetworkPolicy) ([]*v1alpha1.SecurityPolicy, error) {
+	namespaces, podSelector, err := service.resolveAdminNetworkPolicySubject(&policy.subject)
+	if err != nil {
+		return nil, err
+	}
+	var rules []v1alpha1.SecurityPolicyRule
+	for i := range policy.rules {
+		rule, err := service.convertAdminNetworkPolicyRule(&policy.rules[i])
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+
+	securityPolicies := []*v1alpha1.SecurityPolicy{}
+	for _, ns := range namespaces {
+		securityPolicies = append(securityPolicies, &v1alpha1.SecurityPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      policy.Name,
+				UID:       types.UID(service.BuildAdminNetworkPolicyInternalID(string(policy.UID), ns)),
+			},
+			Spec: v1alpha1.SecurityPolicySpec{
+				Priority: policy.priority,
+				AppliedTo: []v1alpha1.SecurityPolicyTarget{
+					{
+						PodSelector: podSelector.DeepCopy(),
+					},
+				},
+				Rules: append([]v1alpha1.SecurityPolicyRule{}, rules...),
+			},
+		})
+	}
+	log.Debug("Converted AdminNetworkPolicy to security policies", "createdFor", policy.createdFor, "securityPolicies", securityPolicies)
+	return securityPolicies, nil
+}
+
+// listAdminNetworkPolicyInternalIDs returns the UIDs of the internal SecurityPolicies of the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy which have NSX resources.
+func (service *SecurityPolicyService) listAdminNetworkPolicyInternalIDs(uid types.UID) sets.Set[string] {
+	ids := sets.New[string]()
+	for id := range service.getGCSecurityPolicyIDSet(common.TagScopeAdminNetworkPolicyUID) {
+		if parseAdminNetworkPolicyInternalID(id) == string(uid) {
+			ids.Insert(id)
+		}
+	}
+	return ids
+}
+
+func (service *SecurityPolicyService) createOrUpdateAdminNetworkPolicy(policy *adminNetworkPolicy) error {
+	internalSecurityPolicies, err := service.convertAdminNetworkPolicyToInternalSecurityPolicies(policy)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	expectedIDs := sets.New[string]()
+	for _, internalSecurityPolicy := range internalSecurityPolicies {
+		expectedIDs.Insert(string(internalSecurityPolicy.UID))
+		if err := service.createOrUpdateVPCSecurityPolicy(internalSecurityPolicy, policy.createdFor); err != nil {
+			log.Error(err, "Failed to create or update SecurityPolicy for AdminNetworkPolicy", "createdFor", policy.createdFor,
+				"name", policy.Name, "namespace", internalSecurityPolicy.Namespace)
+			errs = append(errs, err)
+		}
+	}
+	// Delete the SecurityPolicies of the namespaces which are no longer selected by the subject.
+	for id := range service.listAdminNetworkPolicyInternalIDs(policy.UID).Difference(expectedIDs) {
+		if err := service.DeleteSecurityPolicy(types.UID(id), false, policy.createdFor); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeleteAdminNetworkPolicy deletes the NSX resources of all the internal SecurityPolicies of the AdminNetworkPolicy
+// or BaselineAdminNetworkPolicy.
+func (service *SecurityPolicyService) DeleteAdminNetworkPolicy(uid types.UID, isGC bool, createdFor string) error {
+	var errs []error
+	for id := range service.listAdminNetworkPolicyInternalIDs(uid) {
+		if err := service.DeleteSecurityPolicy(types.UID(id), isGC, createdFor); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ListAdminNetworkPolicyID returns the UIDs of the AdminNetworkPolicies and BaselineAdminNetworkPolicies which have
+// NSX resources.
+func (service *SecurityPolicyService) ListAdminNetworkPolicyID() sets.Set[string] {
+	uids := sets.New[string]()
+	for id := range service.getGCSecurityPolicyIDSet(common.TagScopeAdminNetworkPolicyUID) {
+		uids.Insert(parseAdminNetworkPolicyInternalID(id))
+	}
+	return uids
+}
+
+// ListAdminNetworkPolicyUIDByName returns the UIDs of the AdminNetworkPolicies or BaselineAdminNetworkPolicies with
+// the name which have NSX SecurityPolicies.
+func (service *SecurityPolicyService) ListAdminNetworkPolicyUIDByName(name, createdFor string) sets.Set[string] {
+	uids := sets.New[string]()
+	scopeOwnerName, scopeOwnerUID := getOwnerTagScopes(createdFor)
+	for _, obj := range service.securityPolicyStore.List() {
+		securityPolicy := obj.(*model.SecurityPolicy)
+		if nsxutil.FindTag(securityPolicy.Tags, scopeOwnerName) == name {
+			uids.Insert(parseAdminNetworkPolicyInternalID(nsxutil.FindTag(securityPolicy.Tags, scopeOwnerUID)))
+		}
+	}
+	return uids
+}
@@ -0,0 +1,176 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func Test_newAdminNetworkPolicy(t *testing.T) {
+	nsSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	anp := &policyv1alpha1.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "anp-uid"},
+		Spec: policyv1alpha1.AdminNetworkPolicySpec{
+			Priority: 10,
+			Subject:  policyv1alpha1.AdminNetworkPolicySubject{Namespaces: nsSelector},
+			Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+				{Name: "deny-all", Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny, To: []policyv1alpha1.AdminNetworkPolicyEgressPeer{{Networks: []policyv1alpha1.CIDR{"0.0.0.0/0"}}}},
+			},
+			Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+				{Name: "pass-team", Action: policyv1alpha1.AdminNetworkPolicyRuleActionPass, From: []policyv1alpha1.AdminNetworkPolicyIngressPeer{{Namespaces: nsSelector}}},
+			},
+		},
+	}
+	policy, err := newAdminNetworkPolicy(anp)
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResourceTypeAdminNetworkPolicy, policy.createdFor)
+	assert.Equal(t, 10, policy.priority)
+	assert.Len(t, policy.rules, 2)
+	assert.Equal(t, "pass-team", policy.rules[0].name)
+	assert.Equal(t, ruleActionJumpToApplication, policy.rules[0].action)
+	assert.Equal(t, v1alpha1.RuleDirectionIn, policy.rules[0].direction)
+	assert.Equal(t, nsSelector, policy.rules[0].peers[0].Namespaces)
+	assert.Equal(t, "deny-all", policy.rules[1].name)
+	assert.Equal(t, v1alpha1.RuleActionDrop, policy.rules[1].action)
+	assert.Equal(t, v1alpha1.RuleDirectionOut, policy.rules[1].direction)
+
+	anp.Spec.Egress[0].Action = "Unknown"
+	_, err = newAdminNetworkPolicy(anp)
+	assert.IsType(t, &nsxutil.ValidationError{}, err)
+}
+
+func Test_newBaselineAdminNetworkPolicy(t *testing.T) {
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "banp-uid"},
+		Spec: policyv1alpha1.BaselineAdminNetworkPolicySpec{
+			Subject: policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}},
+			Egress: []policyv1alpha1.BaselineAdminNetworkPolicyEgressRule{
+				{Name: "allow-dns", Action: policyv1alpha1.BaselineAdminNetworkPolicyRuleActionAllow, To: []policyv1alpha1.BaselineAdminNetworkPolicyEgressPeer{{Networks: []policyv1alpha1.CIDR{"10.0.0.10/32"}}}},
+			},
+		},
+	}
+	policy, err := newBaselineAdminNetworkPolicy(banp)
+	assert.NoError(t, err)
+	assert.Equal(t, common.ResourceTypeBaselineAdminNetworkPolicy, policy.createdFor)
+	assert.Equal(t, common.PriorityBaselineAdminNetworkPolicy, policy.priority)
+	assert.Len(t, policy.rules, 1)
+	assert.Equal(t, v1alpha1.RuleActionAllow, policy.rules[0].action)
+	assert.Equal(t, []policyv1alpha1.CIDR{"10.0.0.10/32"}, policy.rules[0].peers[0].Networks)
+}
+
+func Test_convertAdminNetworkPolicyPeer(t *testing.T) {
+	s := &SecurityPolicyService{}
+	nsSelector := metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+
+	tests := []struct {
+		name          string
+		peer          policyv1alpha1.AdminNetworkPolicyEgressPeer
+		expected      *v1alpha1.SecurityPolicyPeer
+		expectedError string
+	}{
+		{
+			name:     "namespaces",
+			peer:     policyv1alpha1.AdminNetworkPolicyEgressPeer{Namespaces: &nsSelector},
+			expected: &v1alpha1.SecurityPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{}}, NamespaceSelector: &nsSelector},
+		},
+		{
+			name:     "pods",
+			peer:     policyv1alpha1.AdminNetworkPolicyEgressPeer{Pods: &policyv1alpha1.NamespacedPod{NamespaceSelector: nsSelector, PodSelector: podSelector}},
+			expected: &v1alpha1.SecurityPolicyPeer{PodSelector: &podSelector, NamespaceSelector: &nsSelector},
+		},
+		{
+			name:     "networks",
+			peer:     policyv1alpha1.AdminNetworkPolicyEgressPeer{Networks: []policyv1alpha1.CIDR{"10.0.0.0/24", "2001:db8::/64"}},
+			expected: &v1alpha1.SecurityPolicyPeer{IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}, {CIDR: "2001:db8::/64"}}},
+		},
+		{
+			name:     "domain names",
+			peer:     policyv1alpha1.AdminNetworkPolicyEgressPeer{DomainNames: []policyv1alpha1.DomainName{"*.example.com"}},
+			expected: &v1alpha1.SecurityPolicyPeer{FQDNs: []string{"*.example.com"}},
+		},
+		{
+			name:          "nodes",
+			peer:          policyv1alpha1.AdminNetworkPolicyEgressPeer{Nodes: &metav1.LabelSelector{}},
+			expectedError: "nodes peer of AdminNetworkPolicy is not supported",
+		},
+		{
+			name:          "empty",
+			peer:          policyv1alpha1.AdminNetworkPolicyEgressPeer{},
+			expectedError: "empty AdminNetworkPolicy peer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peer, err := s.convertAdminNetworkPolicyPeer(&tt.peer)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, peer)
+		})
+	}
+}
+
+func Test_convertAdminNetworkPolicyRule(t *testing.T) {
+	s := &SecurityPolicyService{}
+	namedPort := "http"
+	rule := &adminNetworkPolicyRule{
+		name:      "allow-web",
+		action:    v1alpha1.RuleActionAllow,
+		direction: v1alpha1.RuleDirectionOut,
+		peers:     []policyv1alpha1.AdminNetworkPolicyEgressPeer{{Networks: []policyv1alpha1.CIDR{"10.0.0.0/24"}}},
+		ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+			{PortNumber: &policyv1alpha1.Port{Protocol: corev1.ProtocolTCP, Port: 443}},
+			{NamedPort: &namedPort},
+			{PortRange: &policyv1alpha1.PortRange{Protocol: corev1.ProtocolUDP, Start: 8000, End: 8080}},
+		},
+	}
+	spRule, err := s.convertAdminNetworkPolicyRule(rule)
+	assert.NoError(t, err)
+	assert.Equal(t, "allow-web", spRule.Name)
+	assert.Equal(t, v1alpha1.RuleActionAllow, *spRule.Action)
+	assert.Equal(t, v1alpha1.RuleDirectionOut, *spRule.Direction)
+	assert.Empty(t, spRule.From)
+	assert.Equal(t, []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}, spRule.To[0].IPBlocks)
+	assert.Equal(t, []v1alpha1.SecurityPolicyPort{
+		{Protocol: corev1.ProtocolTCP, Port: intstr.FromInt32(443)},
+		{Port: intstr.FromString("http")},
+		{Protocol: corev1.ProtocolUDP, Port: intstr.FromInt32(8000), EndPort: 8080},
+	}, spRule.Ports)
+
+	rule.ports = &[]policyv1alpha1.AdminNetworkPolicyPort{{}}
+	_, err = s.convertAdminNetworkPolicyRule(rule)
+	assert.ErrorContains(t, err, "empty AdminNetworkPolicy port")
+}
+
+func Test_AdminNetworkPolicyInternalID(t *testing.T) {
+	s := &SecurityPolicyService{}
+	id := s.BuildAdminNetworkPolicyInternalID("anp-uid", "ns1")
+	assert.Equal(t, "anp-uid_ns1", id)
+	assert.Equal(t, "anp-uid", parseAdminNetworkPolicyInternalID(id))
+}
+
+func Test_getOwnerTagScopes(t *testing.T) {
+	nameScope, uidScope := getOwnerTagScopes(common.ResourceTypeAdminNetworkPolicy)
+	assert.Equal(t, common.TagScopeAdminNetworkPolicyName, nameScope)
+	assert.Equal(t, common.TagScopeAdminNetworkPolicyUID, uidScope)
+	nameScope, uidScope = getOwnerTagScopes(common.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.Equal(t, common.TagScopeBaselineAdminNetworkPolicyName, nameScope)
+	assert.Equal(t, common.TagScopeAdminNetworkPolicyUID, uidScope)
+	nameScope, uidScope = getOwnerTagScopes(common.ResourceTypeNetworkPolicy)
+	assert.Equal(t, common.TagScopeNetworkPolicyName, nameScope)
+	assert.Equal(t, common.TagScopeNetworkPolicyUID, uidScope)
+}
@@ -39,6 +39,7 @@ const (
 var (
 	String = common.String
 	Int64  = common.Int64
+	Bool   = common.Bool
 )
 
 type GroupScope int
@@ -54,10 +55,7 @@ func (service *SecurityPolicyService) buildSecurityPolicyName(obj *v1alpha1.Secu
 }
 
 func (service *SecurityPolicyService) buildSecurityPolicyIDAndName(obj *v1alpha1.SecurityPolicy, createdFor string) (string, string) {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	_, indexScope := getOwnerTagScopes(createdFor)
 	existingSecurityPolicies := service.securityPolicyStore.GetByIndex(indexScope, string(obj.GetUID()))
 	if len(existingSecurityPolicies) > 0 {
 		policy := existingSecurityPolicies[0]
@@ -125,6 +123,11 @@ func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.Security
 	nsxSecurityPolicy.DisplayName = String(policyName)
 	// TODO: confirm the sequence number: offset
 	nsxSecurityPolicy.SequenceNumber = Int64(int64(obj.Spec.Priority))
+	if createdFor == common.ResourceTypeAdminNetworkPolicy {
+		// AdminNetworkPolicies are enforced in the Environment category, which is evaluated before the Application
+		// category of the NetworkPolicies, SecurityPolicies and BaselineAdminNetworkPolicies.
+		nsxSecurityPolicy.Category = String(securityPolicyCategoryEnvironment)
+	}
 
 	policyGroup, policyGroupPath, err := service.buildPolicyGroup(obj, createdFor, vpcInfo)
 	if err != nil {
@@ -289,12 +292,7 @@ func (service *SecurityPolicyService) buildTargetTags(obj *v1alpha1.SecurityPoli
 }
 
 func (service *SecurityPolicyService) buildBasicTags(obj *v1alpha1.SecurityPolicy, createdFor string) []model.Tag {
-	scopeOwnerName := common.TagValueScopeSecurityPolicyName
-	scopeOwnerUID := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		scopeOwnerName = common.TagScopeNetworkPolicyName
-		scopeOwnerUID = common.TagScopeNetworkPolicyUID
-	}
+	scopeOwnerName, scopeOwnerUID := getOwnerTagScopes(createdFor)
 
 	tags := util.BuildBasicTags(getCluster(service), obj, service.Service.GetNamespaceUID(obj.ObjectMeta.Namespace))
 	tags = append(tags, []model.Tag{
@@ -479,6 +477,9 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if err := service.validateRuleFQDNs(rule, ruleDirection); err != nil {
+		return nil, nil, nil, err
+	}
 
 	// Since a named port may map to multiple port numbers, then it would return multiple rules.
 	// We use the destination port number of service entry to group the rules.
@@ -489,6 +490,16 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 	}
 	ruleGroups = append(ruleGroups, ipSetGroups...)
 
+	// The FQDNs are matched by the context profile of the rule instead of the destination group,
+	// so the destination of the rule is ANY.
+	fqdns := getRuleFQDNs(rule)
+	if len(fqdns) > 0 {
+		ruleWithoutFQDNs := *rule
+		ruleWithoutFQDNs.To = nil
+		ruleWithoutFQDNs.Destinations = nil //nolint:staticcheck
+		rule = &ruleWithoutFQDNs
+	}
+
 	for _, nsxRule := range nsxRules {
 		switch ruleDirection {
 		case "IN":
@@ -527,6 +538,15 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 		ruleGroups = append(ruleGroups, nsxRuleAppliedGroup)
 		nsxRule.Scope = []string{nsxRuleAppliedGroupPath}
 	}
+
+	if len(fqdns) > 0 && len(nsxRules) > 0 {
+		profileID := service.buildFQDNContextProfileID(ruleBaseID)
+		profilePath := service.buildFQDNContextProfilePath(profileID, vpcInfo, isDefaultProject)
+		for _, nsxRule := range nsxRules {
+			nsxRule.Profiles = []string{profilePath}
+		}
+		nsxRules = append(nsxRules, service.buildDNSSnoopingRule(nsxRules[0], ruleBaseID))
+	}
 	return nsxRules, ruleGroups, nsxGroupShares, nil
 }
 
@@ -666,7 +686,7 @@ func (service *SecurityPolicyService) buildExpandedRuleID(obj *v1alpha1.Security
 	if IsVPCEnabled(service) {
 		portNumberSuffix := ""
 		if namedPort != nil {
-			portNumberSuffix = service.buildRulePortNumberString(namedPort.port)
+			portNumberSuffix = service.buildRulePortsNumberString(namedPort.servicePorts())
 		} else {
 			portNumberSuffix = service.buildRulePortsNumberString(obj.Spec.Rules[ruleIdx].Ports)
 		}
@@ -691,7 +711,7 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 	if err != nil {
 		return "", err
 	}
-	ruleAction, err := getRuleAction(rule)
+	ruleAction, err := getRuleAction(rule, createdFor)
 	if err != nil {
 		return "", err
 	}
@@ -703,6 +723,8 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 		ruleAct = common.RuleActionDrop
 	case util.ToUpper(v1alpha1.RuleActionReject):
 		ruleAct = common.RuleActionReject
+	case util.ToUpper(ruleActionJumpToApplication):
+		ruleAct = common.RuleActionPass
 	}
 	ruleDir := common.RuleEgress
 	if ruleDirection == "IN" {
@@ -733,7 +755,7 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 	// expand to NSX security policy rules with name TCP.http_UDP.1234.TCP.80_ingress_allow and TCP.http_UDP.1234.UDP.1234_ingress_allow.
 	// in case that user defined input security policy's rule name: sp_namedport_rule,
 	// expand to NSX security policy rules with name sp_namedport_rule.TCP.80_ingress_allow and sp_namedport_rule.UDP.1234_ingress_allow.
-	return util.GenerateTruncName(common.MaxNameLength, ruleName+"."+service.buildRulePortString(namedPortInfo.port), "", suffix, "", ""), nil
+	return util.GenerateTruncName(common.MaxNameLength, ruleName+"."+service.buildRulePortsString(namedPortInfo.servicePorts()), "", suffix, "", ""), nil
 }
 
 func (service *SecurityPolicyService) buildRuleAppliedGroupByPolicy(obj *v1alpha1.SecurityPolicy, nsxRuleSrcGroupPath string, nsxRuleDstGroupPath string, policyAppliedGroupPath string) (string, error) {
@@ -1034,7 +1056,7 @@ func (service *SecurityPolicyService) dedupBlocks(rulePeers []v1alpha1.SecurityP
 func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule, ruleIdx int,
 	ruleBaseID, createdFor string, namedPortInfo *portInfo,
 ) (*model.Rule, error) {
-	ruleAction, err := getRuleAction(rule)
+	ruleAction, err := getRuleAction(rule, createdFor)
 	if err != nil {
 		return nil, err
 	}
@@ -1063,6 +1085,10 @@ func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityP
 			}...)
 	}
 
+	for _, tag := range rule.Tags {
+		basicTags = append(basicTags, model.Tag{Scope: String(tag.Scope), Tag: String(tag.Tag)})
+	}
+
 	nsxRule := model.Rule{
 		Id:             String(service.buildExpandedRuleID(obj, ruleIdx, ruleBaseID, namedPortInfo)),
 		DisplayName:    &displayName,
@@ -1072,6 +1098,12 @@ func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityP
 		Services:       []string{"ANY"},
 		Tags:           basicTags,
 	}
+	if rule.Logged {
+		nsxRule.Logged = Bool(true)
+	}
+	if rule.LogLabel != "" {
+		nsxRule.Tag = String(rule.LogLabel)
+	}
 	log.Debug("Built rule basic info", "ruleBaseID", ruleBaseID, "nsxRule", nsxRule)
 	return &nsxRule, nil
 }
@@ -2059,10 +2091,7 @@ func (service *SecurityPolicyService) getPolicyAppliedGroupByCRUID(indexScope, u
 }
 
 func (service *SecurityPolicyService) getAppliedGroupByRuleID(createdFor, uid string, ruleID string) *model.Group {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	_, indexScope := getOwnerTagScopes(createdFor)
 
 	if ruleID == "" {
 		return service.getPolicyAppliedGroupByCRUID(indexScope, uid)
@@ -2118,8 +2147,11 @@ func (service *SecurityPolicyService) getPeerGroupByRuleID(ruleID string, isSour
 func (service *SecurityPolicyService) getRuleIDByUUIDAndRuleHash(uuid types.UID, ruleHash string, createdFor string) *string {
 	var rules []*model.Rule
 	indexKey := SPIndexByUUIDAndRuleHashFuncKey
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy:
 		indexKey = NPIndexByUUIDAndRuleHashFuncKey
+	case common.ResourceTypeAdminNetworkPolicy, common.ResourceTypeBaselineAdminNetworkPolicy:
+		indexKey = ANPIndexByUUIDAndRuleHashFuncKey
 	}
 
 	rules = service.ruleStore.GetByIndexUUIDAndHash(indexKey, string(uuid), ruleHash)
@@ -2139,6 +2171,9 @@ func (service *SecurityPolicyService) getRuleIDByUUIDAndRuleHash(uuid types.UID,
 type portInfo struct {
 	port v1alpha1.SecurityPolicyPort
 	ips  []string
+	// ports is set instead of port with named port rule grouping, it is the port numbers and ranges shared by the
+	// pods of ips.
+	ports []v1alpha1.SecurityPolicyPort
 
 	// idSuffix is used in T1 environment to generate the NSX rule ID. It is constructed by
 	// SecurityPolicyPortIdx_PortAddressIdx.
@@ -2146,6 +2181,14 @@ type portInfo struct {
 	idSuffix string
 }
 
+// servicePorts returns the ports of the NSX rule service entries.
+func (p *portInfo) servicePorts() []v1alpha1.SecurityPolicyPort {
+	if len(p.ports) > 0 {
+		return p.ports
+	}
+	return []v1alpha1.SecurityPolicyPort{p.port}
+}
+
 func newPortInfo(port v1alpha1.SecurityPolicyPort) *portInfo {
 	return &portInfo{
 		port:     port,
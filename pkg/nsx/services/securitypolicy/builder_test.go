@@ -1420,6 +1420,68 @@ func Test_BuildExpandedRuleID(t *testing.T) {
 	}
 }
 
+func Test_BuildRuleBasicInfoLoggingAndTags(t *testing.T) {
+	config.SetMixedModeStateForTest(true, false)
+	svc := &SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "cluster1",
+				},
+			},
+		},
+	}
+	svc.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&svc.Service), "GetNamespaceUID",
+		func(s *common.Service, ns string) types.UID {
+			return types.UID(tagValueNSUID)
+		})
+	defer patches.Reset()
+
+	tests := []struct {
+		name             string
+		logged           bool
+		logLabel         string
+		tags             []v1alpha1.RuleTag
+		expectedLogged   *bool
+		expectedLogLabel *string
+		expectedTags     []model.Tag
+	}{
+		{
+			name: "rule-without-logging-and-tags",
+		},
+		{
+			name:             "rule-with-logging-and-tags",
+			logged:           true,
+			logLabel:         "web-allow",
+			expectedLogged:   common.Bool(true),
+			tags:             []v1alpha1.RuleTag{{Scope: "team", Tag: "web"}, {Tag: "audit"}},
+			expectedLogLabel: common.String("web-allow"),
+			expectedTags: []model.Tag{
+				{Scope: common.String("team"), Tag: common.String("web")},
+				{Scope: common.String(""), Tag: common.String("audit")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := securityPolicyWithMultipleNormalPorts.DeepCopy()
+			rule := &sp.Spec.Rules[0]
+			rule.Logged = tt.logged
+			rule.LogLabel = tt.logLabel
+			rule.Tags = tt.tags
+			ruleBaseID := svc.buildRuleID(sp, 0, common.ResourceTypeSecurityPolicy)
+			nsxRule, err := svc.buildRuleBasicInfo(sp, rule, 0, ruleBaseID, common.ResourceTypeSecurityPolicy, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLogged, nsxRule.Logged)
+			assert.Equal(t, tt.expectedLogLabel, nsxRule.Tag)
+			basicTags := svc.buildBasicTags(sp, common.ResourceTypeSecurityPolicy)
+			assert.Equal(t, append(basicTags, tt.expectedTags...), nsxRule.Tags)
+		})
+	}
+}
+
 func Test_BuildSecurityPolicyIDAndName(t *testing.T) {
 	svc := &SecurityPolicyService{
 		Service: common.Service{
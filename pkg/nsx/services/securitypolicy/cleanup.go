@@ -9,10 +9,11 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
-// CleanupBeforeVPCDeletion cleans up SecurityPolicy, Rules, and Shares before VPC deletion to avoid dependency issues.
-// SecurityPolicy and Rules must be deleted first because Shares cannot be deleted while they are still being consumed.
+// CleanupBeforeVPCDeletion cleans up SecurityPolicy, Rules, FQDN context profiles and Shares before VPC deletion to
+// avoid dependency issues. SecurityPolicy and Rules must be deleted first because the context profiles and Shares
+// cannot be deleted while they are still being consumed.
 func (service *SecurityPolicyService) CleanupBeforeVPCDeletion(ctx context.Context) error {
-	log.Info("Cleaning up security policies, rules, and shares before VPC deletion")
+	log.Info("Cleaning up security policies, rules, context profiles and shares before VPC deletion")
 
 	if err := service.cleanupRulesByVPC(ctx, ""); err != nil {
 		log.Error(err, "Failed to clean up rules")
@@ -26,7 +27,13 @@ func (service *SecurityPolicyService) CleanupBeforeVPCDeletion(ctx context.Conte
 	}
 	log.Info("Successfully cleaned all security policies")
 
-	// Step 3: Clean both project and infra shares
+	if err := service.cleanupContextProfiles(); err != nil {
+		log.Error(err, "Failed to clean up context profiles")
+		return err
+	}
+	log.Info("Successfully cleaned all context profiles")
+
+	// Step 4: Clean both project and infra shares
 	for _, config := range []struct {
 		store   *ShareStore
 		builder *common.PolicyTreeBuilder[*model.Share]
@@ -42,7 +49,7 @@ func (service *SecurityPolicyService) CleanupBeforeVPCDeletion(ctx context.Conte
 			name:    "infra",
 		},
 	} {
-		if err := cleanShares(ctx, config.store, config.builder, service.NSXClient); err != nil {
+		if err := cleanShares(ctx, config.store, config.builder, service.NSXClient, service.CleanupFilter); err != nil {
 			log.Error(err, "Failed to clean shares", "type", config.name)
 			return err
 		}
@@ -80,7 +87,7 @@ func (service *SecurityPolicyService) cleanupSecurityPoliciesByVPC(ctx context.C
 
 	securityPolicies := make([]*model.SecurityPolicy, 0)
 	// Mark the resources for delete.
-	for _, obj := range service.securityPolicyStore.List() {
+	for _, obj := range service.CleanupFilter.Filter(service.securityPolicyStore.List()) {
 		sp := obj.(*model.SecurityPolicy)
 		sp.MarkedForDelete = &MarkedForDelete
 		securityPolicies = append(securityPolicies, sp)
@@ -108,7 +115,7 @@ func (service *SecurityPolicyService) cleanupRulesByVPC(ctx context.Context, vpc
 
 	rules := make([]*model.Rule, 0)
 	// Mark the resources for delete.
-	for _, obj := range service.ruleStore.List() {
+	for _, obj := range service.CleanupFilter.Filter(service.ruleStore.List()) {
 		rule := obj.(*model.Rule)
 		rule.MarkedForDelete = &MarkedForDelete
 		rules = append(rules, rule)
@@ -119,6 +126,23 @@ func (service *SecurityPolicyService) cleanupRulesByVPC(ctx context.Context, vpc
 	})
 }
 
+// cleanupContextProfiles is deleting all the cached NSX FQDN context profiles on NSX and in local cache. The profiles
+// are under the infra or the project infra, so they are not removed when VPC is deleted recursively.
+func (service *SecurityPolicyService) cleanupContextProfiles() error {
+	cachedObjs := service.CleanupFilter.Filter(service.contextProfileStore.List())
+	if len(cachedObjs) == 0 {
+		return nil
+	}
+	log.Info("Cleaning up context profiles", "Count", len(cachedObjs))
+	profiles := make([]model.PolicyContextProfile, 0, len(cachedObjs))
+	for _, obj := range cachedObjs {
+		profile := *obj.(*model.PolicyContextProfile)
+		profile.MarkedForDelete = &MarkedForDelete
+		profiles = append(profiles, profile)
+	}
+	return service.applyFQDNContextProfiles(profiles)
+}
+
 // cleanupGroupsByVPC is deleting all the NSX groups in the given vpcPath on NSX and/or in local cache.
 // If vpcPath is not empty, the function is called with auto-created VPC case, so it only deletes in the local cache for
 // the NSX resources are already removed when VPC is deleted recursively. Otherwise, it should delete all cached groups
@@ -134,7 +158,7 @@ func (service *SecurityPolicyService) cleanupGroupsByVPC(ctx context.Context, vp
 		return nil
 	}
 
-	return cleanGroups(ctx, service.groupStore, service.groupBuilder, service.NSXClient)
+	return cleanGroups(ctx, service.groupStore, service.groupBuilder, service.NSXClient, service.CleanupFilter)
 }
 
 // CleanupInfraResources is to clean up the resources created by SecurityPolicyService under path /infra.
@@ -151,15 +175,47 @@ func (service *SecurityPolicyService) CleanupInfraResources(ctx context.Context)
 			builder: service.infraGroupBuilder,
 		},
 	} {
-		if err := cleanGroups(ctx, config.store, config.builder, service.NSXClient); err != nil {
+		if err := cleanGroups(ctx, config.store, config.builder, service.NSXClient, service.CleanupFilter); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func cleanShares(ctx context.Context, store *ShareStore, builder *common.PolicyTreeBuilder[*model.Share], nsxClient *nsx.Client) error {
-	cachedObjs := store.List()
+// PlanCleanupBeforeVPCDeletion returns the paths of the NSX rules, security policies, context profiles and shares which
+// CleanupBeforeVPCDeletion would delete.
+func (service *SecurityPolicyService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	shares := append(service.projectShareStore.List(), service.infraShareStore.List()...)
+	return map[string][]string{
+		common.ResourceTypeRule:           common.ListNSXResourcePaths(service.CleanupFilter.Filter(service.ruleStore.List())),
+		common.ResourceTypeSecurityPolicy: common.ListNSXResourcePaths(service.CleanupFilter.Filter(service.securityPolicyStore.List())),
+		common.ResourceTypeContextProfile: common.ListNSXResourcePaths(service.CleanupFilter.Filter(service.contextProfileStore.List())),
+		common.ResourceTypeShare:          common.ListNSXResourcePaths(service.CleanupFilter.Filter(shares)),
+	}, nil
+}
+
+// PlanCleanupVPCChildResources returns the paths of the NSX groups which CleanupVPCChildResources would delete with the
+// given vpcPath.
+func (service *SecurityPolicyService) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	groups := service.CleanupFilter.Filter(service.groupStore.List())
+	if vpcPath != "" {
+		groups = service.groupStore.GetByIndex(common.IndexByVPCPathFuncKey, vpcPath)
+	}
+	return map[string][]string{
+		common.ResourceTypeGroup: common.ListNSXResourcePaths(groups),
+	}, nil
+}
+
+// PlanCleanupInfraResources returns the paths of the project and infra NSX groups which CleanupInfraResources would delete.
+func (service *SecurityPolicyService) PlanCleanupInfraResources(_ context.Context) (map[string][]string, error) {
+	groups := append(service.projectGroupStore.List(), service.infraGroupStore.List()...)
+	return map[string][]string{
+		common.ResourceTypeGroup: common.ListNSXResourcePaths(service.CleanupFilter.Filter(groups)),
+	}, nil
+}
+
+func cleanShares(ctx context.Context, store *ShareStore, builder *common.PolicyTreeBuilder[*model.Share], nsxClient *nsx.Client, filter *common.CleanupFilter) error {
+	cachedObjs := filter.Filter(store.List())
 	if len(cachedObjs) == 0 {
 		return nil
 	}
@@ -176,8 +232,8 @@ func cleanShares(ctx context.Context, store *ShareStore, builder *common.PolicyT
 	})
 }
 
-func cleanGroups(ctx context.Context, store *GroupStore, builder *common.PolicyTreeBuilder[*model.Group], nsxClient *nsx.Client) error {
-	cachedObjs := store.List()
+func cleanGroups(ctx context.Context, store *GroupStore, builder *common.PolicyTreeBuilder[*model.Group], nsxClient *nsx.Client, filter *common.CleanupFilter) error {
+	cachedObjs := filter.Filter(store.List())
 	if len(cachedObjs) == 0 {
 		return nil
 	}
@@ -164,6 +164,54 @@ func TestCleanupVPCChildResources(t *testing.T) {
 	}
 }
 
+func TestCleanupBeforeVPCDeletion_ContextProfiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityPolicyPath := fmt.Sprintf("%s/security-policies/%s", vpcPath, vpcSecurityPolicyId)
+	projectProfilePath := fmt.Sprintf("%s/infra/context-profiles/fqdn-0", projectPath)
+	vpcRule := &model.Rule{
+		Id:         String(vpcRuleId),
+		Path:       String(fmt.Sprintf("%s/rules/%s", securityPolicyPath, vpcRuleId)),
+		ParentPath: String(securityPolicyPath),
+		Profiles:   []string{projectProfilePath},
+		Tags:       infraResourceTags,
+	}
+	projectProfile := &model.PolicyContextProfile{
+		Id:   String("fqdn-0"),
+		Path: String(projectProfilePath),
+		Tags: infraResourceTags,
+	}
+	infraProfile := &model.PolicyContextProfile{
+		Id:   String("fqdn-1"),
+		Path: String("/infra/context-profiles/fqdn-1"),
+		Tags: infraResourceTags,
+	}
+
+	orgRootClient := mock_org_root.NewMockOrgRootClient(ctrl)
+	svc := prepareServiceForCleanup(orgRootClient)
+	svc.ruleStore.Add(vpcRule)
+	svc.contextProfileStore.Add(projectProfile)
+	svc.contextProfileStore.Add(infraProfile)
+
+	plan, err := svc.PlanCleanupBeforeVPCDeletion(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{projectProfilePath, "/infra/context-profiles/fqdn-1"}, plan[common.ResourceTypeContextProfile])
+
+	// The rule referring the profile is deleted before the profile.
+	var rulesOnPatch []int
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ model.OrgRoot, _ *bool) error {
+		rulesOnPatch = append(rulesOnPatch, len(svc.ruleStore.List()))
+		return nil
+	}).Times(2)
+
+	err = svc.CleanupBeforeVPCDeletion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, rulesOnPatch)
+	assert.Equal(t, 0, len(svc.ruleStore.List()))
+	assert.Equal(t, 0, len(svc.contextProfileStore.List()))
+}
+
 func prepareServiceForCleanup(orgRootClient *mock_org_root.MockOrgRootClient) *SecurityPolicyService {
 	svc := &SecurityPolicyService{
 		Service: common.Service{
@@ -15,6 +15,7 @@ type (
 	Rule           model.Rule
 	Group          model.Group
 	Share          model.Share
+	ContextProfile model.PolicyContextProfile
 )
 
 type Comparable = common.Comparable
@@ -35,6 +36,10 @@ func (share *Share) Key() string {
 	return *share.Id
 }
 
+func (profile *ContextProfile) Key() string {
+	return *profile.Id
+}
+
 func (sp *SecurityPolicy) Value() data.DataValue {
 	s := &SecurityPolicy{
 		Id:             sp.Id,
@@ -58,8 +63,14 @@ func (rule *Rule) Value() data.DataValue {
 		Action:            rule.Action,
 		Services:          rule.Services,
 		ServiceEntries:    rule.ServiceEntries,
+		Profiles:          rule.Profiles,
 		DestinationGroups: rule.DestinationGroups,
 		SourceGroups:      rule.SourceGroups,
+		Tag:               rule.Tag,
+	}
+	// Logged is only set on the built rules which are logged, while NSX returns false for the others.
+	if rule.Logged != nil && *rule.Logged {
+		r.Logged = rule.Logged
 	}
 	dataValue, _ := ComparableToRule(r).GetDataValue__()
 	return dataValue
@@ -88,6 +99,17 @@ func (share *Share) Value() data.DataValue {
 	return dataValue
 }
 
+func (profile *ContextProfile) Value() data.DataValue {
+	p := &ContextProfile{
+		Id:          profile.Id,
+		DisplayName: profile.DisplayName,
+		Tags:        profile.Tags,
+		Attributes:  profile.Attributes,
+	}
+	dataValue, _ := ComparableToContextProfile(p).GetDataValue__()
+	return dataValue
+}
+
 func SecurityPolicyPtrToComparable(sp *model.SecurityPolicy) Comparable {
 	return (*SecurityPolicy)(sp)
 }
@@ -183,3 +205,31 @@ func ComparableToShares(shares []Comparable) []model.Share {
 func ComparableToShare(share Comparable) *model.Share {
 	return (*model.Share)(share.(*Share))
 }
+
+func ContextProfilesPtrToComparable(profiles []*model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(profiles[i]))
+	}
+	return res
+}
+
+func ContextProfilesToComparable(profiles []model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(&(profiles[i])))
+	}
+	return res
+}
+
+func ComparableToContextProfiles(profiles []Comparable) []model.PolicyContextProfile {
+	res := make([]model.PolicyContextProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		res = append(res, (model.PolicyContextProfile)(*(profile.(*ContextProfile))))
+	}
+	return res
+}
+
+func ComparableToContextProfile(profile Comparable) *model.PolicyContextProfile {
+	return (*model.PolicyContextProfile)(profile.(*ContextProfile))
+}
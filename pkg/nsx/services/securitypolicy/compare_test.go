@@ -149,6 +149,66 @@ func Test_RulesEqual(t *testing.T) {
 			expectedResult1: []model.Rule{},
 			expectedResult2: []model.Rule{},
 		},
+		{
+			name: "rule-with-profiles-changed",
+			inputRule1: []model.Rule{
+				{
+					Id:       &ruleID0,
+					Profiles: []string{"/infra/context-profiles/fqdn-0"},
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id:       &ruleID0,
+					Profiles: []string{"/infra/context-profiles/fqdn-1"},
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id:       &ruleID0,
+					Profiles: []string{"/infra/context-profiles/fqdn-1"},
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-not-logged",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(false),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id: &ruleID0,
+				},
+			},
+			expectedResult1: []model.Rule{},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-logged-changed",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(false),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,183 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// EffectiveResources holds the NSX resources which a SecurityPolicy or NetworkPolicy CR is realized to.
+type EffectiveResources struct {
+	SecurityPolicies []*model.SecurityPolicy
+	Rules            []*model.Rule
+	Groups           []*model.Group
+	Shares           []*model.Share
+}
+
+// ownerUIDIndexFunc returns an index function which indexes the NSX resources by the tag value of indexScope.
+func ownerUIDIndexFunc(indexScope string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		switch o := obj.(type) {
+		case *model.SecurityPolicy:
+			return filterTag(o.Tags, indexScope), nil
+		case *model.Group:
+			return filterTag(o.Tags, indexScope), nil
+		case *model.Rule:
+			return filterTag(o.Tags, indexScope), nil
+		case *model.Share:
+			return filterTag(o.Tags, indexScope), nil
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// effectiveSearch holds the transient stores which the NSX resources searched for a preview or a simulation are
+// loaded to, they are indexed by the owner UID and the rule ID the same way as the SecurityPolicyService stores.
+type effectiveSearch struct {
+	*SecurityPolicyService
+}
+
+func newEffectiveSearch(service common.Service, indexScope string) *effectiveSearch {
+	s := &SecurityPolicyService{Service: service}
+	indexers := func(extra cache.Indexers) cache.Indexers {
+		extra[indexScope] = ownerUIDIndexFunc(indexScope)
+		return extra
+	}
+	s.securityPolicyStore = &SecurityPolicyStore{ResourceStore: common.ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, indexers(cache.Indexers{})),
+		BindingType: model.SecurityPolicyBindingType(),
+	}}
+	s.ruleStore = &RuleStore{ResourceStore: common.ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, indexers(cache.Indexers{common.TagScopeRuleID: indexRuleFunc})),
+		BindingType: model.RuleBindingType(),
+	}}
+	s.groupStore = &GroupStore{ResourceStore: common.ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, indexers(cache.Indexers{common.TagScopeRuleID: indexGroupFunc})),
+		BindingType: model.GroupBindingType(),
+	}}
+	s.projectShareStore = &ShareStore{ResourceStore: common.ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, indexers(cache.Indexers{})),
+		BindingType: model.ShareBindingType(),
+	}}
+	return &effectiveSearch{SecurityPolicyService: s}
+}
+
+// load searches NSX for the SecurityPolicies, Rules, Groups and Shares created by the cluster and tagged with each
+// of the tag sets, and adds them to the stores.
+func (s *effectiveSearch) load(tagSets ...[]model.Tag) error {
+	wg := sync.WaitGroup{}
+	wgDone := make(chan bool)
+	fatalErrors := make(chan error, 4*len(tagSets))
+	for _, tags := range tagSets {
+		wg.Add(4)
+		go s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, tags, s.securityPolicyStore)
+		go s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, tags, s.ruleStore)
+		go s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeGroup, tags, s.groupStore)
+		go s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeShare, tags, s.projectShareStore)
+	}
+	go func() {
+		wg.Wait()
+		close(wgDone)
+	}()
+
+	select {
+	case <-wgDone:
+		break
+	case err := <-fatalErrors:
+		return err
+	}
+	return nil
+}
+
+// SearchEffectiveResources queries NSX for the SecurityPolicies, Rules, Groups and Shares tagged with indexScope
+// and any of the given owner UIDs. It is used by callers that don't run the SecurityPolicy controller and thus
+// have no cached stores, e.g. the EAS server.
+func SearchEffectiveResources(service common.Service, indexScope string, uids ...string) (*EffectiveResources, error) {
+	s := newEffectiveSearch(service, indexScope)
+	var tagSets [][]model.Tag
+	for _, uid := range uids {
+		tagSets = append(tagSets, []model.Tag{{Scope: String(indexScope), Tag: String(uid)}})
+	}
+	if err := s.load(tagSets...); err != nil {
+		return nil, err
+	}
+
+	result := &EffectiveResources{}
+	for _, uid := range uids {
+		result.SecurityPolicies = append(result.SecurityPolicies, s.securityPolicyStore.GetByIndex(indexScope, uid)...)
+		result.Rules = append(result.Rules, s.ruleStore.GetByIndex(indexScope, uid)...)
+		result.Groups = append(result.Groups, s.groupStore.GetByIndex(indexScope, uid)...)
+		result.Shares = append(result.Shares, s.projectShareStore.GetByIndex(indexScope, uid)...)
+	}
+	result.sort()
+	return result, nil
+}
+
+// SearchClusterResources queries NSX for all the SecurityPolicies, Rules, Groups and Shares created by the cluster,
+// including the ones of SecurityPolicies, NetworkPolicies and AdminNetworkPolicies.
+func SearchClusterResources(service common.Service) (*EffectiveResources, error) {
+	s := newEffectiveSearch(service, common.TagScopeSecurityPolicyUID)
+	if err := s.load(nil); err != nil {
+		return nil, err
+	}
+
+	result := &EffectiveResources{}
+	for _, obj := range s.securityPolicyStore.List() {
+		result.SecurityPolicies = append(result.SecurityPolicies, obj.(*model.SecurityPolicy))
+	}
+	for _, obj := range s.ruleStore.List() {
+		result.Rules = append(result.Rules, obj.(*model.Rule))
+	}
+	for _, obj := range s.groupStore.List() {
+		result.Groups = append(result.Groups, obj.(*model.Group))
+	}
+	for _, obj := range s.projectShareStore.List() {
+		result.Shares = append(result.Shares, obj.(*model.Share))
+	}
+	result.sort()
+	return result, nil
+}
+
+// sort orders the SecurityPolicies and Rules by the sequence number, and the Groups by ID.
+func (r *EffectiveResources) sort() {
+	sort.SliceStable(r.SecurityPolicies, func(i, j int) bool {
+		return sequenceNumber(r.SecurityPolicies[i].SequenceNumber) < sequenceNumber(r.SecurityPolicies[j].SequenceNumber)
+	})
+	sort.SliceStable(r.Rules, func(i, j int) bool {
+		return sequenceNumber(r.Rules[i].SequenceNumber) < sequenceNumber(r.Rules[j].SequenceNumber)
+	})
+	sort.Slice(r.Groups, func(i, j int) bool {
+		return *r.Groups[i].Id < *r.Groups[j].Id
+	})
+}
+
+// groupIPMembers is the NSX response of the group effective IP members API.
+type groupIPMembers struct {
+	Results []string `json:"results"`
+}
+
+// GetGroupMemberIPs returns the effective member IPs or CIDRs of the NSX group with the given policy path.
+func GetGroupMemberIPs(service common.Service, groupPath string) ([]string, error) {
+	members := &groupIPMembers{}
+	url := fmt.Sprintf("policy/api/v1%s/members/ip-addresses", groupPath)
+	if err := service.NSXClient.Cluster.HttpGetAndDecode(url, members); err != nil {
+		return nil, err
+	}
+	return members.Results, nil
+}
+
+func sequenceNumber(n *int64) int64 {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
@@ -0,0 +1,129 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestSearchEffectiveResources(t *testing.T) {
+	service := common.Service{
+		NSXClient: &nsx.Client{
+			NsxConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+		},
+	}
+	ownerTags := func(uid string) []model.Tag {
+		return []model.Tag{{Scope: String(common.TagScopeSecurityPolicyUID), Tag: String(uid)}}
+	}
+	nsxResources := map[string][]interface{}{
+		ResourceTypeSecurityPolicy: {
+			&model.SecurityPolicy{Id: String("sp1"), Tags: ownerTags("uid1")},
+		},
+		ResourceTypeRule: {
+			&model.Rule{Id: String("rule-b"), SequenceNumber: common.Int64(2), Tags: ownerTags("uid1")},
+			&model.Rule{Id: String("rule-a"), SequenceNumber: common.Int64(1), Tags: ownerTags("uid1")},
+		},
+		ResourceTypeGroup: {
+			&model.Group{Id: String("group-b"), Tags: ownerTags("uid1")},
+			&model.Group{Id: String("group-a"), Tags: ownerTags("uid1")},
+			&model.Group{Id: String("group-other"), Tags: ownerTags("uid2")},
+		},
+		ResourceTypeShare: {
+			&model.Share{Id: String("share1"), Tags: ownerTags("uid1")},
+		},
+	}
+
+	t.Run("resources indexed by owner UID", func(t *testing.T) {
+		var queries []string
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&service), "SearchResource",
+			func(_ *common.Service, resourceTypeValue string, queryParam string, store common.Store, _ common.Filter) (uint64, error) {
+				queries = append(queries, queryParam)
+				for _, obj := range nsxResources[resourceTypeValue] {
+					_ = store.(interface{ Add(obj interface{}) error }).Add(obj)
+				}
+				return uint64(len(nsxResources[resourceTypeValue])), nil
+			})
+		defer patches.Reset()
+
+		result, err := SearchEffectiveResources(service, common.TagScopeSecurityPolicyUID, "uid1")
+		require.NoError(t, err)
+		require.Len(t, result.SecurityPolicies, 1)
+		require.Len(t, result.Rules, 2)
+		assert.Equal(t, "rule-a", *result.Rules[0].Id)
+		assert.Equal(t, "rule-b", *result.Rules[1].Id)
+		require.Len(t, result.Groups, 2)
+		assert.Equal(t, "group-a", *result.Groups[0].Id)
+		assert.Equal(t, "group-b", *result.Groups[1].Id)
+		require.Len(t, result.Shares, 1)
+		assert.Len(t, queries, 4)
+		for _, query := range queries {
+			assert.Contains(t, query, "tags.tag:uid1")
+		}
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&service), "SearchResource",
+			func(_ *common.Service, _ string, _ string, _ common.Store, _ common.Filter) (uint64, error) {
+				return 0, errors.New("search error")
+			})
+		defer patches.Reset()
+
+		_, err := SearchEffectiveResources(service, common.TagScopeSecurityPolicyUID, "uid1")
+		assert.EqualError(t, err, "search error")
+	})
+}
+
+func TestSearchClusterResources(t *testing.T) {
+	service := common.Service{
+		NSXClient: &nsx.Client{
+			NsxConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+		},
+	}
+	nsxResources := map[string][]interface{}{
+		ResourceTypeSecurityPolicy: {
+			&model.SecurityPolicy{Id: String("sp2"), SequenceNumber: common.Int64(2)},
+			&model.SecurityPolicy{Id: String("sp1"), SequenceNumber: common.Int64(1)},
+		},
+		ResourceTypeRule: {
+			&model.Rule{Id: String("rule1"), Tags: []model.Tag{{Scope: String(common.TagScopeNetworkPolicyUID), Tag: String("np-uid_allow")}}},
+			&model.Rule{Id: String("rule2"), Tags: []model.Tag{{Scope: String(common.TagScopeSecurityPolicyUID), Tag: String("sp-uid")}}},
+		},
+		ResourceTypeGroup: {
+			&model.Group{Id: String("group1")},
+		},
+	}
+	var queries []string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&service), "SearchResource",
+		func(_ *common.Service, resourceTypeValue string, queryParam string, store common.Store, _ common.Filter) (uint64, error) {
+			queries = append(queries, queryParam)
+			for _, obj := range nsxResources[resourceTypeValue] {
+				_ = store.(interface{ Add(obj interface{}) error }).Add(obj)
+			}
+			return uint64(len(nsxResources[resourceTypeValue])), nil
+		})
+	defer patches.Reset()
+
+	result, err := SearchClusterResources(service)
+	require.NoError(t, err)
+	require.Len(t, result.SecurityPolicies, 2)
+	assert.Equal(t, "sp1", *result.SecurityPolicies[0].Id)
+	assert.Len(t, result.Rules, 2)
+	assert.Len(t, result.Groups, 1)
+	assert.Empty(t, result.Shares)
+	assert.Len(t, queries, 4)
+	for _, query := range queries {
+		assert.Contains(t, query, "k8scl-one\\:test")
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	v1 "k8s.io/api/core/v1"
 	meta1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
@@ -51,6 +53,10 @@ func (service *SecurityPolicyService) expandRule(obj *v1alpha1.SecurityPolicy, r
 		return nil, []*model.Rule{nsxRule}, nil
 	}
 
+	if service.namedPortRuleGroupingEnabled() {
+		return service.expandRuleByPortGroup(obj, rule, ruleIdx, ruleBaseID, createdFor, vpcInfo)
+	}
+
 	var nsxRules []*model.Rule
 	// nsxGroups is a slice for the IPSet groups referred by a security Rule if named port is configured.
 	var nsxGroups []*model.Group
@@ -84,22 +90,7 @@ func (service *SecurityPolicyService) expandRuleByPort(obj *v1alpha1.SecurityPol
 		}
 		startPort, err := service.resolveNamedPort(obj, rule, port)
 		if err != nil {
-			// In case there is no more valid ip set selected, so clear the stale ip set group in NSX if stale ips exist
-			if errors.As(err, &nsxutil.NoEffectiveOption{}) {
-				groups := service.groupStore.GetByIndex(common.TagScopeRuleID, ruleBaseID)
-				var ipSetGroup *model.Group
-				for _, group := range groups {
-					ipSetGroup = group
-					// Clear ip set group in NSX
-					ipSetGroup.Expression = nil
-					log.Debug("Clear ruleIPSetGroup", "ruleIPSetGroup", ipSetGroup)
-					err3 := service.createOrUpdateGroups(obj, []*model.Group{ipSetGroup})
-					if err3 != nil {
-						return nil, nil, err3
-					}
-				}
-			}
-			return nil, nil, err
+			return nil, nil, service.handleNamedPortResolveError(obj, ruleBaseID, err)
 		}
 
 		for addrIdx, portAddr := range startPort {
@@ -133,8 +124,10 @@ func (service *SecurityPolicyService) expandRuleByService(obj *v1alpha1.Security
 	}
 
 	var ruleServiceEntries []*data.StructValue
-	serviceEntry := buildRuleServiceEntries(namedPort.port)
-	ruleServiceEntries = append(ruleServiceEntries, serviceEntry)
+	for _, port := range namedPort.servicePorts() {
+		serviceEntry := buildRuleServiceEntries(port)
+		ruleServiceEntries = append(ruleServiceEntries, serviceEntry)
+	}
 	nsxRule.ServiceEntries = ruleServiceEntries
 
 	// If portAddress contains a list of IPs, we should build an ip set group for the rule.
@@ -156,6 +149,142 @@ func (service *SecurityPolicyService) expandRuleByService(obj *v1alpha1.Security
 	return nsxGroups, nsxRule, nil
 }
 
+// handleNamedPortResolveError returns the error of resolving the named port of the rule.
+func (service *SecurityPolicyService) handleNamedPortResolveError(obj *v1alpha1.SecurityPolicy, ruleBaseID string, err error) error {
+	// In case there is no more valid ip set selected, so clear the stale ip set group in NSX if stale ips exist
+	if errors.As(err, &nsxutil.NoEffectiveOption{}) {
+		groups := service.groupStore.GetByIndex(common.TagScopeRuleID, ruleBaseID)
+		var ipSetGroup *model.Group
+		for _, group := range groups {
+			ipSetGroup = group
+			// Clear ip set group in NSX
+			ipSetGroup.Expression = nil
+			log.Debug("Clear ruleIPSetGroup", "ruleIPSetGroup", ipSetGroup)
+			err3 := service.createOrUpdateGroups(obj, []*model.Group{ipSetGroup})
+			if err3 != nil {
+				return err3
+			}
+		}
+	}
+	return err
+}
+
+func (service *SecurityPolicyService) namedPortRuleGroupingEnabled() bool {
+	return service.NSXConfig != nil && service.NSXConfig.K8sConfig != nil && service.NSXConfig.NamedPortRuleGrouping
+}
+
+// namedPortKey is a port number resolved from a named port.
+type namedPortKey struct {
+	protocol v1.Protocol
+	port     int
+}
+
+// expandRuleByPortGroup expands the rule with named ports to one NSX rule for the numeric ports, and one NSX rule
+// per group of pods resolving the named ports to the same port numbers. The contiguous port numbers of a group are
+// merged into port ranges, so the number of NSX rules depends on the distinct named port resolutions instead of the
+// resolved port numbers.
+func (service *SecurityPolicyService) expandRuleByPortGroup(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule,
+	ruleIdx int, ruleBaseID, createdFor string, vpcInfo *common.VPCResourceInfo,
+) ([]*model.Group, []*model.Rule, error) {
+	var numericPorts []v1alpha1.SecurityPolicyPort
+	ipPorts := map[string]sets.Set[namedPortKey]{}
+	for _, port := range rule.Ports {
+		if port.Port.Type == intstr.Int {
+			numericPorts = append(numericPorts, port)
+			continue
+		}
+		// endPort can only be defined if port is also defined. Both ports must be numeric.
+		if port.EndPort != 0 {
+			return nil, nil, nsxutil.RestrictionError{Desc: "endPort can only be defined if port is also numeric."}
+		}
+		portAddrs, err := service.resolveNamedPort(obj, rule, port)
+		if err != nil {
+			return nil, nil, service.handleNamedPortResolveError(obj, ruleBaseID, err)
+		}
+		for _, portAddr := range portAddrs {
+			for _, ip := range portAddr.IPs {
+				if _, ok := ipPorts[ip]; !ok {
+					ipPorts[ip] = sets.New[namedPortKey]()
+				}
+				ipPorts[ip].Insert(namedPortKey{protocol: port.Protocol, port: portAddr.Port})
+			}
+		}
+	}
+
+	var portInfos []*portInfo
+	if len(numericPorts) > 0 {
+		portInfos = append(portInfos, &portInfo{
+			ports:    numericPorts,
+			idSuffix: fmt.Sprintf("0%s0", common.ConnectorUnderline),
+		})
+	}
+
+	groupPorts := map[string][]v1alpha1.SecurityPolicyPort{}
+	groupIPs := map[string][]string{}
+	for ip, keys := range ipPorts {
+		ports := mergeNamedPorts(keys.UnsortedList())
+		groupKey := service.buildRulePortsString(ports)
+		groupPorts[groupKey] = ports
+		groupIPs[groupKey] = append(groupIPs[groupKey], ip)
+	}
+	groupKeys := make([]string, 0, len(groupPorts))
+	for groupKey := range groupPorts {
+		groupKeys = append(groupKeys, groupKey)
+	}
+	sort.Strings(groupKeys)
+	for groupIdx, groupKey := range groupKeys {
+		ips := groupIPs[groupKey]
+		sort.Strings(ips)
+		portInfos = append(portInfos, &portInfo{
+			ports: groupPorts[groupKey],
+			ips:   ips,
+			// The port index after the last port of the rule is used in the T1 NSX rule ID of the port groups.
+			idSuffix: fmt.Sprintf("%d%s%d", len(rule.Ports), common.ConnectorUnderline, groupIdx),
+		})
+	}
+
+	var nsxGroups []*model.Group
+	var nsxRules []*model.Rule
+	for _, portInfo := range portInfos {
+		gs, r, err := service.expandRuleByService(obj, rule, ruleIdx, ruleBaseID, createdFor, portInfo, vpcInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		nsxRules = append(nsxRules, r)
+		nsxGroups = append(nsxGroups, gs...)
+	}
+	log.Debug("Expanded rule by named port groups", "rule", rule.Name, "ruleBaseID", ruleBaseID, "nsxRuleCount", len(nsxRules))
+	return nsxGroups, nsxRules, nil
+}
+
+// mergeNamedPorts sorts the port numbers by protocol and merges the contiguous port numbers of the same protocol
+// into port ranges.
+func mergeNamedPorts(keys []namedPortKey) []v1alpha1.SecurityPolicyPort {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].protocol != keys[j].protocol {
+			return keys[i].protocol < keys[j].protocol
+		}
+		return keys[i].port < keys[j].port
+	})
+	var ports []v1alpha1.SecurityPolicyPort
+	for i := 0; i < len(keys); {
+		j := i
+		for j+1 < len(keys) && keys[j+1].protocol == keys[i].protocol && keys[j+1].port == keys[j].port+1 {
+			j++
+		}
+		port := v1alpha1.SecurityPolicyPort{
+			Protocol: keys[i].protocol,
+			Port:     intstr.FromInt32(int32(keys[i].port)),
+		}
+		if j > i {
+			port.EndPort = keys[j].port
+		}
+		ports = append(ports, port)
+		i = j + 1
+	}
+	return ports
+}
+
 // Resolve a named port to port number by rule and policy selector.
 // e.g. "http" -> [{"80":['1.1.1.1', '2.2.2.2']}, {"443":['3.3.3.3']}]
 func (service *SecurityPolicyService) resolveNamedPort(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule,
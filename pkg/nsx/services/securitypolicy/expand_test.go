@@ -422,14 +422,15 @@ func Test_ExpandRule(t *testing.T) {
 	spVPCRuleTags1 := appendRuleIDAndHashTags(spVPCRuleTags, "94b44028", "p1-94b44028_ogcol")
 
 	for _, tc := range []struct {
-		name        string
-		vpcEnabled  bool
-		ruleIdx     int
-		ruleBasedID string
-		createdFor  string
-		expGroups   []*model.Group
-		expRules    []*model.Rule
-		expErr      string
+		name                  string
+		vpcEnabled            bool
+		namedPortRuleGrouping bool
+		ruleIdx               int
+		ruleBasedID           string
+		createdFor            string
+		expGroups             []*model.Group
+		expRules              []*model.Rule
+		expErr                string
 	}{
 		{
 			name:       "VPC: rule without and ports for NetworkPolicy",
@@ -557,6 +558,37 @@ func Test_ExpandRule(t *testing.T) {
 					Tags:           spT1RuleTags,
 				},
 			},
+		}, {
+			name:                  "T1: rule with named ports for SecurityPolicy with named port rule grouping",
+			vpcEnabled:            false,
+			namedPortRuleGrouping: true,
+			ruleIdx:               2,
+			createdFor:            common.ResourceTypeSecurityPolicy,
+			expGroups: []*model.Group{
+				getTestIPsetGroup("sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2_2_0_ipset", "TCP.http_UDP.1236.1237.TCP.8080_ingress_allow_ipset", "sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2", false, "security_policy_cr"),
+			},
+			expRules: []*model.Rule{
+				{
+					Id:                common.String("sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2_2_0"),
+					DisplayName:       common.String("TCP.http_UDP.1236.1237.TCP.8080_ingress_allow"),
+					Direction:         common.String("IN"),
+					SequenceNumber:    Int64(int64(2)),
+					Action:            common.String("ALLOW"),
+					Services:          []string{"ANY"},
+					ServiceEntries:    []*data.StructValue{getRuleServiceEntries(8080, 0, "TCP")},
+					Tags:              spT1RuleTags,
+					DestinationGroups: []string{"/infra/domains/k8scl-one/groups/sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2_2_0_ipset"},
+				}, {
+					Id:             common.String("sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2_0_0"),
+					DisplayName:    common.String("TCP.http_UDP.1236.1237.UDP.1236.1237_ingress_allow"),
+					Direction:      common.String("IN"),
+					SequenceNumber: Int64(int64(2)),
+					Action:         common.String("ALLOW"),
+					Services:       []string{"ANY"},
+					ServiceEntries: []*data.StructValue{getRuleServiceEntries(1236, 1237, "UDP")},
+					Tags:           spT1RuleTags,
+				},
+			},
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
@@ -578,6 +610,9 @@ func Test_ExpandRule(t *testing.T) {
 							EnableVPCNetwork: tc.vpcEnabled,
 							Cluster:          "k8scl-one",
 						},
+						K8sConfig: &config.K8sConfig{
+							NamedPortRuleGrouping: tc.namedPortRuleGrouping,
+						},
 					},
 				},
 				vpcService: &mockVPCService,
@@ -598,6 +633,23 @@ func Test_ExpandRule(t *testing.T) {
 	}
 }
 
+func Test_mergeNamedPorts(t *testing.T) {
+	ports := mergeNamedPorts([]namedPortKey{
+		{protocol: "UDP", port: 53},
+		{protocol: "TCP", port: 8082},
+		{protocol: "TCP", port: 80},
+		{protocol: "TCP", port: 8080},
+		{protocol: "TCP", port: 8081},
+		{protocol: "UDP", port: 54},
+	})
+	assert.Equal(t, []v1alpha1.SecurityPolicyPort{
+		{Protocol: "TCP", Port: intstr.FromInt32(80)},
+		{Protocol: "TCP", Port: intstr.FromInt32(8080), EndPort: 8082},
+		{Protocol: "UDP", Port: intstr.FromInt32(53), EndPort: 54},
+	}, ports)
+	assert.Empty(t, mergeNamedPorts(nil))
+}
+
 // TestResolveNamespace tests the ResolveNamespace function
 func Test_ResolveNamespace(t *testing.T) {
 	mockCtl := gomock.NewController(t)
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -36,6 +37,8 @@ var (
 	ResourceTypeGroup          = common.ResourceTypeGroup
 	ResourceTypeShare          = common.ResourceTypeShare
 	NewConverter               = common.NewConverter
+	// MaxRuleCount is the number of NSX rules which are supported in one NSX SecurityPolicy.
+	MaxRuleCount = 1000
 )
 
 type SecurityPolicyService struct {
@@ -47,6 +50,7 @@ type SecurityPolicyService struct {
 	infraShareStore     *ShareStore
 	projectGroupStore   *GroupStore
 	projectShareStore   *ShareStore
+	contextProfileStore *ContextProfileStore
 	vpcService          common.VPCServiceProvider
 
 	securityPolicyBuilder *common.PolicyTreeBuilder[*model.SecurityPolicy]
@@ -90,7 +94,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	wgDone := make(chan bool)
 	fatalErrors := make(chan error)
 
-	wg.Add(7)
+	wg.Add(8)
 
 	securityPolicyService := &SecurityPolicyService{
 		Service: service,
@@ -112,6 +116,11 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	}
 	indexScope := common.TagValueScopeSecurityPolicyUID
 	securityPolicyService.setUpStore(indexScope, forCleanUp)
+	if !forCleanUp {
+		common.RegisterStoreMetrics(ResourceTypeSecurityPolicy, &securityPolicyService.securityPolicyStore.ResourceStore)
+		common.RegisterStoreMetrics(ResourceTypeRule, &securityPolicyService.ruleStore.ResourceStore)
+		common.RegisterStoreMetrics(ResourceTypeGroup, &securityPolicyService.groupStore.ResourceStore)
+	}
 	securityPolicyService.vpcService = vpcService
 
 	infraShareTag := []model.Tag{
@@ -145,6 +154,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	}
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, nil, securityPolicyService.securityPolicyStore)
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, nil, securityPolicyService.ruleStore)
+	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeContextProfile, nil, securityPolicyService.contextProfileStore)
 
 	go func() {
 		wg.Wait()
@@ -165,6 +175,7 @@ func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath b
 	vpcResourceIndexWrapper := func(indexers cache.Indexers) cache.Indexers {
 		indexers[indexScope] = indexBySecurityPolicyUID
 		indexers[common.TagScopeNetworkPolicyUID] = indexByNetworkPolicyUID
+		indexers[common.TagScopeAdminNetworkPolicyUID] = indexByAdminNetworkPolicyUID
 		// Note: we can't use indexer `common.IndexByVPCPathFuncKey` with group/rule stores by default because the
 		// caller may not use the object read from NSX to apply on the store which is possibly not set with path or
 		// the parent path. But for cleanup logic, indexWithVPCPath is always set true and the store is re-built from
@@ -190,42 +201,55 @@ func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath b
 	}}
 	s.ruleStore = &RuleStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, vpcResourceIndexWrapper(cache.Indexers{
-			SPIndexByUUIDAndRuleHashFuncKey: indexSPByUUIDAndRuleHash,
-			NPIndexByUUIDAndRuleHashFuncKey: indexNPByUUIDAndRuleHash,
-			common.TagScopeRuleID:           indexRuleFunc,
+			SPIndexByUUIDAndRuleHashFuncKey:  indexSPByUUIDAndRuleHash,
+			NPIndexByUUIDAndRuleHashFuncKey:  indexNPByUUIDAndRuleHash,
+			ANPIndexByUUIDAndRuleHashFuncKey: indexANPByUUIDAndRuleHash,
+			common.TagScopeRuleID:            indexRuleFunc,
 		})),
 		BindingType: model.RuleBindingType(),
 	}}
 	s.infraGroupStore = &GroupStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
-			common.TagScopeRuleID:           indexGroupFunc,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+			common.TagScopeRuleID:                indexGroupFunc,
 		}),
 		BindingType: model.GroupBindingType(),
 	}}
 	s.infraShareStore = &ShareStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
 		}),
 		BindingType: model.ShareBindingType(),
 	}}
 	s.projectGroupStore = &GroupStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
-			common.TagScopeRuleID:           indexGroupFunc,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+			common.TagScopeRuleID:                indexGroupFunc,
 		}),
 		BindingType: model.GroupBindingType(),
 	}}
 	s.projectShareStore = &ShareStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
 		}),
 		BindingType: model.ShareBindingType(),
 	}}
+	s.contextProfileStore = &ContextProfileStore{ResourceStore: common.ResourceStore{
+		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+		}),
+		BindingType: model.PolicyContextProfileBindingType(),
+	}}
 }
 
 func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface{}) error {
@@ -247,6 +271,18 @@ func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface
 				return err
 			}
 		}
+	case *policyv1alpha1.AdminNetworkPolicy:
+		policy, err := newAdminNetworkPolicy(obj)
+		if err != nil {
+			return err
+		}
+		return service.createOrUpdateAdminNetworkPolicy(policy)
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		policy, err := newBaselineAdminNetworkPolicy(obj)
+		if err != nil {
+			return err
+		}
+		return service.createOrUpdateAdminNetworkPolicy(policy)
 	case *v1alpha1.SecurityPolicy:
 		if IsVPCEnabled(service) {
 			err = service.createOrUpdateVPCSecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
@@ -503,10 +539,7 @@ func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alph
 	if len(nsxSecurityPolicy.Scope) == 0 {
 		log.Info("SecurityPolicy has empty policy-level appliedTo field")
 	}
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	_, indexScope := getOwnerTagScopes(createdFor)
 
 	existingSecurityPolicies := securityPolicyStore.GetByIndex(indexScope, string(obj.GetUID()))
 	isChanged := true
@@ -565,15 +598,20 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 		log.Error(err, "Failed to get SecurityPolicy resources from CR", "securityPolicyUID", obj.UID)
 		return err
 	}
+	changedProfiles, staleProfiles := service.getFinalFQDNContextProfiles(obj, createdFor, nil, false)
 
 	// WrapHierarchySecurityPolicy will modify the input security policy rules and move the rules to Children fields for HAPI wrap,
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 {
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(changedProfiles) == 0 && len(staleProfiles) == 0 {
 		log.Info("SecurityPolicy, rules, groups are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
+	// The FQDN context profiles must be created before they are referred by the rules.
+	if err = service.applyFQDNContextProfiles(changedProfiles); err != nil {
+		return err
+	}
 
 	infraSecurityPolicy, err := service.WrapHierarchySecurityPolicy(finalSecurityPolicy, finalGroups)
 	if err != nil {
@@ -614,6 +652,10 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 		log.Error(err, "Failed to apply store", "nsxGroups", finalGroups)
 		return err
 	}
+	// The stale FQDN context profiles can be deleted only after the rules referring them have been deleted.
+	if err = service.applyFQDNContextProfiles(staleProfiles); err != nil {
+		return err
+	}
 	log.Info("Successfully created or updated NSX SecurityPolicy", "nsxSecurityPolicy", finalGetNSXSecurityPolicy)
 	return nil
 }
@@ -638,14 +680,21 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 		return err
 	}
 
+	changedProfiles, staleProfiles := service.getFinalFQDNContextProfiles(obj, createdFor, vpcInfo, isDefaultProject)
+
 	// WrapHierarchyVpcSecurityPolicy will modify the input security policy rules and move the rules to Children fields for HAPI wrap,
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 {
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 &&
+		len(changedProfiles) == 0 && len(staleProfiles) == 0 {
 		log.Info("SecurityPolicy, rules, groups and shares are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
+	// The FQDN context profiles must be created before they are referred by the rules.
+	if err = service.applyFQDNContextProfiles(changedProfiles); err != nil {
+		return err
+	}
 	if !isDefaultProject {
 		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
 	} else {
@@ -663,6 +712,10 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	if err != nil {
 		return err
 	}
+	// The stale FQDN context profiles can be deleted only after the rules referring them have been deleted.
+	if err = service.applyFQDNContextProfiles(staleProfiles); err != nil {
+		return err
+	}
 
 	log.Info("Successfully created or updated NSX SecurityPolicy resources in VPC", "nsxSecurityPolicy", *finalGetNSXSecurityPolicy)
 	return nil
@@ -694,7 +747,7 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 	existingSecurityPolices := securityPolicyStore.GetByIndex(indexScope, string(spUid))
 	if len(existingSecurityPolices) == 0 {
 		log.Info("NSX SecurityPolicy is not found in store, skip deleting it", "nsxSecurityPolicyUID", spUid)
-		return nil
+		return service.applyFQDNContextProfiles(service.getMarkDeleteFQDNContextProfiles(indexScope, spUid))
 	}
 	nsxSecurityPolicy = existingSecurityPolices[0]
 	if nsxSecurityPolicy.Path == nil {
@@ -744,16 +797,16 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 		log.Error(err, "Failed to apply store", "nsxGroups", nsxGroups)
 		return err
 	}
+	if err = service.applyFQDNContextProfiles(service.getMarkDeleteFQDNContextProfiles(indexScope, spUid)); err != nil {
+		return err
+	}
 
 	log.Info("Successfully deleted NSX SecurityPolicy", "nsxSecurityPolicy", finalSecurityPolicyCopy)
 	return nil
 }
 
 func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, isGC bool, createdFor string) error {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	_, indexScope := getOwnerTagScopes(createdFor)
 
 	// For normal SecurityPolicy deletion process, which means that SecurityPolicy has a corresponding NSX SecurityPolicy object.
 	// And for SecurityPolicy GC or cleanup process, which means that SecurityPolicy doesn't exist in K8s any more,
@@ -777,6 +830,10 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 		log.Info("There are stale NSX infra share resource to be GC", "nsxSecurityPolicyUID", spUID, "createdFor", createdFor)
 		isDefaultProject = true
 	} else if vpcInfo.VPCID == "" {
+		// The stale FQDN context profiles left after the other resources are deleted are GC without vpcInfo.
+		if staleProfiles := service.getMarkDeleteFQDNContextProfiles(indexScope, spUID); isGC && nsxSecurityPolicy == nil && len(staleProfiles) != 0 {
+			return service.applyFQDNContextProfiles(staleProfiles)
+		}
 		err = errors.New("vpcID is empty")
 		log.Error(err, "Failed to delete SecurityPolicy in VPC", "nsxSecurityPolicyUID", spUID)
 		return err
@@ -826,6 +883,11 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	if err != nil {
 		return err
 	}
+	// The FQDN context profiles are deleted after the rules referring them, the failed ones will be GC.
+	if err = service.applyFQDNContextProfiles(service.getMarkDeleteFQDNContextProfiles(indexScope, spUID)); err != nil {
+		log.Error(err, "Failed to delete NSX FQDN context profiles after NSX SecurityPolicy is deleted, and they will be GC", "nsxSecurityPolicyUID", spUID)
+		return nil
+	}
 
 	if isGC {
 		log.Info("Successfully GC NSX SecurityPolicy, rules, groups and shares in VPC", "nsxSecurityPolicyUID", spUID)
@@ -1313,6 +1375,11 @@ func (service *SecurityPolicyService) ListSecurityPolicyID() sets.Set[string] {
 	return service.getGCSecurityPolicyIDSet(indexScope)
 }
 
+// GetSecurityPolicyRuleCount returns the number of NSX rules in the store which the SecurityPolicy CR is expanded to.
+func (service *SecurityPolicyService) GetSecurityPolicyRuleCount(uid types.UID) int {
+	return len(service.ruleStore.GetByIndex(common.TagValueScopeSecurityPolicyUID, string(uid)))
+}
+
 func (service *SecurityPolicyService) ListNetworkPolicyID() sets.Set[string] {
 	indexScope := common.TagScopeNetworkPolicyUID
 	return service.getGCSecurityPolicyIDSet(indexScope)
@@ -1354,8 +1421,10 @@ func (service *SecurityPolicyService) getGCSecurityPolicyIDSet(indexScope string
 	// List SecurityPolicyID to which share resources are associated in infra share/group store
 	infraShareSet := service.infraShareStore.ListIndexFuncValues(indexScope)
 	infraGroupSet := service.infraGroupStore.ListIndexFuncValues(indexScope)
+	// List SecurityPolicyID to which FQDN context profiles are associated in context profile store
+	contextProfileSet := service.contextProfileStore.ListIndexFuncValues(indexScope)
 
-	return groupSet.Union(policySet).Union(projectShareSet).Union(projectGroupSet).Union(infraShareSet).Union(infraGroupSet)
+	return groupSet.Union(policySet).Union(projectShareSet).Union(projectGroupSet).Union(infraShareSet).Union(infraGroupSet).Union(contextProfileSet)
 }
 
 func (service *SecurityPolicyService) getVPCInfo(spNameSpace string) (*common.VPCResourceInfo, error) {
@@ -0,0 +1,274 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	contextProfileAttributeDomainName = "DOMAIN_NAME"
+	contextProfileAttributeTypeString = "STRING"
+	fqdnContextProfileSuffix          = "fqdn"
+	dnsSnoopingRuleSuffix             = "dns"
+)
+
+var (
+	// The L7 DNS rule allows the DNS traffic of the workloads with the system-defined DNS App ID context profile,
+	// so that NSX snoops the DNS responses and learns the IPs of the FQDNs.
+	dnsSnoopingContextProfilePath = "/infra/context-profiles/DNS"
+	dnsSnoopingServicePaths       = []string{"/infra/services/DNS", "/infra/services/DNS-UDP"}
+)
+
+// getRuleFQDNs returns the sorted and deduplicated FQDNs of the rule destinations.
+func getRuleFQDNs(rule *v1alpha1.SecurityPolicyRule) []string {
+	var fqdns []string
+	for _, peer := range getRuleDestinationPeers(rule) {
+		for _, fqdn := range peer.FQDNs {
+			fqdns = append(fqdns, strings.ToLower(fqdn))
+		}
+	}
+	slices.Sort(fqdns)
+	return slices.Compact(fqdns)
+}
+
+func isFQDNPeer(peer *v1alpha1.SecurityPolicyPeer) bool {
+	return len(peer.FQDNs) > 0
+}
+
+// validateRuleFQDNs checks the FQDN peers of the rule, FQDNs are only supported as the only destinations of an
+// egress rule without named ports, and the NSX version must support them.
+func (service *SecurityPolicyService) validateRuleFQDNs(rule *v1alpha1.SecurityPolicyRule, ruleDirection string) error {
+	for _, peer := range getRuleSourcePeers(rule) {
+		if isFQDNPeer(&peer) {
+			return nsxutil.ValidationError{Desc: "fqdns can only be used in the destinations of egress rules"}
+		}
+	}
+	destinations := getRuleDestinationPeers(rule)
+	hasFQDN := false
+	for i := range destinations {
+		if isFQDNPeer(&destinations[i]) {
+			hasFQDN = true
+			break
+		}
+	}
+	if !hasFQDN {
+		return nil
+	}
+	if ruleDirection != "OUT" {
+		return nsxutil.ValidationError{Desc: "fqdns can only be used in the destinations of egress rules"}
+	}
+	for i := range destinations {
+		peer := &destinations[i]
+		if !isFQDNPeer(peer) || peer.PodSelector != nil || peer.VMSelector != nil || peer.NamespaceSelector != nil || len(peer.IPBlocks) > 0 {
+			return nsxutil.ValidationError{Desc: "fqdns can't be used with other destinations in the same rule"}
+		}
+	}
+	if service.hasNamedPort(rule) {
+		return nsxutil.ValidationError{Desc: "fqdns can't be used with named ports in the same rule"}
+	}
+	if !nsx.FQDNFilterFeatureEnabled(service.NSXClient) {
+		return nsxutil.ValidationError{Desc: "fqdns are not supported by the NSX version"}
+	}
+	return nil
+}
+
+func (service *SecurityPolicyService) buildFQDNContextProfileID(ruleBaseID string) string {
+	return util.NormalizeId(strings.Join([]string{ruleBaseID, fqdnContextProfileSuffix}, common.ConnectorUnderline))
+}
+
+// buildFQDNContextProfilePath returns the path of the FQDN context profile, which is created under the project infra
+// in VPC network, or under infra in T1 network and for the VPCs in the default project.
+func (service *SecurityPolicyService) buildFQDNContextProfilePath(profileID string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) string {
+	if IsVPCEnabled(service) && !isDefaultProject {
+		return fmt.Sprintf("/orgs/%s/projects/%s/infra/context-profiles/%s", vpcInfo.OrgID, vpcInfo.ProjectID, profileID)
+	}
+	return fmt.Sprintf("/infra/context-profiles/%s", profileID)
+}
+
+// buildFQDNContextProfile builds the context profile with a DOMAIN_NAME attribute of the rule FQDNs.
+func (service *SecurityPolicyService) buildFQDNContextProfile(obj *v1alpha1.SecurityPolicy, fqdns []string, ruleBaseID, createdFor string,
+	vpcInfo *common.VPCResourceInfo, isDefaultProject bool,
+) *model.PolicyContextProfile {
+	profileID := service.buildFQDNContextProfileID(ruleBaseID)
+	tags := append(service.buildBasicTags(obj, createdFor), model.Tag{
+		Scope: String(common.TagScopeRuleID),
+		Tag:   String(ruleBaseID),
+	})
+	return &model.PolicyContextProfile{
+		Id:          String(profileID),
+		DisplayName: String(util.GenerateTruncName(common.MaxNameLength, obj.Name, "", fqdnContextProfileSuffix, "", "")),
+		Path:        String(service.buildFQDNContextProfilePath(profileID, vpcInfo, isDefaultProject)),
+		Attributes: []model.PolicyAttributes{
+			{
+				Key:      String(contextProfileAttributeDomainName),
+				Datatype: String(contextProfileAttributeTypeString),
+				Value:    fqdns,
+			},
+		},
+		Tags: tags,
+	}
+}
+
+// buildDNSSnoopingRule builds the L7 DNS rule allowing the DNS traffic of the workloads applied by the FQDN rule.
+func (service *SecurityPolicyService) buildDNSSnoopingRule(fqdnRule *model.Rule, ruleBaseID string) *model.Rule {
+	return &model.Rule{
+		Id:                String(util.NormalizeId(strings.Join([]string{ruleBaseID, dnsSnoopingRuleSuffix}, common.ConnectorUnderline))),
+		DisplayName:       String(util.GenerateTruncName(common.MaxNameLength, *fqdnRule.DisplayName, "", dnsSnoopingRuleSuffix, "", "")),
+		Direction:         String("OUT"),
+		SequenceNumber:    fqdnRule.SequenceNumber,
+		Action:            String(util.ToUpper(v1alpha1.RuleActionAllow)),
+		Services:          dnsSnoopingServicePaths,
+		Profiles:          []string{dnsSnoopingContextProfilePath},
+		SourceGroups:      []string{"ANY"},
+		DestinationGroups: []string{"ANY"},
+		Scope:             fqdnRule.Scope,
+		Tags:              fqdnRule.Tags,
+		Logged:            fqdnRule.Logged,
+	}
+}
+
+// buildFQDNContextProfiles builds the FQDN context profiles of all the rules in the SecurityPolicy.
+func (service *SecurityPolicyService) buildFQDNContextProfiles(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo,
+	isDefaultProject bool,
+) []model.PolicyContextProfile {
+	var profiles []model.PolicyContextProfile
+	for ruleIdx := range obj.Spec.Rules {
+		fqdns := getRuleFQDNs(&obj.Spec.Rules[ruleIdx])
+		if len(fqdns) == 0 {
+			continue
+		}
+		ruleBaseID := service.buildRuleID(obj, ruleIdx, createdFor)
+		profiles = append(profiles, *service.buildFQDNContextProfile(obj, fqdns, ruleBaseID, createdFor, vpcInfo, isDefaultProject))
+	}
+	return profiles
+}
+
+// getFinalFQDNContextProfiles returns the changed FQDN context profiles and the stale ones marked for deletion.
+func (service *SecurityPolicyService) getFinalFQDNContextProfiles(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo,
+	isDefaultProject bool,
+) ([]model.PolicyContextProfile, []model.PolicyContextProfile) {
+	_, indexScope := getOwnerTagScopes(createdFor)
+	existingProfiles := service.contextProfileStore.GetByIndex(indexScope, string(obj.UID))
+	expectedProfiles := service.buildFQDNContextProfiles(obj, createdFor, vpcInfo, isDefaultProject)
+	changed, stale := common.CompareResources(ContextProfilesPtrToComparable(existingProfiles), ContextProfilesToComparable(expectedProfiles))
+	changedProfiles, staleProfiles := ComparableToContextProfiles(changed), ComparableToContextProfiles(stale)
+	for i := len(staleProfiles) - 1; i >= 0; i-- {
+		staleProfiles[i].MarkedForDelete = &MarkedForDelete
+	}
+	return changedProfiles, staleProfiles
+}
+
+func (service *SecurityPolicyService) getMarkDeleteFQDNContextProfiles(indexScope string, spUID types.UID) []model.PolicyContextProfile {
+	deleteProfiles := make([]model.PolicyContextProfile, 0)
+	for _, profile := range service.contextProfileStore.GetByIndex(indexScope, string(spUID)) {
+		deleteProfile := *profile
+		deleteProfile.MarkedForDelete = &MarkedForDelete
+		deleteProfiles = append(deleteProfiles, deleteProfile)
+	}
+	return deleteProfiles
+}
+
+// applyFQDNContextProfiles creates, updates or deletes the FQDN context profiles on NSX and in the store.
+// The profiles under the project infra are patched with OrgRootClient, and the others are patched with InfraClient.
+// The profiles must be created before the rules referring them, and deleted after the rules are updated.
+func (service *SecurityPolicyService) applyFQDNContextProfiles(profiles []model.PolicyContextProfile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	type projectKey struct {
+		orgID, projectID string
+	}
+	var infraProfiles []model.PolicyContextProfile
+	projectProfiles := make(map[projectKey][]model.PolicyContextProfile)
+	for _, profile := range profiles {
+		if profile.Path != nil && strings.HasPrefix(*profile.Path, "/orgs/") {
+			// The path is in the format of "/orgs/<orgID>/projects/<projectID>/infra/context-profiles/<profileID>".
+			segments := strings.Split(*profile.Path, "/")
+			key := projectKey{orgID: segments[2], projectID: segments[4]}
+			projectProfiles[key] = append(projectProfiles[key], profile)
+		} else {
+			infraProfiles = append(infraProfiles, profile)
+		}
+	}
+
+	if len(infraProfiles) > 0 {
+		profilesChildren, err := service.wrapContextProfiles(infraProfiles)
+		if err != nil {
+			return err
+		}
+		infra, err := service.wrapInfra(profilesChildren)
+		if err != nil {
+			return err
+		}
+		err = service.NSXClient.InfraClient.Patch(*infra, &EnforceRevisionCheckParam)
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			log.Error(err, "Failed to create, update or delete NSX FQDN context profiles in infra")
+			return err
+		}
+	}
+	for key, profiles := range projectProfiles {
+		profilesChildren, err := service.wrapContextProfiles(profiles)
+		if err != nil {
+			return err
+		}
+		projectInfraChildren, err := service.wrapChildTargetInfra(profilesChildren)
+		if err != nil {
+			return err
+		}
+		orgRoot, err := service.wrapOrgRootProjectInfra(projectInfraChildren, key.orgID, key.projectID)
+		if err != nil {
+			return err
+		}
+		err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			log.Error(err, "Failed to create, update or delete NSX FQDN context profiles in project", "nsxProjectID", key.projectID)
+			return err
+		}
+	}
+
+	if err := service.contextProfileStore.Apply(&profiles); err != nil {
+		log.Error(err, "Failed to apply store", "contextProfiles", profiles)
+		return err
+	}
+	log.Info("Successfully applied NSX FQDN context profiles", "count", len(profiles))
+	return nil
+}
+
+func (service *SecurityPolicyService) wrapContextProfiles(profiles []model.PolicyContextProfile) ([]*data.StructValue, error) {
+	var profilesChildren []*data.StructValue
+	resourceType := common.ResourceTypeChildContextProfile
+
+	for _, p := range profiles {
+		profile := p
+		profile.ResourceType = &common.ResourceTypeContextProfile
+		childProfile := model.ChildPolicyContextProfile{
+			ResourceType:         resourceType,
+			Id:                   profile.Id,
+			MarkedForDelete:      profile.MarkedForDelete,
+			PolicyContextProfile: &profile,
+		}
+		dataValue, errors := NewConverter().ConvertToVapi(childProfile, model.ChildPolicyContextProfileBindingType())
+		if len(errors) > 0 {
+			return nil, errors[0]
+		}
+		profilesChildren = append(profilesChildren, dataValue.(*data.StructValue))
+	}
+	return profilesChildren, nil
+}
@@ -0,0 +1,158 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	gomonkey "github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func Test_getRuleFQDNs(t *testing.T) {
+	rule := &v1alpha1.SecurityPolicyRule{
+		To: []v1alpha1.SecurityPolicyPeer{
+			{FQDNs: []string{"WWW.example.com", "*.example.org"}},
+			{FQDNs: []string{"www.example.com"}},
+		},
+	}
+	assert.Equal(t, []string{"*.example.org", "www.example.com"}, getRuleFQDNs(rule))
+	assert.Empty(t, getRuleFQDNs(&v1alpha1.SecurityPolicyRule{}))
+}
+
+func Test_validateRuleFQDNs(t *testing.T) {
+	s := &SecurityPolicyService{Service: common.Service{NSXClient: &nsx.Client{}}}
+	fqdnPeer := v1alpha1.SecurityPolicyPeer{FQDNs: []string{"www.example.com"}}
+	namedPort := intstr.FromString("http")
+
+	tests := []struct {
+		name          string
+		rule          *v1alpha1.SecurityPolicyRule
+		direction     string
+		supported     bool
+		expectedError string
+	}{
+		{
+			name:      "no fqdn",
+			rule:      &v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{{IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}}}},
+			direction: "IN",
+		},
+		{
+			name:      "valid egress fqdn",
+			rule:      &v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			direction: "OUT",
+			supported: true,
+		},
+		{
+			name:          "fqdn in sources",
+			rule:          &v1alpha1.SecurityPolicyRule{From: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			direction:     "IN",
+			supported:     true,
+			expectedError: "fqdns can only be used in the destinations of egress rules",
+		},
+		{
+			name:          "fqdn in ingress rule",
+			rule:          &v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			direction:     "IN",
+			supported:     true,
+			expectedError: "fqdns can only be used in the destinations of egress rules",
+		},
+		{
+			name: "fqdn mixed with other peers",
+			rule: &v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{
+				fqdnPeer,
+				{PodSelector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}},
+			direction:     "OUT",
+			supported:     true,
+			expectedError: "fqdns can't be used with other destinations in the same rule",
+		},
+		{
+			name: "fqdn with named port",
+			rule: &v1alpha1.SecurityPolicyRule{
+				To:    []v1alpha1.SecurityPolicyPeer{fqdnPeer},
+				Ports: []v1alpha1.SecurityPolicyPort{{Protocol: "TCP", Port: namedPort}},
+			},
+			direction:     "OUT",
+			supported:     true,
+			expectedError: "fqdns can't be used with named ports in the same rule",
+		},
+		{
+			name:          "fqdn not supported by NSX",
+			rule:          &v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			direction:     "OUT",
+			supported:     false,
+			expectedError: "fqdns are not supported by the NSX version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(nsx.FQDNFilterFeatureEnabled, func(_ *nsx.Client) bool {
+				return tt.supported
+			})
+			defer patches.Reset()
+
+			err := s.validateRuleFQDNs(tt.rule, tt.direction)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedError)
+			assert.IsType(t, nsxutil.ValidationError{}, err)
+		})
+	}
+}
+
+func Test_buildFQDNContextProfilePath(t *testing.T) {
+	s := &SecurityPolicyService{}
+	vpcInfo := &common.VPCResourceInfo{OrgID: "default", ProjectID: "pro1", VPCID: "vpc1"}
+
+	patches := gomonkey.ApplyFunc(IsVPCEnabled, func(_ *SecurityPolicyService) bool {
+		return false
+	})
+	assert.Equal(t, "/infra/context-profiles/sp_uid_0_fqdn", s.buildFQDNContextProfilePath("sp_uid_0_fqdn", nil, false))
+	patches.Reset()
+
+	patches = gomonkey.ApplyFunc(IsVPCEnabled, func(_ *SecurityPolicyService) bool {
+		return true
+	})
+	defer patches.Reset()
+	assert.Equal(t, "/orgs/default/projects/pro1/infra/context-profiles/sp_uid_0_fqdn", s.buildFQDNContextProfilePath("sp_uid_0_fqdn", vpcInfo, false))
+	assert.Equal(t, "/infra/context-profiles/sp_uid_0_fqdn", s.buildFQDNContextProfilePath("sp_uid_0_fqdn", vpcInfo, true))
+}
+
+func Test_buildDNSSnoopingRule(t *testing.T) {
+	s := &SecurityPolicyService{}
+	tags := []model.Tag{{Scope: String(common.TagScopeRuleID), Tag: String("sp_uid_0")}}
+	fqdnRule := &model.Rule{
+		Id:             String("sp_uid_0_all"),
+		DisplayName:    String("egress-fqdn"),
+		SequenceNumber: Int64(0),
+		Scope:          []string{"/infra/domains/k8scl-one/groups/sp_uid_scope"},
+		Tags:           tags,
+		Logged:         Bool(true),
+	}
+
+	rule := s.buildDNSSnoopingRule(fqdnRule, "sp_uid_0")
+	assert.Equal(t, "sp_uid_0_dns", *rule.Id)
+	assert.Equal(t, "egress-fqdn_dns", *rule.DisplayName)
+	assert.Equal(t, "OUT", *rule.Direction)
+	assert.Equal(t, "ALLOW", *rule.Action)
+	assert.Equal(t, int64(0), *rule.SequenceNumber)
+	assert.Equal(t, []string{"/infra/services/DNS", "/infra/services/DNS-UDP"}, rule.Services)
+	assert.Equal(t, []string{"/infra/context-profiles/DNS"}, rule.Profiles)
+	assert.Equal(t, []string{"ANY"}, rule.SourceGroups)
+	assert.Equal(t, []string{"ANY"}, rule.DestinationGroups)
+	assert.Equal(t, fqdnRule.Scope, rule.Scope)
+	assert.Equal(t, tags, rule.Tags)
+	assert.True(t, *rule.Logged)
+}
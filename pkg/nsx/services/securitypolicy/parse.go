@@ -12,6 +12,11 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
+// ruleActionJumpToApplication is only set on the rules of the internal SecurityPolicies converted from the Pass rules
+// of AdminNetworkPolicies, the matched traffic skips the remaining rules in the Environment category and is evaluated
+// by the NetworkPolicies and SecurityPolicies in the Application category.
+const ruleActionJumpToApplication v1alpha1.RuleAction = "Jump_To_Application"
+
 var validRuleActions = []string{
 	util.ToUpper(v1alpha1.RuleActionAllow),
 	util.ToUpper(v1alpha1.RuleActionDrop),
@@ -25,13 +30,19 @@ var (
 	ruleDirectionOut     = util.ToUpper(v1alpha1.RuleDirectionOut)
 )
 
-func getRuleAction(rule *v1alpha1.SecurityPolicyRule) (string, error) {
+// getRuleAction returns the NSX action of the rule, Jump_To_Application is only accepted on the SecurityPolicies
+// created for AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+func getRuleAction(rule *v1alpha1.SecurityPolicyRule, createdFor string) (string, error) {
 	ruleAction := util.ToUpper(*rule.Action)
 	for _, validRuleAction := range validRuleActions {
 		if ruleAction == validRuleAction {
 			return ruleAction, nil
 		}
 	}
+	if ruleAction == util.ToUpper(ruleActionJumpToApplication) &&
+		(createdFor == common.ResourceTypeAdminNetworkPolicy || createdFor == common.ResourceTypeBaselineAdminNetworkPolicy) {
+		return ruleAction, nil
+	}
 	return "", errors.New("invalid rule action")
 }
 
@@ -47,6 +58,21 @@ func getRuleDirection(rule *v1alpha1.SecurityPolicyRule) (string, error) {
 	}
 }
 
+// getOwnerTagScopes returns the tag scopes of the owner name and UID on the NSX resources created for the given
+// resource type, the UID scope is also used as the index of the resources in the stores.
+func getOwnerTagScopes(createdFor string) (string, string) {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy:
+		return common.TagScopeNetworkPolicyName, common.TagScopeNetworkPolicyUID
+	case common.ResourceTypeAdminNetworkPolicy:
+		return common.TagScopeAdminNetworkPolicyName, common.TagScopeAdminNetworkPolicyUID
+	case common.ResourceTypeBaselineAdminNetworkPolicy:
+		return common.TagScopeBaselineAdminNetworkPolicyName, common.TagScopeAdminNetworkPolicyUID
+	default:
+		return common.TagValueScopeSecurityPolicyName, common.TagValueScopeSecurityPolicyUID
+	}
+}
+
 func getCluster(service *SecurityPolicyService) string {
 	return service.NSXConfig.Cluster
 }
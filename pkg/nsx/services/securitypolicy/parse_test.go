@@ -7,8 +7,65 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 func Test_GetCluster(t *testing.T) {
 	assert.Equal(t, "k8scl-one", getCluster(service))
 }
+
+func Test_GetRuleAction(t *testing.T) {
+	allow := v1alpha1.RuleActionAllow
+	jump := ruleActionJumpToApplication
+	tests := []struct {
+		name           string
+		action         *v1alpha1.RuleAction
+		createdFor     string
+		expectedAction string
+		expectedErr    string
+	}{
+		{
+			name:           "allow-rule-of-security-policy",
+			action:         &allow,
+			createdFor:     common.ResourceTypeSecurityPolicy,
+			expectedAction: "ALLOW",
+		},
+		{
+			name:        "jump-to-application-rule-of-security-policy",
+			action:      &jump,
+			createdFor:  common.ResourceTypeSecurityPolicy,
+			expectedErr: "invalid rule action",
+		},
+		{
+			name:        "jump-to-application-rule-of-network-policy",
+			action:      &jump,
+			createdFor:  common.ResourceTypeNetworkPolicy,
+			expectedErr: "invalid rule action",
+		},
+		{
+			name:           "jump-to-application-rule-of-admin-network-policy",
+			action:         &jump,
+			createdFor:     common.ResourceTypeAdminNetworkPolicy,
+			expectedAction: "JUMP_TO_APPLICATION",
+		},
+		{
+			name:           "jump-to-application-rule-of-baseline-admin-network-policy",
+			action:         &jump,
+			createdFor:     common.ResourceTypeBaselineAdminNetworkPolicy,
+			expectedAction: "JUMP_TO_APPLICATION",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := getRuleAction(&v1alpha1.SecurityPolicyRule{Action: tt.action}, tt.createdFor)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAction, action)
+		})
+	}
+}
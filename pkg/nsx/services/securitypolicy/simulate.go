@@ -0,0 +1,399 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	// groupPathAny is the group path of the NSX rule source, destination or scope which matches any endpoint.
+	groupPathAny = "ANY"
+	// securityPolicyCategoryApplication is the default DFW category of the NSX SecurityPolicies.
+	securityPolicyCategoryApplication = "Application"
+	// VerdictIndeterminate is the verdict of the traffic matching a rule which can't be fully evaluated by the L3/L4
+	// simulation, e.g. an FQDN rule whose context profile decides the traffic by the DNS names.
+	VerdictIndeterminate = "INDETERMINATE"
+)
+
+// securityPolicyCategoryOrder is the evaluation order of the DFW categories used by the operator.
+var securityPolicyCategoryOrder = map[string]int{
+	"Emergency":                       0,
+	"Infrastructure":                  1,
+	securityPolicyCategoryEnvironment: 2,
+	securityPolicyCategoryApplication: 3,
+}
+
+// systemServiceEntries are the service entries of the NSX system-defined services which the operator sets on the
+// rules.
+var systemServiceEntries = map[string]l4ServiceEntry{
+	"/infra/services/DNS":     {ResourceType: "L4PortSetServiceEntry", L4Protocol: "TCP", DestinationPorts: []string{"53"}},
+	"/infra/services/DNS-UDP": {ResourceType: "L4PortSetServiceEntry", L4Protocol: "UDP", DestinationPorts: []string{"53"}},
+}
+
+// SimulationRequest describes the traffic to simulate.
+type SimulationRequest struct {
+	SourceIP      string
+	DestinationIP string
+	// Protocol is the L4 protocol, e.g. TCP, UDP or SCTP.
+	Protocol string
+	// Port is the destination port, 0 matches the rules without services and service entries only.
+	Port int
+}
+
+// RuleMatch is the NSX rule which decides the traffic at one endpoint.
+type RuleMatch struct {
+	PolicyPath  string `json:"policyPath"`
+	RulePath    string `json:"rulePath"`
+	DisplayName string `json:"displayName"`
+	Action      string `json:"action"`
+	Direction   string `json:"direction"`
+	// Owner is the K8s policy which the rule is created for, in the format of "Kind namespace/name".
+	Owner string `json:"owner,omitempty"`
+	// Indeterminate is the reason why the rule may not decide the traffic, e.g. the rule has L7 context profiles.
+	// The rule only matches the traffic by its groups and services if it is set.
+	Indeterminate string `json:"indeterminate,omitempty"`
+}
+
+// SimulationResult is the verdict of the simulated traffic. Egress is the rule matched at the source endpoint and
+// Ingress the rule matched at the destination endpoint, a nil match means the traffic hits the default rule. The
+// verdict is VerdictIndeterminate if a matched rule is indeterminate and the other endpoint doesn't drop the traffic.
+type SimulationResult struct {
+	Verdict string     `json:"verdict"`
+	Egress  *RuleMatch `json:"egress,omitempty"`
+	Ingress *RuleMatch `json:"ingress,omitempty"`
+}
+
+// GroupMemberIPsFunc returns the effective member IPs or CIDRs of the NSX group with the given path.
+type GroupMemberIPsFunc func(groupPath string) ([]string, error)
+
+// Simulate evaluates the NSX rules in the resources against the traffic in the DFW order: by category, then by the
+// sequence number of the SecurityPolicy and of the rule. The egress rules are evaluated at the source endpoint and the
+// ingress rules at the destination endpoint, the traffic is allowed only if both endpoints allow it. The endpoints
+// which are not matched by any rule fall to the default rule, which is assumed to allow the traffic.
+func Simulate(resources *EffectiveResources, memberIPs GroupMemberIPsFunc, req SimulationRequest) (*SimulationResult, error) {
+	srcIP := net.ParseIP(req.SourceIP)
+	dstIP := net.ParseIP(req.DestinationIP)
+	if srcIP == nil || dstIP == nil {
+		return nil, fmt.Errorf("invalid source IP %q or destination IP %q", req.SourceIP, req.DestinationIP)
+	}
+	sim := &simulation{
+		memberIPs:  memberIPs,
+		memberData: map[string][]string{},
+		srcIP:      srcIP,
+		dstIP:      dstIP,
+		protocol:   strings.ToUpper(req.Protocol),
+		port:       req.Port,
+	}
+
+	rulesByPolicy := map[string][]*model.Rule{}
+	for _, rule := range resources.Rules {
+		parentPath := stringValue(rule.ParentPath)
+		if parentPath == "" {
+			parentPath = policyPathOfRule(stringValue(rule.Path))
+		}
+		rulesByPolicy[parentPath] = append(rulesByPolicy[parentPath], rule)
+	}
+	policies := sortPoliciesByCategory(resources.SecurityPolicies)
+
+	result := &SimulationResult{}
+	var err error
+	if result.Egress, err = sim.evaluate(policies, rulesByPolicy, model.Rule_DIRECTION_OUT, srcIP); err != nil {
+		return nil, err
+	}
+	if result.Ingress, err = sim.evaluate(policies, rulesByPolicy, model.Rule_DIRECTION_IN, dstIP); err != nil {
+		return nil, err
+	}
+	result.Verdict = model.Rule_ACTION_ALLOW
+	for _, match := range []*RuleMatch{result.Egress, result.Ingress} {
+		if match == nil {
+			continue
+		}
+		if match.Indeterminate != "" {
+			result.Verdict = VerdictIndeterminate
+			continue
+		}
+		if match.Action != model.Rule_ACTION_ALLOW {
+			result.Verdict = match.Action
+			break
+		}
+	}
+	return result, nil
+}
+
+type simulation struct {
+	memberIPs  GroupMemberIPsFunc
+	memberData map[string][]string
+	srcIP      net.IP
+	dstIP      net.IP
+	protocol   string
+	port       int
+}
+
+// evaluate returns the first rule of the direction which is applied to the endpoint and matches the traffic. A
+// JUMP_TO_APPLICATION rule skips the remaining SecurityPolicies before the Application category. The evaluation stops
+// at the first indeterminate rule as the traffic may or may not hit the rules after it.
+func (s *simulation) evaluate(policies []*model.SecurityPolicy, rulesByPolicy map[string][]*model.Rule, direction string, endpoint net.IP) (*RuleMatch, error) {
+	jumpToApplication := false
+	for _, policy := range policies {
+		if jumpToApplication && policyCategory(policy) != securityPolicyCategoryApplication {
+			continue
+		}
+		policyPath := stringValue(policy.Path)
+		for _, rule := range rulesByPolicy[policyPath] {
+			matched, indeterminate, err := s.matchRule(policy, rule, direction, endpoint)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+			if indeterminate == "" && stringValue(rule.Action) == model.Rule_ACTION_JUMP_TO_APPLICATION {
+				jumpToApplication = true
+				break
+			}
+			return &RuleMatch{
+				PolicyPath:    policyPath,
+				RulePath:      stringValue(rule.Path),
+				DisplayName:   stringValue(rule.DisplayName),
+				Action:        stringValue(rule.Action),
+				Direction:     stringValue(rule.Direction),
+				Owner:         ruleOwner(rule.Tags),
+				Indeterminate: indeterminate,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// matchRule checks if the rule matches the traffic at the endpoint by its direction, groups and services. The reason
+// is returned if the matched rule is indeterminate, i.e. it has context profiles or services unknown to the simulation.
+func (s *simulation) matchRule(policy *model.SecurityPolicy, rule *model.Rule, direction string, endpoint net.IP) (bool, string, error) {
+	if rule.Disabled != nil && *rule.Disabled {
+		return false, "", nil
+	}
+	ruleDirection := stringValue(rule.Direction)
+	if ruleDirection != direction && ruleDirection != model.Rule_DIRECTION_IN_OUT && ruleDirection != "" {
+		return false, "", nil
+	}
+	scope := rule.Scope
+	if isAnyGroup(scope) {
+		scope = policy.Scope
+	}
+	for _, check := range []struct {
+		groups []string
+		ip     net.IP
+	}{
+		{scope, endpoint},
+		{rule.SourceGroups, s.srcIP},
+		{rule.DestinationGroups, s.dstIP},
+	} {
+		matched, err := s.inGroups(check.groups, check.ip)
+		if err != nil || !matched {
+			return false, "", err
+		}
+	}
+	matched, indeterminate, err := s.matchServices(rule.Services, rule.ServiceEntries)
+	if err != nil || !matched {
+		return false, "", err
+	}
+	if indeterminate == "" && !isAnyGroup(rule.Profiles) {
+		indeterminate = fmt.Sprintf("the rule has context profiles %s", strings.Join(rule.Profiles, ", "))
+	}
+	return true, indeterminate, nil
+}
+
+func isAnyGroup(groups []string) bool {
+	return len(groups) == 0 || (len(groups) == 1 && groups[0] == groupPathAny)
+}
+
+// inGroups checks if the IP is an effective member of any of the groups.
+func (s *simulation) inGroups(groups []string, ip net.IP) (bool, error) {
+	if isAnyGroup(groups) {
+		return true, nil
+	}
+	for _, groupPath := range groups {
+		members, ok := s.memberData[groupPath]
+		if !ok {
+			var err error
+			if members, err = s.memberIPs(groupPath); err != nil {
+				return false, fmt.Errorf("failed to get member IPs of group %s: %w", groupPath, err)
+			}
+			s.memberData[groupPath] = members
+		}
+		for _, member := range members {
+			if ipMatches(member, ip) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ipMatches checks if the IP equals to the member IP, or is in the member CIDR or IP range.
+func ipMatches(member string, ip net.IP) bool {
+	if strings.Contains(member, "/") {
+		_, cidr, err := net.ParseCIDR(member)
+		return err == nil && cidr.Contains(ip)
+	}
+	if start, end, found := strings.Cut(member, "-"); found {
+		startIP, endIP := net.ParseIP(start), net.ParseIP(end)
+		if startIP == nil || endIP == nil {
+			return false
+		}
+		return compareIP(ip, startIP) >= 0 && compareIP(ip, endIP) <= 0
+	}
+	memberIP := net.ParseIP(member)
+	return memberIP != nil && memberIP.Equal(ip)
+}
+
+func compareIP(a, b net.IP) int {
+	if a4, b4 := a.To4(), b.To4(); a4 != nil && b4 != nil {
+		a, b = a4, b4
+	}
+	return strings.Compare(string(a.To16()), string(b.To16()))
+}
+
+// l4ServiceEntry is the subset of the L4PortSetServiceEntry fields which the operator sets on the rules.
+type l4ServiceEntry struct {
+	ResourceType     string   `json:"resource_type"`
+	L4Protocol       string   `json:"l4_protocol"`
+	DestinationPorts []string `json:"destination_ports"`
+}
+
+// matchServices checks if the protocol and port match any of the services or the service entries, a rule without
+// services and service entries matches any traffic. The reason is returned if the traffic may match a service unknown
+// to the simulation.
+func (s *simulation) matchServices(services []string, entries []*data.StructValue) (bool, string, error) {
+	if isAnyGroup(services) && len(entries) == 0 {
+		return true, "", nil
+	}
+	var unknownServices []string
+	for _, servicePath := range services {
+		if servicePath == groupPathAny {
+			continue
+		}
+		serviceEntry, ok := systemServiceEntries[servicePath]
+		if !ok {
+			unknownServices = append(unknownServices, servicePath)
+			continue
+		}
+		if s.matchServiceEntry(&serviceEntry) {
+			return true, "", nil
+		}
+	}
+	encoder := cleanjson.NewDataValueToJsonEncoder()
+	for _, entry := range entries {
+		encoded, err := encoder.Encode(entry)
+		if err != nil {
+			return false, "", err
+		}
+		serviceEntry := &l4ServiceEntry{}
+		if err := json.Unmarshal([]byte(encoded), serviceEntry); err != nil {
+			return false, "", err
+		}
+		if s.matchServiceEntry(serviceEntry) {
+			return true, "", nil
+		}
+	}
+	if len(unknownServices) > 0 {
+		return true, fmt.Sprintf("the rule has services %s", strings.Join(unknownServices, ", ")), nil
+	}
+	return false, "", nil
+}
+
+// matchServiceEntry checks if the protocol and port match the L4 port set service entry.
+func (s *simulation) matchServiceEntry(serviceEntry *l4ServiceEntry) bool {
+	if serviceEntry.ResourceType != "L4PortSetServiceEntry" || !strings.EqualFold(serviceEntry.L4Protocol, s.protocol) {
+		return false
+	}
+	if len(serviceEntry.DestinationPorts) == 0 {
+		return true
+	}
+	for _, portRange := range serviceEntry.DestinationPorts {
+		if portInRange(s.port, portRange) {
+			return true
+		}
+	}
+	return false
+}
+
+func portInRange(port int, portRange string) bool {
+	start, end, found := strings.Cut(portRange, "-")
+	if !found {
+		end = start
+	}
+	startPort, err1 := strconv.Atoi(start)
+	endPort, err2 := strconv.Atoi(end)
+	return err1 == nil && err2 == nil && port >= startPort && port <= endPort
+}
+
+func policyCategory(policy *model.SecurityPolicy) string {
+	if policy.Category == nil || *policy.Category == "" {
+		return securityPolicyCategoryApplication
+	}
+	return *policy.Category
+}
+
+// sortPoliciesByCategory orders the SecurityPolicies by category, the SecurityPolicies should be sorted by the
+// sequence number already.
+func sortPoliciesByCategory(policies []*model.SecurityPolicy) []*model.SecurityPolicy {
+	var sorted []*model.SecurityPolicy
+	for order := 0; order < len(securityPolicyCategoryOrder); order++ {
+		for _, policy := range policies {
+			if securityPolicyCategoryOrder[policyCategory(policy)] == order {
+				sorted = append(sorted, policy)
+			}
+		}
+	}
+	return sorted
+}
+
+func policyPathOfRule(rulePath string) string {
+	if idx := strings.LastIndex(rulePath, "/rules/"); idx >= 0 {
+		return rulePath[:idx]
+	}
+	return ""
+}
+
+// ruleOwner returns the K8s policy which the rule is created for from the rule tags.
+func ruleOwner(tags []model.Tag) string {
+	namespace := nsxutil.FindTag(tags, common.TagScopeNamespace)
+	for _, owner := range []struct {
+		kind  string
+		scope string
+	}{
+		{common.ResourceTypeSecurityPolicy, common.TagScopeSecurityPolicyName},
+		{common.ResourceTypeSecurityPolicy, common.TagScopeSecurityPolicyCRName},
+		{common.ResourceTypeNetworkPolicy, common.TagScopeNetworkPolicyName},
+		{common.ResourceTypeAdminNetworkPolicy, common.TagScopeAdminNetworkPolicyName},
+		{common.ResourceTypeBaselineAdminNetworkPolicy, common.TagScopeBaselineAdminNetworkPolicyName},
+	} {
+		if name := nsxutil.FindTag(tags, owner.scope); name != "" {
+			if namespace == "" {
+				return fmt.Sprintf("%s %s", owner.kind, name)
+			}
+			return fmt.Sprintf("%s %s/%s", owner.kind, namespace, name)
+		}
+	}
+	return ""
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
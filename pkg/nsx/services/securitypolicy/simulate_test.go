@@ -0,0 +1,294 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestSimulate(t *testing.T) {
+	const (
+		spPath     = "/orgs/default/projects/p1/vpcs/vpc1/security-policies/sp1"
+		envPath    = "/orgs/default/projects/p1/vpcs/vpc1/security-policies/env"
+		podAGroup  = "/orgs/default/projects/p1/vpcs/vpc1/groups/pod-a"
+		podBGroup  = "/orgs/default/projects/p1/vpcs/vpc1/groups/pod-b"
+		cidrGroup  = "/orgs/default/projects/p1/vpcs/vpc1/groups/cidr"
+		rangeGroup = "/orgs/default/projects/p1/vpcs/vpc1/groups/range"
+	)
+	members := map[string][]string{
+		podAGroup:  {"10.0.0.1"},
+		podBGroup:  {"10.0.0.2"},
+		cidrGroup:  {"10.0.0.0/24"},
+		rangeGroup: {"10.0.1.10-10.0.1.20"},
+	}
+	memberIPs := func(groupPath string) ([]string, error) {
+		return members[groupPath], nil
+	}
+	tcpPort := func(port, endPort int) []*data.StructValue {
+		return []*data.StructValue{buildRuleServiceEntries(v1alpha1.SecurityPolicyPort{
+			Protocol: corev1.ProtocolTCP, Port: intstr.FromInt(port), EndPort: endPort,
+		})}
+	}
+	rule := func(id, direction, action string, seq int64, scope, src, dst []string, services []*data.StructValue) *model.Rule {
+		return &model.Rule{
+			Id:                String(id),
+			DisplayName:       String(id),
+			Path:              String(spPath + "/rules/" + id),
+			Direction:         String(direction),
+			Action:            String(action),
+			SequenceNumber:    common.Int64(seq),
+			Scope:             scope,
+			SourceGroups:      src,
+			DestinationGroups: dst,
+			ServiceEntries:    services,
+			Tags: []model.Tag{
+				{Scope: String(common.TagScopeNamespace), Tag: String("ns1")},
+				{Scope: String(common.TagScopeSecurityPolicyCRName), Tag: String("sp1")},
+			},
+		}
+	}
+	policy := &model.SecurityPolicy{Id: String("sp1"), Path: String(spPath), Scope: []string{groupPathAny}}
+	anyGroup := []string{groupPathAny}
+
+	tests := []struct {
+		name            string
+		rules           []*model.Rule
+		policies        []*model.SecurityPolicy
+		req             SimulationRequest
+		expectedVerdict string
+		expectedEgress  string
+		expectedIngress string
+	}{
+		{
+			name:            "no rules falls to the default rule",
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 80},
+			expectedVerdict: model.Rule_ACTION_ALLOW,
+		},
+		{
+			name: "ingress allowed on port",
+			rules: []*model.Rule{
+				rule("allow-80", model.Rule_DIRECTION_IN, model.Rule_ACTION_ALLOW, 1, []string{podBGroup}, []string{podAGroup}, anyGroup, tcpPort(80, 0)),
+				rule("drop-all", model.Rule_DIRECTION_IN, model.Rule_ACTION_DROP, 2, []string{podBGroup}, anyGroup, anyGroup, nil),
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "tcp", Port: 80},
+			expectedVerdict: model.Rule_ACTION_ALLOW,
+			expectedIngress: "allow-80",
+		},
+		{
+			name: "ingress dropped on other port",
+			rules: []*model.Rule{
+				rule("allow-80", model.Rule_DIRECTION_IN, model.Rule_ACTION_ALLOW, 1, []string{podBGroup}, []string{podAGroup}, anyGroup, tcpPort(80, 0)),
+				rule("drop-all", model.Rule_DIRECTION_IN, model.Rule_ACTION_DROP, 2, []string{podBGroup}, anyGroup, anyGroup, nil),
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 443},
+			expectedVerdict: model.Rule_ACTION_DROP,
+			expectedIngress: "drop-all",
+		},
+		{
+			name: "egress rejected by CIDR and port range",
+			rules: []*model.Rule{
+				rule("reject-range", model.Rule_DIRECTION_OUT, model.Rule_ACTION_REJECT, 1, anyGroup, anyGroup, []string{cidrGroup}, tcpPort(8000, 9000)),
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 8080},
+			expectedVerdict: model.Rule_ACTION_REJECT,
+			expectedEgress:  "reject-range",
+		},
+		{
+			name: "destination out of IP range",
+			rules: []*model.Rule{
+				rule("drop-range", model.Rule_DIRECTION_OUT, model.Rule_ACTION_DROP, 1, anyGroup, anyGroup, []string{rangeGroup}, nil),
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 80},
+			expectedVerdict: model.Rule_ACTION_ALLOW,
+		},
+		{
+			name: "jump to application skips the environment category",
+			rules: []*model.Rule{
+				{
+					Id: String("jump"), Path: String(envPath + "/rules/jump"), Direction: String(model.Rule_DIRECTION_IN),
+					Action: String(model.Rule_ACTION_JUMP_TO_APPLICATION), SequenceNumber: common.Int64(1),
+				},
+				{
+					Id: String("env-drop"), Path: String(envPath + "/rules/env-drop"), Direction: String(model.Rule_DIRECTION_IN),
+					Action: String(model.Rule_ACTION_DROP), SequenceNumber: common.Int64(2),
+				},
+				rule("allow-all", model.Rule_DIRECTION_IN, model.Rule_ACTION_ALLOW, 1, anyGroup, anyGroup, anyGroup, nil),
+			},
+			policies: []*model.SecurityPolicy{
+				policy,
+				{Id: String("env"), Path: String(envPath), Category: String(securityPolicyCategoryEnvironment)},
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 80},
+			expectedVerdict: model.Rule_ACTION_ALLOW,
+			expectedIngress: "allow-all",
+		},
+		{
+			name: "disabled rule is skipped",
+			rules: []*model.Rule{
+				func() *model.Rule {
+					r := rule("drop-all", model.Rule_DIRECTION_IN_OUT, model.Rule_ACTION_DROP, 1, anyGroup, anyGroup, anyGroup, nil)
+					r.Disabled = Bool(true)
+					return r
+				}(),
+			},
+			req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2", Protocol: "TCP", Port: 80},
+			expectedVerdict: model.Rule_ACTION_ALLOW,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := tt.policies
+			if policies == nil {
+				policies = []*model.SecurityPolicy{policy}
+			}
+			result, err := Simulate(&EffectiveResources{SecurityPolicies: policies, Rules: tt.rules}, memberIPs, tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVerdict, result.Verdict)
+			for _, check := range []struct {
+				expected string
+				match    *RuleMatch
+			}{
+				{tt.expectedEgress, result.Egress},
+				{tt.expectedIngress, result.Ingress},
+			} {
+				if check.expected == "" {
+					assert.Nil(t, check.match)
+					continue
+				}
+				require.NotNil(t, check.match)
+				assert.Equal(t, check.expected, check.match.DisplayName)
+			}
+		})
+	}
+
+	t.Run("rule owner", func(t *testing.T) {
+		rules := []*model.Rule{rule("drop-all", model.Rule_DIRECTION_IN, model.Rule_ACTION_DROP, 1, anyGroup, anyGroup, anyGroup, nil)}
+		result, err := Simulate(&EffectiveResources{SecurityPolicies: []*model.SecurityPolicy{policy}, Rules: rules}, memberIPs,
+			SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2"})
+		require.NoError(t, err)
+		require.NotNil(t, result.Ingress)
+		assert.Equal(t, "SecurityPolicy ns1/sp1", result.Ingress.Owner)
+		assert.Equal(t, spPath, result.Ingress.PolicyPath)
+	})
+
+	t.Run("FQDN rules", func(t *testing.T) {
+		fqdnRule := rule("fqdn", model.Rule_DIRECTION_OUT, model.Rule_ACTION_ALLOW, 1, anyGroup, anyGroup, anyGroup, tcpPort(443, 0))
+		fqdnRule.Profiles = []string{"/orgs/default/projects/p1/vpcs/vpc1/context-profiles/sp1_fqdn"}
+		dnsRule := rule("fqdn-dns", model.Rule_DIRECTION_OUT, model.Rule_ACTION_ALLOW, 1, anyGroup, anyGroup, anyGroup, nil)
+		dnsRule.Services = dnsSnoopingServicePaths
+		dnsRule.Profiles = []string{dnsSnoopingContextProfilePath}
+		dropEgress := rule("drop-egress", model.Rule_DIRECTION_OUT, model.Rule_ACTION_DROP, 2, anyGroup, anyGroup, anyGroup, nil)
+		dropIngress := rule("drop-ingress", model.Rule_DIRECTION_IN, model.Rule_ACTION_DROP, 3, anyGroup, anyGroup, anyGroup, nil)
+		httpRule := rule("http", model.Rule_DIRECTION_OUT, model.Rule_ACTION_ALLOW, 1, anyGroup, anyGroup, anyGroup, nil)
+		httpRule.Services = []string{"/infra/services/HTTP"}
+
+		tests := []struct {
+			name            string
+			rules           []*model.Rule
+			req             SimulationRequest
+			expectedVerdict string
+			expectedEgress  string
+			expectedIngress string
+		}{
+			{
+				name:            "FQDN rule is indeterminate",
+				rules:           []*model.Rule{fqdnRule, dnsRule, dropEgress},
+				req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "192.168.0.1", Protocol: "TCP", Port: 443},
+				expectedVerdict: VerdictIndeterminate,
+				expectedEgress:  "fqdn",
+			},
+			{
+				name:            "DNS snooping rule is indeterminate on DNS traffic",
+				rules:           []*model.Rule{fqdnRule, dnsRule, dropEgress},
+				req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "192.168.0.1", Protocol: "UDP", Port: 53},
+				expectedVerdict: VerdictIndeterminate,
+				expectedEgress:  "fqdn-dns",
+			},
+			{
+				name:            "DNS snooping rule doesn't match other traffic",
+				rules:           []*model.Rule{fqdnRule, dnsRule, dropEgress},
+				req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "192.168.0.1", Protocol: "TCP", Port: 80},
+				expectedVerdict: model.Rule_ACTION_DROP,
+				expectedEgress:  "drop-egress",
+			},
+			{
+				name:            "drop at the other endpoint decides the traffic",
+				rules:           []*model.Rule{fqdnRule, dnsRule, dropIngress},
+				req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "192.168.0.1", Protocol: "TCP", Port: 443},
+				expectedVerdict: model.Rule_ACTION_DROP,
+				expectedEgress:  "fqdn",
+				expectedIngress: "drop-ingress",
+			},
+			{
+				name:            "unknown service is indeterminate",
+				rules:           []*model.Rule{httpRule, dropEgress},
+				req:             SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "192.168.0.1", Protocol: "TCP", Port: 80},
+				expectedVerdict: VerdictIndeterminate,
+				expectedEgress:  "http",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := Simulate(&EffectiveResources{SecurityPolicies: []*model.SecurityPolicy{policy}, Rules: tt.rules}, memberIPs, tt.req)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedVerdict, result.Verdict)
+				require.NotNil(t, result.Egress)
+				assert.Equal(t, tt.expectedEgress, result.Egress.DisplayName)
+				assert.Equal(t, tt.expectedEgress != "drop-egress", result.Egress.Indeterminate != "")
+				if tt.expectedIngress == "" {
+					assert.Nil(t, result.Ingress)
+					return
+				}
+				require.NotNil(t, result.Ingress)
+				assert.Equal(t, tt.expectedIngress, result.Ingress.DisplayName)
+				assert.Empty(t, result.Ingress.Indeterminate)
+			})
+		}
+	})
+
+	t.Run("invalid IP", func(t *testing.T) {
+		_, err := Simulate(&EffectiveResources{}, memberIPs, SimulationRequest{SourceIP: "pod-a", DestinationIP: "10.0.0.2"})
+		assert.Error(t, err)
+	})
+
+	t.Run("group member IPs error", func(t *testing.T) {
+		rules := []*model.Rule{rule("allow", model.Rule_DIRECTION_IN, model.Rule_ACTION_ALLOW, 1, []string{podBGroup}, anyGroup, anyGroup, nil)}
+		_, err := Simulate(&EffectiveResources{SecurityPolicies: []*model.SecurityPolicy{policy}, Rules: rules},
+			func(string) ([]string, error) { return nil, errors.New("nsx error") },
+			SimulationRequest{SourceIP: "10.0.0.1", DestinationIP: "10.0.0.2"})
+		assert.ErrorContains(t, err, "nsx error")
+	})
+}
+
+func TestIPMatches(t *testing.T) {
+	tests := []struct {
+		member   string
+		ip       string
+		expected bool
+	}{
+		{"10.0.0.1", "10.0.0.1", true},
+		{"10.0.0.1", "10.0.0.2", false},
+		{"10.0.0.0/24", "10.0.0.200", true},
+		{"10.0.0.0/24", "10.0.1.1", false},
+		{"10.0.0.10-10.0.0.20", "10.0.0.15", true},
+		{"10.0.0.10-10.0.0.20", "10.0.0.21", false},
+		{"fd00::/64", "fd00::1", true},
+		{"invalid", "10.0.0.1", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ipMatches(tt.member, net.ParseIP(tt.ip)), "%s in %s", tt.ip, tt.member)
+	}
+}
@@ -12,8 +12,9 @@ import (
 )
 
 const (
-	SPIndexByUUIDAndRuleHashFuncKey = "SPIndexByUUIDRuleHash"
-	NPIndexByUUIDAndRuleHashFuncKey = "NPIndexByUUIDRuleHash"
+	SPIndexByUUIDAndRuleHashFuncKey  = "SPIndexByUUIDRuleHash"
+	NPIndexByUUIDAndRuleHashFuncKey  = "NPIndexByUUIDRuleHash"
+	ANPIndexByUUIDAndRuleHashFuncKey = "ANPIndexByUUIDRuleHash"
 )
 
 // keyFunc is used to get the key of a resource, usually, which is the ID of the resource
@@ -27,6 +28,8 @@ func keyFunc(obj interface{}) (string, error) {
 		return *v.Id, nil
 	case *model.Share:
 		return *v.Id, nil
+	case *model.PolicyContextProfile:
+		return *v.Id, nil
 	default:
 		return "", errors.New("keyFunc doesn't support unknown type")
 	}
@@ -54,6 +57,8 @@ func indexBySecurityPolicyUID(obj interface{}) ([]string, error) {
 		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
 	case *model.Share:
 		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
+	case *model.PolicyContextProfile:
+		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
 	default:
 		return nil, errors.New("indexBySecurityPolicyUID doesn't support unknown type")
 	}
@@ -69,11 +74,30 @@ func indexByNetworkPolicyUID(obj interface{}) ([]string, error) {
 		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
 	case *model.Share:
 		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
+	case *model.PolicyContextProfile:
+		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
 	default:
 		return nil, errors.New("indexByNetworkPolicyUID doesn't support unknown type")
 	}
 }
 
+func indexByAdminNetworkPolicyUID(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.SecurityPolicy:
+		return filterTag(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	case *model.Group:
+		return filterTag(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	case *model.Rule:
+		return filterTag(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	case *model.Share:
+		return filterTag(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	case *model.PolicyContextProfile:
+		return filterTag(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	default:
+		return nil, errors.New("indexByAdminNetworkPolicyUID doesn't support unknown type")
+	}
+}
+
 func indexGroupFunc(obj interface{}) ([]string, error) {
 	res := make([]string, 0, 5)
 	switch o := obj.(type) {
@@ -153,6 +177,15 @@ func indexNPByUUIDAndRuleHash(obj interface{}) ([]string, error) {
 	}
 }
 
+func indexANPByUUIDAndRuleHash(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.Rule:
+		return filterRuleHash(o.Tags, common.TagScopeAdminNetworkPolicyUID), nil
+	default:
+		return nil, errors.New("indexANPByUUIDAndRuleHash doesn't support unknown type")
+	}
+}
+
 func (ruleStore *RuleStore) GetByIndexUUIDAndHash(key string, uuid, hash string) []*model.Rule {
 	value := uuid + ":" + hash
 	rules := make([]*model.Rule, 0)
@@ -183,6 +216,11 @@ type ShareStore struct {
 	common.ResourceStore
 }
 
+// ContextProfileStore is a store for the FQDN context profiles referenced by security policy rule
+type ContextProfileStore struct {
+	common.ResourceStore
+}
+
 func (securityPolicyStore *SecurityPolicyStore) Apply(i interface{}) error {
 	if i == nil {
 		return nil
@@ -356,3 +394,39 @@ func (shareStore *ShareStore) DeleteMultipleObjects(shares []*model.Share) {
 		shareStore.Delete(share)
 	}
 }
+
+func (contextProfileStore *ContextProfileStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	profiles, ok := i.(*[]model.PolicyContextProfile)
+	if !ok || profiles == nil {
+		return nil
+	}
+	for _, profile := range *profiles {
+		tempProfile := profile
+		if profile.MarkedForDelete != nil && *profile.MarkedForDelete {
+			err := contextProfileStore.Delete(&tempProfile)
+			log.Debug("Delete context profile from store", "contextProfile", tempProfile)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := contextProfileStore.Add(&tempProfile)
+			log.Debug("Add context profile to store", "contextProfile", tempProfile)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (contextProfileStore *ContextProfileStore) GetByIndex(key string, value string) []*model.PolicyContextProfile {
+	profiles := make([]*model.PolicyContextProfile, 0)
+	objs := contextProfileStore.ResourceStore.GetByIndex(key, value)
+	for _, profile := range objs {
+		profiles = append(profiles, profile.(*model.PolicyContextProfile))
+	}
+	return profiles
+}
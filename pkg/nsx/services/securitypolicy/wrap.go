@@ -365,3 +365,37 @@ func (service *SecurityPolicyService) wrapHierarchyInfraResources(shares []model
 	}
 	return wrapInfra, nil
 }
+
+// wrapOrgRootProjectInfra wrap the project infra children into one hierarchy resource tree for OrgRootClient to patch.
+func (service *SecurityPolicyService) wrapOrgRootProjectInfra(projectInfraChildren []*data.StructValue, orgID, projectID string) (*model.OrgRoot, error) {
+	resourceType := common.ResourceTypeChildResourceReference
+	projectTargetType := common.ResourceTypeProject
+	childProject := model.ChildResourceReference{
+		Id:           &projectID,
+		ResourceType: resourceType,
+		TargetType:   &projectTargetType,
+		Children:     projectInfraChildren,
+	}
+	projectValue, errors := NewConverter().ConvertToVapi(childProject, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	orgTargetType := common.ResourceTypeOrg
+	childOrg := model.ChildResourceReference{
+		Id:           &orgID,
+		ResourceType: resourceType,
+		TargetType:   &orgTargetType,
+		Children:     []*data.StructValue{projectValue.(*data.StructValue)},
+	}
+	orgValue, errors := NewConverter().ConvertToVapi(childOrg, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	orgRootType := common.ResourceTypeOrgRoot
+	return &model.OrgRoot{
+		Children:     []*data.StructValue{orgValue.(*data.StructValue)},
+		ResourceType: &orgRootType,
+	}, nil
+}
@@ -32,7 +32,7 @@ func (service *StaticRouteService) CleanupVPCChildResources(ctx context.Context,
 	routes := make([]*model.StaticRoutes, 0)
 	MarkedForDelete := true
 	// Mark the resources for delete.
-	for _, obj := range service.StaticRouteStore.List() {
+	for _, obj := range service.CleanupFilter.Filter(service.StaticRouteStore.List()) {
 		route := obj.(*model.StaticRoutes)
 		route.MarkedForDelete = &MarkedForDelete
 		routes = append(routes, route)
@@ -42,3 +42,15 @@ func (service *StaticRouteService) CleanupVPCChildResources(ctx context.Context,
 		service.StaticRouteStore.DeleteMultipleObjects(deletedObjs)
 	})
 }
+
+// PlanCleanupVPCChildResources returns the paths of the NSX StaticRoutes which CleanupVPCChildResources would delete with
+// the given vpcPath.
+func (service *StaticRouteService) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	objs := service.CleanupFilter.Filter(service.StaticRouteStore.List())
+	if vpcPath != "" {
+		objs = service.StaticRouteStore.GetByIndex(common.IndexByVPCPathFuncKey, vpcPath)
+	}
+	return map[string][]string{
+		common.ResourceTypeStaticRoutes: common.ListNSXResourcePaths(objs),
+	}, nil
+}
@@ -47,6 +47,7 @@ func InitializeStaticRoute(commonService common.Service, vpcService common.VPCSe
 	staticRouteService.IPAllocationService = ipAllocationService
 
 	go staticRouteService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeStaticRoutes, nil, staticRouteService.StaticRouteStore)
+	common.RegisterStoreMetrics(common.ResourceTypeStaticRoutes, &staticRouteService.StaticRouteStore.ResourceStore)
 
 	go func() {
 		wg.Wait()
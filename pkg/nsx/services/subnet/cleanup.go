@@ -28,7 +28,7 @@ func (service *SubnetService) CleanupVPCChildResources(ctx context.Context, vpcP
 
 	subnets := make([]*model.VpcSubnet, 0)
 	// Mark the resources for delete.
-	for _, obj := range service.SubnetStore.List() {
+	for _, obj := range service.CleanupFilter.Filter(service.SubnetStore.List()) {
 		subnet := obj.(*model.VpcSubnet)
 		subnet.MarkedForDelete = &MarkedForDelete
 		subnets = append(subnets, subnet)
@@ -39,3 +39,15 @@ func (service *SubnetService) CleanupVPCChildResources(ctx context.Context, vpcP
 		service.SubnetStore.DeleteMultipleObjects(deletedObjects)
 	})
 }
+
+// PlanCleanupVPCChildResources returns the paths of the NSX VpcSubnets which CleanupVPCChildResources would delete with
+// the given vpcPath.
+func (service *SubnetService) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	objs := service.CleanupFilter.Filter(service.SubnetStore.List())
+	if vpcPath != "" {
+		objs = service.SubnetStore.GetByIndex(common.IndexByVPCPathFuncKey, vpcPath)
+	}
+	return map[string][]string{
+		common.ResourceTypeSubnet: common.ListNSXResourcePaths(objs),
+	}, nil
+}
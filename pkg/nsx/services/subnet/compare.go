@@ -18,7 +18,8 @@ func (subnet *Subnet) Key() string {
 }
 
 func (subnet *Subnet) Value() data.DataValue {
-	// IPv4SubnetSize/AccessMode/IPAddresses are immutable field,
+	// IPv4SubnetSize/IPAddresses are not compared as an increased IPv4SubnetSize is applied by ExpandSubnet,
+	// AccessMode is immutable.
 	// Changes of tags, subnetDHCPConfig, subnetDHCPv6Config, and IPAddressType are considered as changed.
 	// TODO AccessMode may also need to be compared in future.
 	var advancedConfig *model.SubnetAdvancedConfig
@@ -78,3 +79,18 @@ func (subnet *Subnet) Value() data.DataValue {
 func SubnetToComparable(subnet *model.VpcSubnet) Comparable {
 	return (*Subnet)(subnet)
 }
+
+// DriftTarget returns the target to audit the Subnets in the store against NSX.
+func (service *SubnetService) DriftTarget() common.DriftTarget {
+	return common.DriftTarget{
+		ResourceType: ResourceTypeSubnet,
+		Store:        &service.SubnetStore.ResourceStore,
+		Inspect: func(obj interface{}) (string, []model.Tag, common.Comparable) {
+			subnet := obj.(*model.VpcSubnet)
+			if subnet.Path == nil {
+				return "", nil, nil
+			}
+			return *subnet.Path, subnet.Tags, SubnetToComparable(subnet)
+		},
+	}
+}
@@ -0,0 +1,111 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnet
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"net"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// ErrSubnetNotExpandable is returned when the IPv4 CIDR of the NSX Subnet can't be expanded in place, retrying
+// won't help until the Subnet spec is changed.
+var ErrSubnetNotExpandable = errors.New("subnet cannot be expanded")
+
+// SubnetNeedsExpansion checks if the Subnet CR requests a larger IPv4 size than the CIDR of its realized NSX Subnet.
+// The Subnet with user specified IPv4 CIDR is never expanded.
+func SubnetNeedsExpansion(subnet *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) bool {
+	if nsxSubnet == nil || !util.IPAddressTypeIncludesIPv4(subnet.Spec.IPAddressType) || util.ContainsIPv4CIDR(subnet.Spec.IPAddresses) {
+		return false
+	}
+	size := util.IPv4CIDRSize(nsxSubnet.IpAddresses)
+	return size > 0 && subnet.Spec.IPv4SubnetSize > size
+}
+
+// buildExpandedIPAddresses replaces the IPv4 CIDR with the CIDR of the given size starting from the same network
+// address, so that the existing IPs, the gateway and the DHCP server address are kept. The IPv6 CIDR is not changed.
+func buildExpandedIPAddresses(ipAddresses []string, size int) ([]string, error) {
+	if !util.IsPowerOfTwo(size) {
+		return nil, fmt.Errorf("%w: size %d is not a power of 2", ErrSubnetNotExpandable, size)
+	}
+	prefixLength := net.IPv4len*8 - (bits.Len(uint(size)) - 1)
+	expanded := make([]string, 0, len(ipAddresses))
+	for _, address := range ipAddresses {
+		_, ipNet, err := net.ParseCIDR(address)
+		if err != nil || ipNet.IP.To4() == nil {
+			expanded = append(expanded, address)
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones < prefixLength {
+			return nil, fmt.Errorf("%w: CIDR %s is larger than size %d", ErrSubnetNotExpandable, address, size)
+		}
+		mask := net.CIDRMask(prefixLength, net.IPv4len*8)
+		if !ipNet.IP.Mask(mask).Equal(ipNet.IP) {
+			return nil, fmt.Errorf("%w: CIDR %s is not aligned to size %d", ErrSubnetNotExpandable, address, size)
+		}
+		expanded = append(expanded, (&net.IPNet{IP: ipNet.IP, Mask: mask}).String())
+	}
+	return expanded, nil
+}
+
+// ValidateSubnetExpansion checks if the realized IPv4 CIDR of the Subnet can be expanded in place to the size.
+func ValidateSubnetExpansion(networkAddresses []string, size int) error {
+	_, err := buildExpandedIPAddresses(networkAddresses, size)
+	return err
+}
+
+// ExpandSubnet expands the IPv4 CIDR of the realized NSX Subnet to the Spec.IPv4SubnetSize of the Subnet CR in place.
+// The NSX SubnetPorts are kept on the NSX Subnet with their IPs as the expanded CIDR contains the original one.
+// NSX rejects the expansion if the expanded CIDR overlaps with other Subnets in the VPC.
+func (service *SubnetService) ExpandSubnet(subnet *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) (*model.VpcSubnet, error) {
+	if !service.NSXClient.NSXCheckVersion(nsx.SubnetExpansion) {
+		err := fmt.Errorf("%w: NSX doesn't support the Subnet expansion", ErrSubnetNotExpandable)
+		log.Error(err, "Failed to expand Subnet", "Subnet", subnet.Namespace+"/"+subnet.Name, "ID", *nsxSubnet.Id)
+		return nil, err
+	}
+	ipAddresses, err := buildExpandedIPAddresses(nsxSubnet.IpAddresses, subnet.Spec.IPv4SubnetSize)
+	if err != nil {
+		log.Error(err, "Failed to build expanded CIDR for Subnet", "Subnet", subnet.Namespace+"/"+subnet.Name, "ID", *nsxSubnet.Id)
+		return nil, err
+	}
+	vpcInfo, err := common.ParseVPCResourcePath(*nsxSubnet.Path)
+	if err != nil {
+		return nil, err
+	}
+	// Avoid modification on nsxSubnet to ensure Subnet store is only updated after the expansion succeeds.
+	expandedSubnet := *nsxSubnet
+	expandedSubnet.IpAddresses = ipAddresses
+	expandedSubnet.Ipv4SubnetSize = Int64(int64(subnet.Spec.IPv4SubnetSize))
+	err = service.NSXClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, expandedSubnet)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to expand nsxSubnet", "ID", *nsxSubnet.Id, "ipAddresses", ipAddresses)
+		return nil, err
+	}
+	if expandedSubnet, err = service.NSXClient.SubnetsClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id); err != nil {
+		return nil, nsxutil.TransNSXApiError(err)
+	}
+	// Unlike the creation, the Subnet is not deleted on the realization failure as it may still have SubnetPorts.
+	realizeService := realizestate.InitializeRealizeState(service.Service)
+	if err = realizeService.CheckRealizeState(util.NSXTRealizeRetry, *expandedSubnet.Path, []string{}); err != nil {
+		log.Error(err, "Failed to check expanded Subnet realization state", "ID", *nsxSubnet.Id)
+		return nil, err
+	}
+	if err = service.SubnetStore.Apply(&expandedSubnet); err != nil {
+		log.Error(err, "Failed to add expanded nsxSubnet to store", "ID", *nsxSubnet.Id)
+		return nil, err
+	}
+	log.Info("Successfully expanded nsxSubnet", "ID", *nsxSubnet.Id, "ipAddresses", expandedSubnet.IpAddresses)
+	return &expandedSubnet, nil
+}
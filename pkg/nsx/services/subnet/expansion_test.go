@@ -0,0 +1,197 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnet
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+)
+
+func TestSubnetNeedsExpansion(t *testing.T) {
+	nsxSubnet := &model.VpcSubnet{IpAddresses: []string{"10.0.0.0/27", "2001:db8::/64"}}
+	tests := []struct {
+		name      string
+		spec      v1alpha1.SubnetSpec
+		nsxSubnet *model.VpcSubnet
+		expected  bool
+	}{
+		{
+			name:      "SizeIncreased",
+			spec:      v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4IPv6, IPv4SubnetSize: 64},
+			nsxSubnet: nsxSubnet,
+			expected:  true,
+		},
+		{
+			name:      "SizeUnchanged",
+			spec:      v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4IPv6, IPv4SubnetSize: 32},
+			nsxSubnet: nsxSubnet,
+		},
+		{
+			name:      "IPv6Only",
+			spec:      v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv6, IPv4SubnetSize: 64},
+			nsxSubnet: nsxSubnet,
+		},
+		{
+			name:      "UserSpecifiedCIDR",
+			spec:      v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4, IPv4SubnetSize: 64, IPAddresses: []string{"10.0.0.0/27"}},
+			nsxSubnet: nsxSubnet,
+		},
+		{
+			name:      "NoRealizedCIDR",
+			spec:      v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4, IPv4SubnetSize: 64},
+			nsxSubnet: &model.VpcSubnet{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SubnetNeedsExpansion(&v1alpha1.Subnet{Spec: tt.spec}, tt.nsxSubnet))
+		})
+	}
+}
+
+func TestBuildExpandedIPAddresses(t *testing.T) {
+	tests := []struct {
+		name        string
+		ipAddresses []string
+		size        int
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:        "Expanded",
+			ipAddresses: []string{"10.0.0.64/27", "2001:db8::/64"},
+			size:        64,
+			expected:    []string{"10.0.0.64/26", "2001:db8::/64"},
+		},
+		{
+			name:        "NotAligned",
+			ipAddresses: []string{"10.0.0.32/27"},
+			size:        64,
+			expectedErr: "CIDR 10.0.0.32/27 is not aligned to size 64",
+		},
+		{
+			name:        "NotPowerOfTwo",
+			ipAddresses: []string{"10.0.0.0/27"},
+			size:        48,
+			expectedErr: "size 48 is not a power of 2",
+		},
+		{
+			name:        "Shrunk",
+			ipAddresses: []string{"10.0.0.0/26"},
+			size:        32,
+			expectedErr: "CIDR 10.0.0.0/26 is larger than size 32",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ipAddresses, err := buildExpandedIPAddresses(tt.ipAddresses, tt.size)
+			if tt.expectedErr != "" {
+				assert.ErrorIs(t, err, ErrSubnetNotExpandable)
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ipAddresses)
+		})
+	}
+}
+
+func TestSubnetService_ExpandSubnet(t *testing.T) {
+	nsxSubnet := &model.VpcSubnet{
+		Id:             common.String("subnet-1"),
+		Path:           common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1"),
+		IpAddresses:    []string{"10.0.0.0/27"},
+		Ipv4SubnetSize: common.Int64(32),
+	}
+	subnet := &v1alpha1.Subnet{Spec: v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4, IPv4SubnetSize: 64}}
+	tests := []struct {
+		name         string
+		notSupported bool
+		patchErr     error
+		realizeErr   error
+		expectedErr  string
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:         "NotSupported",
+			notSupported: true,
+			expectedErr:  "NSX doesn't support the Subnet expansion",
+		},
+		{
+			name:        "PatchFailure",
+			patchErr:    errors.New("overlapped with other Subnets"),
+			expectedErr: "overlapped with other Subnets",
+		},
+		{
+			name:        "RealizationFailure",
+			realizeErr:  errors.New("realization failed"),
+			expectedErr: "realization failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &SubnetService{
+				Service: common.Service{
+					NSXClient: &nsx.Client{SubnetsClient: &fakeSubnetsClient{}},
+				},
+				SubnetStore: buildSubnetStore(),
+			}
+			require.NoError(t, service.SubnetStore.Apply(nsxSubnet))
+
+			var patched model.VpcSubnet
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Patch", func(_ *fakeSubnetsClient, _, _, _, _ string, obj model.VpcSubnet) error {
+				patched = obj
+				return tt.patchErr
+			})
+			defer patches.Reset()
+			patches.ApplyMethod(reflect.TypeOf(&nsx.Client{}), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+				return feature == nsx.SubnetExpansion && !tt.notSupported
+			})
+			patches.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Get", func(_ *fakeSubnetsClient, _, _, _, _ string) (model.VpcSubnet, error) {
+				return patched, nil
+			})
+			deleted := false
+			patches.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Delete", func(_ *fakeSubnetsClient, _, _, _, _ string) error {
+				deleted = true
+				return nil
+			})
+			patches.ApplyMethod(reflect.TypeOf(&realizestate.RealizeStateService{}), "CheckRealizeState", func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+				return tt.realizeErr
+			})
+
+			expandedSubnet, err := service.ExpandSubnet(subnet, nsxSubnet)
+			// The Subnet is never deleted on the expansion failure.
+			assert.False(t, deleted)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				if tt.notSupported {
+					assert.ErrorIs(t, err, ErrSubnetNotExpandable)
+					assert.Nil(t, patched.IpAddresses)
+				}
+				assert.Equal(t, []string{"10.0.0.0/27"}, service.SubnetStore.GetByKey("subnet-1").IpAddresses)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"10.0.0.0/26"}, expandedSubnet.IpAddresses)
+			assert.Equal(t, int64(64), *expandedSubnet.Ipv4SubnetSize)
+			assert.Equal(t, []string{"10.0.0.0/26"}, service.SubnetStore.GetByKey("subnet-1").IpAddresses)
+			// The original NSX Subnet is not changed.
+			assert.Equal(t, []string{"10.0.0.0/27"}, nsxSubnet.IpAddresses)
+		})
+	}
+}
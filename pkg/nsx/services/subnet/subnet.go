@@ -86,6 +86,7 @@ func InitializeSubnetService(service common.Service) (*SubnetService, error) {
 
 	wg.Add(1)
 	go subnetService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSubnet, nil, subnetService.SubnetStore)
+	common.RegisterStoreMetrics(ResourceTypeSubnet, &subnetService.SubnetStore.ResourceStore)
 	go func() {
 		wg.Wait()
 		close(wgDone)
@@ -356,6 +357,23 @@ func (service *SubnetService) GetSubnetStatus(subnet *model.VpcSubnet) ([]model.
 	return statusList.Results, nil
 }
 
+// GetSubnetIPUsage returns the number of the allocated IPv4 addresses and the size of the IPv4 pool of the NSX Subnet.
+// The size is 0 if NSX doesn't allocate the IPv4 addresses of the Subnet, i.e. a DHCPRelay Subnet or a Subnet with
+// static IP allocation disabled.
+func (service *SubnetService) GetSubnetIPUsage(nsxSubnet *model.VpcSubnet) (int, int, error) {
+	if nsxSubnet.SubnetDhcpConfig == nil || nsxSubnet.SubnetDhcpConfig.Mode == nil || *nsxSubnet.SubnetDhcpConfig.Mode == model.SubnetDhcpConfig_MODE_DEACTIVATED {
+		if nsxSubnet.AdvancedConfig == nil || nsxSubnet.AdvancedConfig.StaticIpAllocation == nil ||
+			nsxSubnet.AdvancedConfig.StaticIpAllocation.Enabled == nil || !*nsxSubnet.AdvancedConfig.StaticIpAllocation.Enabled {
+			return 0, 0, nil
+		}
+	}
+	subnetInfo, err := common.ParseVPCResourcePath(*nsxSubnet.Path)
+	if err != nil {
+		return 0, 0, err
+	}
+	return service.GetSubnetIPv4PoolUsage(subnetInfo, nsxSubnet)
+}
+
 func (service *SubnetService) UpdateSubnetSetStatus(obj *v1alpha1.SubnetSet) error {
 	var subnetInfoList []v1alpha1.SubnetInfo
 	nsxSubnets := service.SubnetStore.GetByIndex(common.TagScopeSubnetSetCRUID, string(obj.GetUID()))
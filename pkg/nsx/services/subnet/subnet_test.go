@@ -2608,6 +2608,80 @@ func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 	}
 }
 
+func TestSubnetService_CreateOrUpdateSubnet_RefreshedDrift(t *testing.T) {
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:       types.UID("0ca84a5b-b8b2-4e90-ae50-12caa5f847cf"),
+			Name:      "subnet1",
+			Namespace: "ns1",
+		},
+		Spec: v1alpha1.SubnetSpec{
+			IPAddresses: []string{"10.0.0.0/28"},
+		},
+	}
+	basicTags := []model.Tag{
+		{Scope: String(common.TagScopeNamespaceUID), Tag: String("ns1")},
+	}
+	vpcResourceInfo, _ := common.ParseVPCResourcePath("/orgs/default/projects/default/vpcs/vpc-1")
+
+	for _, tc := range []struct {
+		name      string
+		driftType common.DriftType
+	}{
+		{
+			name:      "modified Subnet is reverted",
+			driftType: common.DriftTypeModified,
+		},
+		{
+			name:      "missing Subnet is recreated",
+			driftType: common.DriftTypeMissing,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SubnetService{
+				Service: common.Service{
+					NSXClient: &nsx.Client{SubnetsClient: &fakeSubnetsClient{}},
+					NSXConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+				},
+				SubnetStore: buildSubnetStore(),
+			}
+			patched := 0
+			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+				patched++
+				return nsxSubnet, service.SubnetStore.Apply(nsxSubnet)
+			})
+			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, nsxSubnet *model.VpcSubnet) error {
+				return nil
+			})
+			defer patches.Reset()
+
+			cached, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			require.NoError(t, err)
+			require.Equal(t, 1, patched)
+
+			// The drift is not repaired with the stale store.
+			_, err = service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			require.NoError(t, err)
+			assert.Equal(t, 1, patched)
+
+			drift := common.Drift{Type: tc.driftType, Cached: cached}
+			if tc.driftType == common.DriftTypeModified {
+				realized := *cached
+				realized.SubnetDhcpConfig = &model.SubnetDhcpConfig{Mode: String("DHCP_SERVER")}
+				drift.Realized = &realized
+			}
+			require.NoError(t, service.DriftTarget().Refresh(drift))
+
+			_, err = service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			require.NoError(t, err)
+			assert.Equal(t, 2, patched)
+			nsxSubnets := service.SubnetStore.GetByIndex(common.TagScopeSubnetCRUID, string(subnetCR.UID))
+			require.Len(t, nsxSubnets, 1)
+			assert.Equal(t, cached.SubnetDhcpConfig, nsxSubnets[0].SubnetDhcpConfig)
+		})
+	}
+}
+
 func Test_isSubnetReady(t *testing.T) {
 	subnetReady := &v1alpha1.Subnet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -3068,3 +3142,85 @@ func TestSubnetService_GetAllGatewayPrefixesOfSubnet_Integration(t *testing.T) {
 		})
 	}
 }
+
+type fakeIPPoolClient struct {
+	pool model.IpAddressPool
+	err  error
+}
+
+func (c *fakeIPPoolClient) Get(_ string, _ string, _ string, _ string, _ string) (model.IpAddressPool, error) {
+	return c.pool, c.err
+}
+
+func (c *fakeIPPoolClient) List(_ string, _ string, _ string, _ string, _ *string, _ *bool, _ *string, _ *int64, _ *bool, _ *string) (model.IpAddressPoolListResult, error) {
+	return model.IpAddressPoolListResult{}, nil
+}
+
+type fakeDhcpStatsClient struct {
+	stats model.DhcpServerStatistics
+}
+
+func (c *fakeDhcpStatsClient) Get(_ string, _ string, _ string, _ string, _ *string, _ *string, _ *bool, _ *string, _ *int64, _ *bool, _ *string) (model.DhcpServerStatistics, error) {
+	return c.stats, nil
+}
+
+func TestSubnetService_GetSubnetIPUsage(t *testing.T) {
+	path := "/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1"
+	service := &SubnetService{Service: common.Service{NSXClient: &nsx.Client{
+		IPPoolClient: &fakeIPPoolClient{pool: model.IpAddressPool{
+			PoolUsage: &model.PolicyPoolUsage{TotalIps: common.Int64(12), RequestedIpAllocations: common.Int64(3)},
+		}},
+		DhcpServerConfigStatsClient: &fakeDhcpStatsClient{stats: model.DhcpServerStatistics{
+			IpPoolStats: []model.DhcpIpPoolUsage{{PoolSize: common.Int64(28), AllocatedNumber: common.Int64(7)}},
+		}},
+	}}}
+
+	tests := []struct {
+		name          string
+		nsxSubnet     *model.VpcSubnet
+		expectedUsed  int
+		expectedTotal int
+	}{
+		{
+			name: "StaticIPAllocation",
+			nsxSubnet: &model.VpcSubnet{
+				Path:           common.String(path),
+				AdvancedConfig: &model.SubnetAdvancedConfig{StaticIpAllocation: &model.StaticIpAllocation{Enabled: common.Bool(true)}},
+			},
+			expectedUsed:  3,
+			expectedTotal: 12,
+		},
+		{
+			name: "DHCPServer",
+			nsxSubnet: &model.VpcSubnet{
+				Path:             common.String(path),
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: common.String(model.SubnetDhcpConfig_MODE_SERVER)},
+			},
+			expectedUsed:  7,
+			expectedTotal: 28,
+		},
+		{
+			name:      "StaticIPAllocationDisabled",
+			nsxSubnet: &model.VpcSubnet{Path: common.String(path)},
+		},
+		{
+			name: "DHCPRelay",
+			nsxSubnet: &model.VpcSubnet{
+				Path:             common.String(path),
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: common.String(model.SubnetDhcpConfig_MODE_RELAY)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, total, err := service.GetSubnetIPUsage(tt.nsxSubnet)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUsed, used)
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+
+	service.NSXClient.IPPoolClient = &fakeIPPoolClient{err: errors.New("mock static error")}
+	_, _, err := service.GetSubnetIPUsage(tests[0].nsxSubnet)
+	assert.ErrorContains(t, err, "mock static error")
+}
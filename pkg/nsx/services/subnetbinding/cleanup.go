@@ -9,7 +9,7 @@ import (
 )
 
 func (s *BindingService) CleanupBeforeVPCDeletion(ctx context.Context) error {
-	allNSXBindings := s.BindingStore.List()
+	allNSXBindings := s.CleanupFilter.Filter(s.BindingStore.List())
 	log.Info("Cleaning up SubnetConnectionBindingMaps", "Count", len(allNSXBindings), "status", "attempting")
 	if len(allNSXBindings) == 0 {
 		log.Info("No SubnetConnectionBindingMaps found to clean up", "count", 0)
@@ -33,3 +33,11 @@ func (s *BindingService) CleanupBeforeVPCDeletion(ctx context.Context) error {
 	log.Info("Successfully cleaned up SubnetConnectionBindingMaps", "count", len(finalBindingMaps), "status", "success")
 	return nil
 }
+
+// PlanCleanupBeforeVPCDeletion returns the paths of the NSX SubnetConnectionBindingMaps which CleanupBeforeVPCDeletion
+// would delete.
+func (s *BindingService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		servicecommon.ResourceTypeSubnetConnectionBindingMap: servicecommon.ListNSXResourcePaths(s.CleanupFilter.Filter(s.BindingStore.List())),
+	}, nil
+}
@@ -43,6 +43,7 @@ func InitializeService(service servicecommon.Service) (*BindingService, error) {
 
 	wg.Add(1)
 	go service.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSubnetConnectionBindingMap, nil, bindingService.BindingStore)
+	servicecommon.RegisterStoreMetrics(ResourceTypeSubnetConnectionBindingMap, &bindingService.BindingStore.ResourceStore)
 	wg.Wait()
 
 	if len(fatalErrors) > 0 {
@@ -100,6 +101,11 @@ func (s *BindingService) DeleteSubnetConnectionBindingMapsByCRName(bindingName s
 	return s.deleteSubnetConnectionBindingMaps(bindingMaps)
 }
 
+// DeleteSubnetConnectionBindingMap deletes the SubnetConnectionBindingMap on NSX and from the local store.
+func (s *BindingService) DeleteSubnetConnectionBindingMap(bindingMap *model.SubnetConnectionBindingMap) error {
+	return s.deleteSubnetConnectionBindingMaps([]*model.SubnetConnectionBindingMap{bindingMap})
+}
+
 // DeleteSubnetConnectionBindingMapsByParentSubnet deletes all the SubnetConnectionBindingMaps bound to the
 // given parentSubnet.
 func (s *BindingService) DeleteSubnetConnectionBindingMapsByParentSubnet(parentSubnet *model.VpcSubnet) error {
@@ -16,7 +16,7 @@ func (s *IPReservationService) CleanupBeforeVPCDeletion(ctx context.Context) err
 }
 
 func (s *IPReservationService) CleanupDynamicIPReservation(ctx context.Context) error {
-	objs := s.DynamicIPReservationStore.List()
+	objs := s.CleanupFilter.Filter(s.DynamicIPReservationStore.List())
 	log.Info("Cleaning up Subnet DynamicIPReservation", "Count", len(objs), "status", "attempting")
 	if len(objs) == 0 {
 		log.Info("No Subnet DynamicIPReservation found to clean up", "count", 0)
@@ -42,7 +42,7 @@ func (s *IPReservationService) CleanupDynamicIPReservation(ctx context.Context)
 }
 
 func (s *IPReservationService) CleanupStaticIPReservation(ctx context.Context) error {
-	objs := s.StaticIPReservationStore.List()
+	objs := s.CleanupFilter.Filter(s.StaticIPReservationStore.List())
 	log.Info("Cleaning up Subnet StaticIPReservation", "Count", len(objs), "status", "attempting")
 	if len(objs) == 0 {
 		log.Info("No Subnet StaticIPReservation found to clean up", "count", 0)
@@ -66,3 +66,12 @@ func (s *IPReservationService) CleanupStaticIPReservation(ctx context.Context) e
 	log.Info("Successfully cleaned up Subnet StaticIPReservation", "count", len(iprs), "status", "success")
 	return nil
 }
+
+// PlanCleanupBeforeVPCDeletion returns the paths of the NSX dynamic and static IP reservations which
+// CleanupBeforeVPCDeletion would delete.
+func (s *IPReservationService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		common.ResourceTypeDynamicIpAddressReservation: common.ListNSXResourcePaths(s.CleanupFilter.Filter(s.DynamicIPReservationStore.List())),
+		common.ResourceTypeStaticIpAddressReservation:  common.ListNSXResourcePaths(s.CleanupFilter.Filter(s.StaticIPReservationStore.List())),
+	}, nil
+}
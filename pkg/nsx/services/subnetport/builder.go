@@ -37,8 +37,14 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 		return nil, fmt.Errorf("unsupported object: %v", obj)
 	}
 	objNamespace = objMeta.Namespace
-	if _, ok := obj.(*corev1.Pod); ok {
+	switch o := obj.(type) {
+	case *corev1.Pod:
 		appId = string(objMeta.UID)
+	case *v1alpha1.SubnetPort:
+		// The SubnetPort of an additional Pod interface is attached to the Pod on the transport node of contextID.
+		if owner := metav1.GetControllerOf(o); contextID != "" && owner != nil && owner.Kind == "Pod" {
+			appId = string(owner.UID)
+		}
 	}
 	_, stsUID := getStatefulSetInfo(obj)
 	var externalAddressBinding *model.ExternalAddressBinding
@@ -147,9 +153,42 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	}
 	namespaceUid := namespace.UID
 
-	nsxSubnetPortID, nsxSubnetPortName := service.BuildSubnetPortIdAndName(objMeta, namespaceUid, stsUID)
+	var nsxSubnetPortID, nsxSubnetPortName, stickyIdentity string
+	var stickyGracePeriod time.Duration
+	if pod, ok := obj.(*corev1.Pod); ok {
+		stickyIdentity, stickyGracePeriod = GetStickyIdentity(pod)
+		// A recreated sticky Pod reuses the released SubnetPort of the same identity to keep the IP and MAC.
+		if stickySubnetPort := service.ClaimStickySubnetPort(pod); stickySubnetPort != nil {
+			nsxSubnetPortID, nsxSubnetPortName = *stickySubnetPort.Id, *stickySubnetPort.DisplayName
+		}
+	}
+	if nsxSubnetPortID == "" {
+		nsxSubnetPortID, nsxSubnetPortName = service.BuildSubnetPortIdAndName(objMeta, namespaceUid, stsUID)
+	}
 	nsxSubnetPortPath := fmt.Sprintf("%s/ports/%s", *nsxSubnet.Path, nsxSubnetPortID)
 
+	// The Pod of a StatefulSet with static IPs is created with the IP of its ordinal. The existing SubnetPort keeps the
+	// address bindings it is created with, so that it is not affected by the later changes of the StatefulSet.
+	if pod, ok := obj.(*corev1.Pod); ok && !restoreMode && stsUID != "" {
+		if existingSubnetPort := service.SubnetPortStore.GetByKey(nsxSubnetPortID); existingSubnetPort != nil {
+			if len(existingSubnetPort.AddressBindings) > 0 && existingSubnetPort.StaticIpAllocationType != nil {
+				staticIpAllocationType = *existingSubnetPort.StaticIpAllocationType
+			}
+		} else {
+			_, staticIP, err := controllercommon.GetStatefulSetStaticIPForPod(context.TODO(), service.Client, pod)
+			if err != nil {
+				return nil, err
+			}
+			if staticIP != "" {
+				addressBindings = []model.PortAddressBindingEntry{{IpAddress: String(staticIP)}}
+				staticIpAllocationType = controllercommon.NSXIPAddressTypeIPv4
+				if util.IsIPv6(staticIP) {
+					staticIpAllocationType = controllercommon.NSXIPAddressTypeIPv6
+				}
+			}
+		}
+	}
+
 	tags := util.BuildBasicTags(getCluster(service), obj, namespaceUid)
 
 	// Filter tags based on the type of subnet port (VM or Pod).
@@ -171,6 +210,9 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 		}
 		tagsFiltered = append(tagsFiltered, tag)
 	}
+	if stickyIdentity != "" {
+		tagsFiltered = append(tagsFiltered, buildStickyTags(stickyIdentity, stickyGracePeriod)...)
+	}
 
 	if labelTags != nil {
 		// Append Namespace labels in order as tags
@@ -2,6 +2,7 @@ package subnetport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,13 +10,17 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -1444,3 +1449,140 @@ func TestBuildSubnetPortIdAndName_reuseSTSPortByUIDAndPodName(t *testing.T) {
 	assert.Equal(t, "sts-port-id", id)
 	assert.Equal(t, "test-pod", name)
 }
+
+func TestBuildSubnetPort_StatefulSetStaticIP(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid"}}
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "ns1",
+		Name:      "db",
+		UID:       "sts-uid",
+		Annotations: map[string]string{
+			common.AnnotationStaticIPs:      "10.0.0.10-10.0.0.12",
+			common.AnnotationStaticIPSubnet: "subnet-1",
+		},
+	}}
+	newPod := func(stsName string, stsUID types.UID) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns1",
+			Name:            stsName + "-1",
+			UID:             types.UID(stsName + "-pod-uid"),
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "StatefulSet", Name: stsName, UID: stsUID, Controller: ptr.To(true)}},
+		}}
+	}
+	nsxSubnet := &model.VpcSubnet{Path: common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1")}
+	nsxClient := &nsx.Client{}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nsx.StatefulSetPodSubnetPortFeatureEnabled, func(_ *nsx.Client, _ *config.NSXOperatorConfig) bool {
+		return false
+	})
+
+	tests := []struct {
+		name                   string
+		pod                    *corev1.Pod
+		existingSubnetPort     *model.VpcSubnetPort
+		expectedBindings       []model.PortAddressBindingEntry
+		expectedAllocationType string
+	}{
+		{
+			name:                   "new-port-of-statefulset-with-static-ips",
+			pod:                    newPod("db", "sts-uid"),
+			expectedBindings:       []model.PortAddressBindingEntry{{IpAddress: common.String("10.0.0.11")}},
+			expectedAllocationType: controllercommon.NSXIPAddressTypeIPv4,
+		},
+		{
+			name:                   "new-port-of-recreated-statefulset",
+			pod:                    newPod("db", "old-sts-uid"),
+			expectedAllocationType: controllercommon.NSXIPAddressTypeNone,
+		},
+		{
+			name:                   "new-port-of-deleted-statefulset",
+			pod:                    newPod("web", "web-sts-uid"),
+			expectedAllocationType: controllercommon.NSXIPAddressTypeNone,
+		},
+		{
+			name: "existing-port-of-statefulset-with-static-ips",
+			pod:  newPod("db", "sts-uid"),
+			existingSubnetPort: &model.VpcSubnetPort{
+				Id:                     common.String("port-1"),
+				DisplayName:            common.String("db-1"),
+				Tags:                   []model.Tag{{Scope: common.String(common.TagScopePodUID), Tag: common.String("db-pod-uid")}},
+				AddressBindings:        []model.PortAddressBindingEntry{{IpAddress: common.String("10.0.0.11")}},
+				StaticIpAllocationType: common.String(controllercommon.NSXIPAddressTypeIPv4),
+			},
+			expectedAllocationType: controllercommon.NSXIPAddressTypeIPv4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().WithObjects(namespace, sts).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*appsv1.StatefulSet); ok && tt.existingSubnetPort != nil {
+						return errors.New("unexpected StatefulSet lookup for the existing SubnetPort")
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).Build()
+			service := &SubnetPortService{
+				Service: common.Service{
+					Client:    k8sClient,
+					NSXClient: nsxClient,
+					NSXConfig: &config.NSXOperatorConfig{
+						NsxConfig: &config.NsxConfig{},
+						CoeConfig: &config.CoeConfig{Cluster: "fake_cluster"},
+					},
+				},
+				SubnetPortStore: setupStore(),
+			}
+			if tt.existingSubnetPort != nil {
+				require.NoError(t, service.SubnetPortStore.Apply(tt.existingSubnetPort))
+			}
+
+			nsxSubnetPort, err := service.buildSubnetPort(tt.pod, nsxSubnet, "", nil, false, false, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBindings, nsxSubnetPort.AddressBindings)
+			assert.Equal(t, tt.expectedAllocationType, *nsxSubnetPort.StaticIpAllocationType)
+		})
+	}
+}
+
+func TestBuildSubnetPort_PodInterface(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid"}}
+	subnetPort := &v1alpha1.SubnetPort{ObjectMeta: metav1.ObjectMeta{
+		Namespace:       "ns1",
+		Name:            "pod-1-eth1",
+		UID:             "port-uid",
+		Annotations:     map[string]string{common.AnnotationPodInterface: "eth1"},
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "pod-1", UID: "pod-uid", Controller: ptr.To(true)}},
+	}}
+	nsxSubnet := &model.VpcSubnet{Path: common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1")}
+	nsxClient := &nsx.Client{}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+	service := &SubnetPortService{
+		Service: common.Service{
+			Client:    fake.NewClientBuilder().WithObjects(namespace).Build(),
+			NSXClient: nsxClient,
+			NSXConfig: &config.NSXOperatorConfig{
+				NsxConfig: &config.NsxConfig{},
+				CoeConfig: &config.CoeConfig{Cluster: "fake_cluster"},
+			},
+		},
+		SubnetPortStore: setupStore(),
+	}
+
+	nsxSubnetPort, err := service.buildSubnetPort(subnetPort, nsxSubnet, "node-uuid", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	require.NoError(t, err)
+	assert.Equal(t, common.String("pod-uid"), nsxSubnetPort.Attachment.AppId)
+	assert.Equal(t, common.String("node-uuid"), nsxSubnetPort.Attachment.ContextId)
+
+	// The Pod owning the SubnetPort isn't scheduled yet.
+	nsxSubnetPort, err = service.buildSubnetPort(subnetPort, nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	require.NoError(t, err)
+	assert.Nil(t, nsxSubnetPort.Attachment.AppId)
+	assert.Nil(t, nsxSubnetPort.Attachment.ContextId)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func (service *SubnetPortService) CleanupBeforeVPCDeletion(ctx context.Context) error {
-	objs := service.SubnetPortStore.List()
+	objs := service.CleanupFilter.Filter(service.SubnetPortStore.List())
 	log.Info("Cleaning up VpcSubnetPorts", "Count", len(objs), "status", "attempting")
 	if len(objs) == 0 {
 		log.Info("No VpcSubnetPorts found to clean up", "count", 0)
@@ -34,3 +34,10 @@ func (service *SubnetPortService) CleanupBeforeVPCDeletion(ctx context.Context)
 	log.Info("Successfully cleaned up VpcSubnetPorts", "count", len(ports), "status", "success")
 	return nil
 }
+
+// PlanCleanupBeforeVPCDeletion returns the paths of the NSX VpcSubnetPorts which CleanupBeforeVPCDeletion would delete.
+func (service *SubnetPortService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		common.ResourceTypeSubnetPort: common.ListNSXResourcePaths(service.CleanupFilter.Filter(service.SubnetPortStore.List())),
+	}, nil
+}
@@ -60,3 +60,18 @@ func SubnetPortToComparable(sp *model.VpcSubnetPort) Comparable {
 func ComparableToSubnetPort(sp Comparable) *model.VpcSubnetPort {
 	return (*model.VpcSubnetPort)(sp.(*SubnetPort))
 }
+
+// DriftTarget returns the target to audit the SubnetPorts in the store against NSX.
+func (service *SubnetPortService) DriftTarget() common.DriftTarget {
+	return common.DriftTarget{
+		ResourceType: ResourceTypeSubnetPort,
+		Store:        &service.SubnetPortStore.ResourceStore,
+		Inspect: func(obj interface{}) (string, []model.Tag, common.Comparable) {
+			subnetPort := obj.(*model.VpcSubnetPort)
+			if subnetPort.Path == nil {
+				return "", nil, nil
+			}
+			return *subnetPort.Path, subnetPort.Tags, SubnetPortToComparable(subnetPort)
+		},
+	}
+}
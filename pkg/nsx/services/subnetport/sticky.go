@@ -0,0 +1,197 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetport
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// DefaultStickyGracePeriod is the grace period of a sticky SubnetPort if the annotation value is "true".
+var DefaultStickyGracePeriod = 10 * time.Minute
+
+const (
+	stickyIdentityKindPod        = "Pod"
+	stickyIdentityKindDeployment = "Deployment"
+)
+
+// parseStickyGracePeriod parses the value of the sticky-ip annotation, which is a boolean or a positive duration.
+// A zero duration means the Pod is not sticky.
+func parseStickyGracePeriod(value string) (time.Duration, error) {
+	if enabled, err := strconv.ParseBool(value); err == nil {
+		if enabled {
+			return DefaultStickyGracePeriod, nil
+		}
+		return 0, nil
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q: %w", common.AnnotationStickyIP, value, err)
+	}
+	if gracePeriod <= 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q: grace period must be positive", common.AnnotationStickyIP, value)
+	}
+	return gracePeriod, nil
+}
+
+// GetStickyIdentity returns the identity which the SubnetPort of the Pod is retained for after the Pod is deleted,
+// and the grace period of the retention. A standalone Pod is identified by its name, and a Pod of a Deployment by
+// the Deployment name, which is the name of the owner ReplicaSet without the pod-template-hash suffix, so that the
+// address survives the rollouts. An empty identity means the Pod is not sticky.
+func GetStickyIdentity(pod *corev1.Pod) (string, time.Duration) {
+	value, ok := pod.GetAnnotations()[common.AnnotationStickyIP]
+	if !ok {
+		return "", 0
+	}
+	gracePeriod, err := parseStickyGracePeriod(value)
+	if err != nil {
+		log.Error(err, "Ignoring the sticky IP annotation of Pod", "Namespace", pod.Namespace, "Name", pod.Name)
+		return "", 0
+	}
+	if gracePeriod == 0 {
+		return "", 0
+	}
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return fmt.Sprintf("%s/%s", stickyIdentityKindPod, pod.Name), gracePeriod
+	}
+	if ref.Kind == appsv1.SchemeGroupVersion.WithKind("ReplicaSet").Kind {
+		hash := pod.GetLabels()[appsv1.DefaultDeploymentUniqueLabelKey]
+		if deployment, found := strings.CutSuffix(ref.Name, "-"+hash); found && hash != "" {
+			return fmt.Sprintf("%s/%s", stickyIdentityKindDeployment, deployment), gracePeriod
+		}
+	}
+	log.Info("Ignoring the sticky IP annotation, only standalone Pods and Pods of Deployments are supported", "Namespace", pod.Namespace, "Name", pod.Name, "owner", ref.Kind)
+	return "", 0
+}
+
+func buildStickyTags(identity string, gracePeriod time.Duration) []model.Tag {
+	return []model.Tag{
+		{Scope: String(common.TagScopeStickyIdentity), Tag: String(util.NormalizeLabelValue(identity, util.Sha1))},
+		{Scope: String(common.TagScopeStickyGracePeriod), Tag: String(strconv.FormatInt(int64(gracePeriod.Seconds()), 10))},
+	}
+}
+
+// IsStickySubnetPort checks if the SubnetPort is retained after its Pod is deleted.
+func IsStickySubnetPort(nsxSubnetPort *model.VpcSubnetPort) bool {
+	return nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeStickyIdentity) != ""
+}
+
+// IsStickySubnetPortReleased checks if the Pod using the sticky SubnetPort has been deleted.
+func IsStickySubnetPortReleased(nsxSubnetPort *model.VpcSubnetPort) bool {
+	return nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeStickyReleasedAt) != ""
+}
+
+// StickySubnetPortExpired checks if the grace period of the released sticky SubnetPort has passed. A SubnetPort with
+// malformed tags is regarded as expired so that it is not leaked.
+func StickySubnetPortExpired(nsxSubnetPort *model.VpcSubnetPort, now time.Time) bool {
+	releasedAt, err := strconv.ParseInt(nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeStickyReleasedAt), 10, 64)
+	if err != nil {
+		return true
+	}
+	gracePeriod, err := strconv.ParseInt(nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeStickyGracePeriod), 10, 64)
+	if err != nil {
+		return true
+	}
+	return !now.Before(time.Unix(releasedAt+gracePeriod, 0))
+}
+
+// InspectOrphanSubnetPort returns the path and the tags of the SubnetPort for the orphan collector. The sticky
+// SubnetPorts are skipped with an empty path, they outlive their Pods on purpose and are deleted by the Pod garbage
+// collector after the sticky grace period.
+func InspectOrphanSubnetPort(obj interface{}) (string, []model.Tag) {
+	nsxSubnetPort := obj.(*model.VpcSubnetPort)
+	if nsxSubnetPort.Path == nil || IsStickySubnetPort(nsxSubnetPort) {
+		return "", nil
+	}
+	return *nsxSubnetPort.Path, nsxSubnetPort.Tags
+}
+
+// ClaimStickySubnetPort returns the released SubnetPort retained for the identity of the Pod if the Pod has no
+// SubnetPort yet. The SubnetPort is claimed by the Pod until it is released again, so that it is not handed out to
+// another Pod of the same Deployment before the Pod tags are updated on it.
+func (service *SubnetPortService) ClaimStickySubnetPort(pod *corev1.Pod) *model.VpcSubnetPort {
+	identity, _ := GetStickyIdentity(pod)
+	if identity == "" {
+		return nil
+	}
+	if existingSubnetPort, err := service.SubnetPortStore.GetVpcSubnetPortByUID(pod.UID); err != nil || existingSubnetPort != nil {
+		return nil
+	}
+
+	service.stickyLock.Lock()
+	defer service.stickyLock.Unlock()
+	if service.stickyClaims == nil {
+		service.stickyClaims = make(map[string]types.UID)
+	}
+	now := time.Now()
+	var candidate *model.VpcSubnetPort
+	for _, nsxSubnetPort := range service.SubnetPortStore.GetByIndex(common.TagScopeStickyIdentity, util.NormalizeLabelValue(identity, util.Sha1)) {
+		if nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeNamespace) != pod.Namespace ||
+			!IsStickySubnetPortReleased(nsxSubnetPort) || StickySubnetPortExpired(nsxSubnetPort, now) {
+			continue
+		}
+		claimedBy, claimed := service.stickyClaims[*nsxSubnetPort.Id]
+		if claimedBy == pod.UID {
+			return nsxSubnetPort
+		}
+		if !claimed && candidate == nil {
+			candidate = nsxSubnetPort
+		}
+	}
+	if candidate != nil {
+		service.stickyClaims[*candidate.Id] = pod.UID
+		log.Info("Reusing sticky SubnetPort for Pod", "Namespace", pod.Namespace, "Name", pod.Name, "identity", identity, "nsxSubnetPort.Id", *candidate.Id)
+	}
+	return candidate
+}
+
+// ReleaseStickySubnetPort keeps the sticky SubnetPort of a deleted Pod with its IP and MAC, and records the release
+// time on it so that the SubnetPort is deleted by the garbage collector after the grace period.
+func (service *SubnetPortService) ReleaseStickySubnetPort(nsxSubnetPort *model.VpcSubnetPort) error {
+	if IsStickySubnetPortReleased(nsxSubnetPort) {
+		return nil
+	}
+	if nsxSubnetPort.Path == nil {
+		return errors.New("subnet port path is nil")
+	}
+	releasedSubnetPort := *nsxSubnetPort
+	releasedSubnetPort.Tags = append(slices.Clone(nsxSubnetPort.Tags), model.Tag{
+		Scope: String(common.TagScopeStickyReleasedAt),
+		Tag:   String(strconv.FormatInt(time.Now().Unix(), 10)),
+	})
+	subnetPortInfo, _ := common.ParseVPCResourcePath(*nsxSubnetPort.Path)
+	err := service.NSXClient.PortClient.Patch(subnetPortInfo.OrgID, subnetPortInfo.ProjectID, subnetPortInfo.VPCID, subnetPortInfo.ParentID, *nsxSubnetPort.Id, releasedSubnetPort)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to release sticky SubnetPort", "nsxSubnetPort.Path", *nsxSubnetPort.Path)
+		return err
+	}
+	if err = service.SubnetPortStore.Apply(&releasedSubnetPort); err != nil {
+		return err
+	}
+	service.forgetStickyClaim(*nsxSubnetPort.Id)
+	log.Info("Released sticky SubnetPort", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "identity", nsxutil.FindTag(nsxSubnetPort.Tags, common.TagScopeStickyIdentity))
+	return nil
+}
+
+func (service *SubnetPortService) forgetStickyClaim(id string) {
+	service.stickyLock.Lock()
+	defer service.stickyLock.Unlock()
+	delete(service.stickyClaims, id)
+}
@@ -0,0 +1,182 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetport
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func newStickyPod(name string, uid types.UID, value string, owner *metav1.OwnerReference) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns-1",
+			UID:         uid,
+			Annotations: map[string]string{common.AnnotationStickyIP: value},
+			Labels:      map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5d4f8b7c9"},
+		},
+	}
+	if owner != nil {
+		pod.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+	return pod
+}
+
+func newStickySubnetPort(id string, identity string, releasedAt *time.Time) *model.VpcSubnetPort {
+	tags := []model.Tag{
+		{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")},
+		{Scope: common.String(common.TagScopePodUID), Tag: common.String("old-pod-uid")},
+	}
+	tags = append(tags, buildStickyTags(identity, 10*time.Minute)...)
+	if releasedAt != nil {
+		tags = append(tags, model.Tag{Scope: common.String(common.TagScopeStickyReleasedAt), Tag: common.String(strconv.FormatInt(releasedAt.Unix(), 10))})
+	}
+	return &model.VpcSubnetPort{
+		Id:          common.String(id),
+		DisplayName: common.String(id),
+		Path:        common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1/ports/" + id),
+		ParentPath:  common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1"),
+		Tags:        tags,
+	}
+}
+
+func TestGetStickyIdentity(t *testing.T) {
+	replicaSet := &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d4f8b7c9", UID: "rs-uid", Controller: ptr.To(true)}
+	tests := []struct {
+		name             string
+		pod              *corev1.Pod
+		expectedIdentity string
+		expectedPeriod   time.Duration
+	}{
+		{
+			name:             "StandalonePod",
+			pod:              newStickyPod("pod-1", "uid-1", "true", nil),
+			expectedIdentity: "Pod/pod-1",
+			expectedPeriod:   DefaultStickyGracePeriod,
+		},
+		{
+			name:             "DeploymentPod",
+			pod:              newStickyPod("web-5d4f8b7c9-x2v7q", "uid-1", "30m", replicaSet),
+			expectedIdentity: "Deployment/web",
+			expectedPeriod:   30 * time.Minute,
+		},
+		{
+			name: "ReplicaSetWithoutDeployment",
+			pod:  newStickyPod("web-x2v7q", "uid-1", "true", &metav1.OwnerReference{Kind: "ReplicaSet", Name: "web", Controller: ptr.To(true)}),
+		},
+		{
+			name: "StatefulSetPod",
+			pod:  newStickyPod("db-0", "uid-1", "true", &metav1.OwnerReference{Kind: "StatefulSet", Name: "db", Controller: ptr.To(true)}),
+		},
+		{
+			name: "Disabled",
+			pod:  newStickyPod("pod-1", "uid-1", "false", nil),
+		},
+		{
+			name: "InvalidGracePeriod",
+			pod:  newStickyPod("pod-1", "uid-1", "-5m", nil),
+		},
+		{
+			name: "NoAnnotation",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, gracePeriod := GetStickyIdentity(tt.pod)
+			assert.Equal(t, tt.expectedIdentity, identity)
+			assert.Equal(t, tt.expectedPeriod, gracePeriod)
+		})
+	}
+}
+
+func TestStickySubnetPortExpired(t *testing.T) {
+	now := time.Now()
+	released := now.Add(-5 * time.Minute)
+	assert.False(t, StickySubnetPortExpired(newStickySubnetPort("port-1", "Pod/pod-1", &released), now))
+	assert.True(t, StickySubnetPortExpired(newStickySubnetPort("port-1", "Pod/pod-1", &released), now.Add(5*time.Minute)))
+	// A sticky SubnetPort without the release time is treated as expired.
+	assert.True(t, StickySubnetPortExpired(newStickySubnetPort("port-1", "Pod/pod-1", nil), now))
+}
+
+func TestSubnetPortService_ClaimStickySubnetPort(t *testing.T) {
+	replicaSet := &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d4f8b7c9", UID: "rs-uid", Controller: ptr.To(true)}
+	released := time.Now().Add(-time.Minute)
+	expired := time.Now().Add(-time.Hour)
+	service := &SubnetPortService{SubnetPortStore: setupStore()}
+	require.NoError(t, service.SubnetPortStore.Apply(newStickySubnetPort("port-web-1", "Deployment/web", &released)))
+	require.NoError(t, service.SubnetPortStore.Apply(newStickySubnetPort("port-web-2", "Deployment/web", &expired)))
+	require.NoError(t, service.SubnetPortStore.Apply(newStickySubnetPort("port-web-3", "Deployment/web", nil)))
+	require.NoError(t, service.SubnetPortStore.Apply(newStickySubnetPort("port-pod-1", "Pod/pod-1", &released)))
+
+	// Only the released SubnetPort in the grace period is claimed, and it is claimed by one Pod at a time.
+	podA := newStickyPod("web-5d4f8b7c9-aaaaa", "uid-a", "true", replicaSet)
+	podB := newStickyPod("web-5d4f8b7c9-bbbbb", "uid-b", "true", replicaSet)
+	claimed := service.ClaimStickySubnetPort(podA)
+	require.NotNil(t, claimed)
+	assert.Equal(t, "port-web-1", *claimed.Id)
+	assert.Nil(t, service.ClaimStickySubnetPort(podB))
+	claimed = service.ClaimStickySubnetPort(podA)
+	require.NotNil(t, claimed)
+	assert.Equal(t, "port-web-1", *claimed.Id)
+
+	pod := newStickyPod("pod-1", "uid-c", "true", nil)
+	claimed = service.ClaimStickySubnetPort(pod)
+	require.NotNil(t, claimed)
+	assert.Equal(t, "port-pod-1", *claimed.Id)
+
+	// A Pod in another Namespace doesn't reuse the SubnetPort.
+	pod = newStickyPod("pod-1", "uid-d", "true", nil)
+	pod.Namespace = "ns-2"
+	assert.Nil(t, service.ClaimStickySubnetPort(pod))
+	// A Pod which is not sticky doesn't reuse the SubnetPort.
+	assert.Nil(t, service.ClaimStickySubnetPort(newStickyPod("pod-1", "uid-e", "false", nil)))
+}
+
+func TestSubnetPortService_ReleaseStickySubnetPort(t *testing.T) {
+	service := &SubnetPortService{
+		Service:         common.Service{NSXClient: &nsx.Client{PortClient: &fakePortClient{}}},
+		SubnetPortStore: setupStore(),
+	}
+	nsxSubnetPort := newStickySubnetPort("port-1", "Pod/pod-1", nil)
+	require.NoError(t, service.SubnetPortStore.Apply(nsxSubnetPort))
+	service.stickyClaims = map[string]types.UID{"port-1": "old-pod-uid"}
+
+	require.NoError(t, service.ReleaseStickySubnetPort(nsxSubnetPort))
+	released := service.SubnetPortStore.GetByKey("port-1")
+	require.NotNil(t, released)
+	assert.True(t, IsStickySubnetPortReleased(released))
+	assert.False(t, IsStickySubnetPortReleased(nsxSubnetPort))
+	assert.Empty(t, service.stickyClaims)
+
+	// Releasing the SubnetPort again doesn't change the release time.
+	patches := gomonkey.ApplyMethodSeq(service.NSXClient.PortClient, "Patch", []gomonkey.OutputCell{{
+		Values: gomonkey.Params{errors.New("unexpected patch")},
+		Times:  1,
+	}})
+	defer patches.Reset()
+	releasedAt := nsxutil.FindTag(released.Tags, common.TagScopeStickyReleasedAt)
+	require.NoError(t, service.ReleaseStickySubnetPort(released))
+	assert.Equal(t, releasedAt, nsxutil.FindTag(service.SubnetPortStore.GetByKey("port-1").Tags, common.TagScopeStickyReleasedAt))
+
+	err := service.ReleaseStickySubnetPort(newStickySubnetPort("port-2", "Pod/pod-2", nil))
+	assert.ErrorContains(t, err, "unexpected patch")
+}
@@ -117,6 +117,15 @@ func subnetPortIndexByStatefulSetName(obj interface{}) ([]string, error) {
 	}
 }
 
+func subnetPortIndexByStickyIdentity(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.VpcSubnetPort:
+		return filterTag(o.Tags, common.TagScopeStickyIdentity), nil
+	default:
+		return nil, errors.New("subnetPortIndexByStickyIdentity doesn't support unknown type")
+	}
+}
+
 func subnetPortIndexBySts(obj interface{}) ([]string, error) {
 	port, ok := obj.(*model.VpcSubnetPort)
 	if !ok {
@@ -40,6 +40,9 @@ type SubnetPortService struct {
 	VPCService                 servicecommon.VPCServiceProvider
 	IpAddressAllocationService servicecommon.IPAddressAllocationServiceProvider
 	builder                    *servicecommon.PolicyTreeBuilder[*model.VpcSubnetPort]
+	// stickyClaims is the Pod UID which each released sticky SubnetPort is claimed by, see ClaimStickySubnetPort.
+	stickyClaims map[string]types.UID
+	stickyLock   sync.Mutex
 }
 
 // InitializeSubnetPort sync NSX resources.
@@ -62,6 +65,7 @@ func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommo
 	subnetPortService.SubnetPortStore = setupStore()
 
 	go subnetPortService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSubnetPort, nil, subnetPortService.SubnetPortStore)
+	servicecommon.RegisterStoreMetrics(ResourceTypeSubnetPort, &subnetPortService.SubnetPortStore.ResourceStore)
 	go func() {
 		wg.Wait()
 		close(wgDone)
@@ -92,6 +96,7 @@ func setupStore() *SubnetPortStore {
 					servicecommon.TagScopeStatefulSetUID:  subnetPortIndexByStatefulSetUID,
 					servicecommon.TagScopeStatefulSetName: subnetPortIndexByStatefulSetName,
 					servicecommon.IndexKeyAllStsPorts:     subnetPortIndexBySts,
+					servicecommon.TagScopeStickyIdentity:  subnetPortIndexByStickyIdentity,
 				}),
 			BindingType: model.VpcSubnetPortBindingType(),
 		}}
@@ -308,6 +313,7 @@ func (service *SubnetPortService) DeleteSubnetPort(nsxSubnetPort *model.VpcSubne
 	if err = service.SubnetPortStore.Delete(*nsxSubnetPort.Id); err != nil {
 		return err
 	}
+	service.forgetStickyClaim(*nsxSubnetPort.Id)
 	log.Info("Successfully deleted nsxSubnetPort", "nsxSubnetPortID", *nsxSubnetPort.Id)
 	return nil
 }
@@ -523,43 +529,26 @@ func (service *SubnetPortService) checkIPv4Capacity(subnet *model.VpcSubnet, sha
 
 	var allocatedIPNumber int
 	// For DHCP Server mode Subnet, get total IPs from DHCP IP Pool from NSX each time
-	// since user might update reservedIPRanges for the subnet and it impacts the DHCP Pool size
-	if dhcpMode == model.SubnetDhcpConfig_MODE_SERVER {
-		dhcpServerStats, err := service.NSXClient.DhcpServerConfigStatsClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, nil, nil, nil, nil, nil, nil, nil)
-		if err != nil {
-			log.Error(err, "Failed to get Subnet dhcp-server-config stats", "Subnet", *subnet.Path)
-			return false, err
-		}
-		if len(dhcpServerStats.IpPoolStats) > 0 && dhcpServerStats.IpPoolStats[0].PoolSize != nil {
-			info.totalIP = int(*dhcpServerStats.IpPoolStats[0].PoolSize)
-		}
-		if sharedSubnet && len(dhcpServerStats.IpPoolStats) > 0 && dhcpServerStats.IpPoolStats[0].AllocatedNumber != nil {
-			allocatedIPNumber = int(*dhcpServerStats.IpPoolStats[0].AllocatedNumber)
-		}
-	}
+	// since user might update reservedIPRanges for the subnet and it impacts the DHCP Pool size.
+	// For DHCP Deactivated mode Subnet, get total IPs from IP pool static-ipv4-default.
 	// When SubnetIPReservation is created/deleted, we will reset the info.totalIP and
 	// expect the totalIP is updated from NSX ip pool API
 	// For shared Subnet, user can create IPReservation and SubnetPort from NSX side.
 	// We call NSX ip pool API to for latest total ip and requested ip.
-	if (!existedEntry || info.totalIP == 0 || sharedSubnet) && dhcpMode == model.SubnetDhcpConfig_MODE_DEACTIVATED {
-		// For DHCP Deactivated mode Subnet, get total IPs from IP pool static-ipv4-default
-		// only get Subnet total IPs from static IP Pool if staticIpAllocation enabled
-		if staticIpAllocationEnabled {
-			staticIPPool, err := service.NSXClient.IPPoolClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, "static-ipv4-default")
-			if err != nil {
-				log.Error(err, "Failed to get Subnet static IP Pool static-ipv4-default", "Subnet", *subnet.Path)
-				return false, err
-			}
-			if staticIPPool.PoolUsage != nil && staticIPPool.PoolUsage.TotalIps != nil {
-				info.totalIP = int(*staticIPPool.PoolUsage.TotalIps)
-			}
-			if sharedSubnet && staticIPPool.PoolUsage != nil && staticIPPool.PoolUsage.RequestedIpAllocations != nil {
-				allocatedIPNumber = int(*staticIPPool.PoolUsage.RequestedIpAllocations)
-			}
+	if dhcpMode == model.SubnetDhcpConfig_MODE_SERVER ||
+		((!existedEntry || info.totalIP == 0 || sharedSubnet) && dhcpMode == model.SubnetDhcpConfig_MODE_DEACTIVATED && staticIpAllocationEnabled) {
+		used, total, err := service.GetSubnetIPv4PoolUsage(subnetInfo, subnet)
+		if err != nil {
+			return false, err
+		}
+		info.totalIP = total
+		if sharedSubnet {
+			allocatedIPNumber = used
 		}
 	}
 
-	if !existedEntry && dhcpMode == model.SubnetDhcpConfig_MODE_RELAY {
+	// The info.totalIP is also reset when the Subnet is expanded.
+	if (!existedEntry || info.totalIP == 0) && dhcpMode == model.SubnetDhcpConfig_MODE_RELAY {
 		// For DHCP Relay mode Subnet, assume 4 reserved IPs
 		var totalIP int
 		if subnet.Ipv4SubnetSize != nil {
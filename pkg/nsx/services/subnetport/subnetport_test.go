@@ -1522,6 +1522,29 @@ func TestSubnetPortService_AllocatePortFromSubnet(t *testing.T) {
 	}
 }
 
+func TestSubnetPortService_AllocatePortFromExpandedSubnet(t *testing.T) {
+	subnetPath := "/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-1"
+	dhcpRelaySubnet := &model.VpcSubnet{
+		Ipv4SubnetSize:   common.Int64(4),
+		IpAddresses:      []string{"10.0.0.0/30"},
+		Path:             &subnetPath,
+		SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: common.String(model.SubnetDhcpConfig_MODE_RELAY)},
+	}
+	service := createSubnetPortService(t)
+	canAllocate, err := service.AllocatePortFromSubnet(dhcpRelaySubnet, false, v1alpha1.IPAddressTypeIPv4)
+	require.NoError(t, err)
+	assert.False(t, canAllocate)
+
+	// The total IP count is recalculated from the expanded CIDR after it is reset.
+	expandedSubnet := *dhcpRelaySubnet
+	expandedSubnet.Ipv4SubnetSize = common.Int64(16)
+	expandedSubnet.IpAddresses = []string{"10.0.0.0/28"}
+	service.ResetSubnetTotalIP(subnetPath)
+	canAllocate, err = service.AllocatePortFromSubnet(&expandedSubnet, false, v1alpha1.IPAddressTypeIPv4)
+	require.NoError(t, err)
+	assert.True(t, canAllocate)
+}
+
 func createSubnetPortService(t *testing.T) *SubnetPortService {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
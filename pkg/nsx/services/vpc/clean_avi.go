@@ -98,3 +98,15 @@ func extractIDFromAviPath(path string) string {
 	}
 	return ""
 }
+
+// ListAviSubnetPorts returns the paths of the Avi Subnet ports under the given VPC which CleanAviSubnetPorts would delete.
+func ListAviSubnetPorts(cluster *nsx.Cluster, vpcPath string) ([]string, error) {
+	allPaths, err := httpGetAviPortsPaths(cluster, vpcPath)
+	if err != nil {
+		if errors.Is(err, nsxutil.HttpNotFoundError) || errors.Is(err, nsxutil.HttpBadRequest) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Avi Subnet ports: %w", err)
+	}
+	return sets.List(allPaths), nil
+}
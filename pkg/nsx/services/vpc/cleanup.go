@@ -47,6 +47,45 @@ func (s *VPCService) CleanupVPCChildResources(ctx context.Context, vpcPath strin
 	return nil
 }
 
+// PlanCleanupBeforeVPCDeletion returns the paths of the Avi Subnet ports and SLB virtual servers which
+// CleanupBeforeVPCDeletion would delete.
+func (s *VPCService) PlanCleanupBeforeVPCDeletion(_ context.Context) (map[string][]string, error) {
+	aviPorts := make([]string, 0)
+	for _, vpc := range s.ListVPC() {
+		if !s.CleanupFilter.Match(&vpc) {
+			continue
+		}
+		paths, err := ListAviSubnetPorts(s.NSXClient.Cluster, *vpc.Path)
+		if err != nil {
+			return nil, err
+		}
+		aviPorts = append(aviPorts, paths...)
+	}
+	lbVSs, err := s.getStaleSLBResources(common.ResourceTypeLBVirtualServer, model.LBVirtualServerBindingType())
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]string{
+		common.ResourceTypeSubnetPort:      aviPorts,
+		common.ResourceTypeLBVirtualServer: common.ListNSXResourcePaths(lbVSs),
+	}, nil
+}
+
+// PlanCleanupVPCChildResources returns the paths of the SLB pools which CleanupVPCChildResources would delete with the
+// given vpcPath. Nothing is returned for an auto-created VPC since its LB pools are removed with the VPC.
+func (s *VPCService) PlanCleanupVPCChildResources(_ context.Context, vpcPath string) (map[string][]string, error) {
+	if vpcPath != "" {
+		return nil, nil
+	}
+	lbPools, err := s.getStaleSLBResources(common.ResourceTypeLBPool, model.LBPoolBindingType())
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]string{
+		common.ResourceTypeLBPool: common.ListNSXResourcePaths(lbPools),
+	}, nil
+}
+
 func (s *VPCService) cleanupSLBVirtualServers(ctx context.Context) error {
 	lbVSs, err := s.getStaleSLBVirtualServers()
 	if err != nil {
@@ -108,7 +147,7 @@ func (s *VPCService) getStaleSLBResources(resourceType string, resourceBindingTy
 	}
 
 	var slbObjects []interface{}
-	for _, obj := range store.List() {
+	for _, obj := range s.CleanupFilter.Filter(store.List()) {
 		if !isSLBResourceValidToDelete(obj) {
 			continue
 		}
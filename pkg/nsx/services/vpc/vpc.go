@@ -181,6 +181,8 @@ func InitializeVPC(service common.Service) (*VPCService, error) {
 	// initialize vpc store, lbs store
 	go VPCService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeVpc, nil, VPCService.VpcStore)
 	go VPCService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeLBService, nil, VPCService.LbsStore)
+	common.RegisterStoreMetrics(common.ResourceTypeVpc, &VPCService.VpcStore.ResourceStore)
+	common.RegisterStoreMetrics(common.ResourceTypeLBService, &VPCService.LbsStore.ResourceStore)
 
 	go func() {
 		wg.Wait()
@@ -1226,6 +1228,9 @@ func (s *VPCService) GetNamespacesWithPreCreatedVPCs() (map[string]string, error
 func (s *VPCService) cleanupAviSubnetPorts(ctx context.Context) error {
 	vpcs := s.ListVPC()
 	for _, vpc := range vpcs {
+		if !s.CleanupFilter.Match(&vpc) {
+			continue
+		}
 		if err := CleanAviSubnetPorts(ctx, s.NSXClient.Cluster, *vpc.Path); err != nil {
 			return err
 		}
@@ -11,6 +11,9 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/retry"
 )
@@ -31,6 +34,12 @@ type Transport struct {
 func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var resul error
+	priority := ratelimiter.PriorityFromRequest(r)
+	if r.Header.Get(ratelimiter.PriorityHeader) != "" {
+		// The header is only for the rate limiter, it is stripped on a copy to keep the request of the caller intact.
+		r = r.Clone(r.Context())
+		r.Header.Del(ratelimiter.PriorityHeader)
+	}
 
 	retry.Do(
 		func() error {
@@ -46,14 +55,16 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 			ep.UpdateHttpRequestAuth(r)
 			ep.UpdateCAforEnvoy(r)
 			start := time.Now()
-			ep.wait()
+			ep.wait(priority)
 			util.DumpHttpRequest(r)
 			waitTime := time.Since(start)
 			if resp, resul = t.base().RoundTrip(r); resul != nil {
+				ep.observeRequest(r.Method, r.URL.Path, 0, 0)
 				ep.setStatus(DOWN)
 				return handleRoundTripError(resul, ep)
 			}
 			transTime := time.Since(start) - waitTime
+			ep.observeRequest(r.Method, r.URL.Path, resp.StatusCode, transTime)
 			ep.adjustRate(waitTime, resp.StatusCode)
 			if resp == nil {
 				return nil
@@ -107,6 +118,46 @@ func handleRoundTripError(err error, ep *Endpoint) error {
 	}
 }
 
+// apiPathSegments is the route table of the static segments in the NSX API paths called by NSX Operator. Any other
+// segment is an ID or a value chosen by the user.
+var apiPathSegments = sets.New[string](
+	"api", "policy", "v1", "node", "version", "reverse-proxy", "health", "search", "query", "aggregate", "cluster",
+	"status", "state", "stats", "realized-state", "realized-entities", "realized-entity", "enforcement-points",
+	"orgs", "projects", "infra", "domains", "sites", "shares", "resources", "zones", "transit-gateways",
+	"vpcs", "vpc-connectivity-profiles", "vpc-service-profiles", "vpc-attachments", "attachments", "gateway-connections",
+	"distributed-vlan-connections", "distributed-vxlan-connections", "ip-blocks", "subnets", "ports", "ip-pools",
+	"ip-allocations", "ip-subnets", "dhcp-server-config", "dhcp-static-binding-configs", "dynamic-ip-reservations",
+	"static-ip-reservations", "address-bindings", "ip-address-allocations", "subnet-connection-binding-maps",
+	"static-routes", "groups", "members", "ip-addresses", "ip-address-expressions", "security-policies", "rules",
+	"gateway-policies", "context-profiles", "services", "service-entries", "dns-services", "dns-records", "dns-zones",
+	"vpc-lbs", "lbs", "lb-services", "lb-virtual-servers", "lb-pools", "lb-app-profiles", "nat", "nat-rules",
+	"certificates", "trust-management", "principal-identities", "with-certificate", "transport-nodes",
+	"host-transport-nodes", "fabric", "compute-managers", "virtual-machines", "principal-identity", "tags", "batch",
+)
+
+// apiPathTemplate replaces the IDs in the NSX API path with "{id}" to limit the cardinality of the metrics. A segment
+// is kept only if it is in the route table of the static segments, e.g.
+// /policy/api/v1/orgs/default/projects/p1/vpcs/vpc1/subnets/s1/ip-pools/static-ipv4-default is templated to
+// /policy/api/v1/orgs/{id}/projects/{id}/vpcs/{id}/subnets/{id}/ip-pools/{id}. The query string and the prefix of the
+// envoy sidecar path are removed.
+func apiPathTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	if i := strings.Index(path, "/policy/api/"); i >= 0 {
+		path = path[i:]
+	} else if i := strings.Index(path, "/api/"); i >= 0 {
+		path = path[i:]
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !apiPathSegments.Has(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
 func (t *Transport) base() http.RoundTripper {
 	if t.Base != nil {
 		return t.Base
@@ -69,6 +69,32 @@ func TestRoundTripRetry(t *testing.T) {
 	assert.Equal(err, nil)
 }
 
+func TestRoundTripPriorityHeader(t *testing.T) {
+	var forwarded []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "reverse-proxy/node/health") && !strings.Contains(r.URL.Path, "api/session/create") {
+			forwarded = append(forwarded, r.Header.Get(ratelimiter.PriorityHeader))
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"healthy" : true}`))
+	}))
+	defer ts.Close()
+	index := strings.Index(ts.URL, "//")
+	config := NewConfig(ts.URL[index+2:], "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, err := NewCluster(config)
+	assert.NoError(t, err)
+	cluster.endpoints[0], _ = NewEndpoint(ts.URL, cluster.client, cluster.noBalancerClient, cluster.endpoints[0].ratelimiter, nil)
+	cluster.endpoints[0].keepAlive()
+
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	assert.NoError(t, SetInteractivePriorityHeader(req))
+	_, err = cluster.transport.RoundTrip(req)
+	assert.NoError(t, err)
+	// The header is not sent to NSX, and the request of the caller is not modified.
+	assert.Equal(t, []string{""}, forwarded)
+	assert.Equal(t, ratelimiter.PriorityInteractiveValue, req.Header.Get(ratelimiter.PriorityHeader))
+}
+
 func TestSelectEndpoint(t *testing.T) {
 	assert := assert.New(t)
 	a := "127.0.0.1, 127.0.0.2, 127.0.0.3"
@@ -77,8 +103,7 @@ func TestSelectEndpoint(t *testing.T) {
 	tr := cluster.createTransport(idleConnTimeout)
 	client := cluster.createHTTPClient(tr, timeout)
 	noBClient := cluster.createNoBalancerClient(timeout, idleConnTimeout)
-	r := ratelimiter.NewRateLimiter(config.APIRateMode)
-	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, r, nil)
+	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, createRateLimiters(config), nil)
 	// all eps DOWN
 	_, err := tr.selectEndpoint()
 	assert.NotNil(t, err, fmt.Sprintf("Select endpoint error %s", err))
@@ -153,8 +178,7 @@ func Test_handleRoundTripError(t *testing.T) {
 	tr := cluster.createTransport(idleConnTimeout)
 	client := cluster.createHTTPClient(tr, timeout)
 	noBClient := cluster.createNoBalancerClient(timeout, idleConnTimeout)
-	r := ratelimiter.NewRateLimiter(config.APIRateMode)
-	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, r, nil)
+	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, createRateLimiters(config), nil)
 	cluster.endpoints = eps
 	err := errors.New("connection refused")
 	assert.NotNil(t, handleRoundTripError(err, eps[0]))
@@ -223,3 +247,22 @@ func TestTransport_selectEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_apiPathTemplate(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/policy/api/v1/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ports/port-1", "/policy/api/v1/orgs/{id}/projects/{id}/vpcs/{id}/subnets/{id}/ports/{id}"},
+		{"/policy/api/v1/infra/domains/default/security-policies/sp1/rules", "/policy/api/v1/infra/domains/{id}/security-policies/{id}/rules"},
+		{"/policy/api/v1/search/query", "/policy/api/v1/search/query"},
+		{"/api/v1/reverse-proxy/node/health", "/api/v1/reverse-proxy/node/health"},
+		{"/external-cert/http1/10.0.0.1/443/policy/api/v1/orgs/default/projects", "/policy/api/v1/orgs/{id}/projects"},
+		{"/policy/api/v1/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/ip-pools/static-ipv4-default", "/policy/api/v1/orgs/{id}/projects/{id}/vpcs/{id}/subnets/{id}/ip-pools/{id}"},
+		{"/policy/api/v1/orgs/default/projects/p1/vpcs/vpc-1/subnets/s1/dhcp-server-config/stats?enforcement_point_path=ep", "/policy/api/v1/orgs/{id}/projects/{id}/vpcs/{id}/subnets/{id}/dhcp-server-config/stats"},
+		{"/policy/api/v1/infra/unknown-resource/new-id", "/policy/api/v1/infra/{id}/{id}"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, apiPathTemplate(tt.path))
+	}
+}
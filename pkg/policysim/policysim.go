@@ -0,0 +1,161 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package policysim simulates traffic between two endpoints against the NSX rules which the operator has
+// programmed for SecurityPolicies, NetworkPolicies and AdminNetworkPolicies.
+package policysim
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	controllercommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+const (
+	endpointKindPod = "pod"
+	endpointKindVM  = "vm"
+	endpointKindIP  = "ip"
+)
+
+// Request is the traffic to simulate. Source and Destination are endpoints in the format of "pod:<namespace>/<name>",
+// "vm:<namespace>/<name>", "ip:<address>" or a bare IP address.
+type Request struct {
+	Source      string
+	Destination string
+	Protocol    string
+	Port        int
+}
+
+// Result is the verdict of the simulated traffic with the IPs the endpoints are resolved to.
+type Result struct {
+	SourceIP      string `json:"sourceIP"`
+	DestinationIP string `json:"destinationIP"`
+	securitypolicy.SimulationResult
+}
+
+// Run resolves the endpoints of the request to IPs, loads the NSX SecurityPolicies, Rules and Groups created by the
+// cluster and evaluates the rules against the traffic.
+func Run(ctx context.Context, nsxClient *nsx.Client, client k8sclient.Client, req Request) (*Result, error) {
+	srcIPs, err := ResolveEndpoint(ctx, client, req.Source)
+	if err != nil {
+		return nil, err
+	}
+	dstIPs, err := ResolveEndpoint(ctx, client, req.Destination)
+	if err != nil {
+		return nil, err
+	}
+	srcIP, dstIP, err := pickIPPair(srcIPs, dstIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	service := common.Service{NSXClient: nsxClient, NSXConfig: nsxClient.NsxConfig}
+	resources, err := securitypolicy.SearchClusterResources(service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search NSX SecurityPolicies: %w", err)
+	}
+	memberIPs := func(groupPath string) ([]string, error) {
+		return securitypolicy.GetGroupMemberIPs(service, groupPath)
+	}
+	simulation, err := securitypolicy.Simulate(resources, memberIPs, securitypolicy.SimulationRequest{
+		SourceIP:      srcIP,
+		DestinationIP: dstIP,
+		Protocol:      req.Protocol,
+		Port:          req.Port,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Result{SourceIP: srcIP, DestinationIP: dstIP, SimulationResult: *simulation}, nil
+}
+
+// ResolveEndpoint returns the IPs of the endpoint. A Pod is resolved from its status, and a VM from the status of
+// the SubnetPorts attached to it.
+func ResolveEndpoint(ctx context.Context, client k8sclient.Client, endpoint string) ([]string, error) {
+	kind, ref, found := strings.Cut(endpoint, ":")
+	if !found || net.ParseIP(endpoint) != nil {
+		kind, ref = endpointKindIP, endpoint
+	}
+	switch kind {
+	case endpointKindIP:
+		if net.ParseIP(ref) == nil {
+			return nil, fmt.Errorf("invalid IP address %q", ref)
+		}
+		return []string{ref}, nil
+	case endpointKindPod, endpointKindVM:
+		namespace, name, found := strings.Cut(ref, "/")
+		if !found || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid %s endpoint %q, expected %s:<namespace>/<name>", kind, endpoint, kind)
+		}
+		if kind == endpointKindPod {
+			return resolvePod(ctx, client, namespace, name)
+		}
+		return resolveVM(ctx, client, namespace, name)
+	default:
+		return nil, fmt.Errorf("unsupported endpoint kind %q in %q", kind, endpoint)
+	}
+}
+
+func resolvePod(ctx context.Context, client k8sclient.Client, namespace, name string) ([]string, error) {
+	pod := &corev1.Pod{}
+	if err := client.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: name}, pod); err != nil {
+		return nil, fmt.Errorf("failed to get Pod %s/%s: %w", namespace, name, err)
+	}
+	var ips []string
+	for _, podIP := range pod.Status.PodIPs {
+		ips = append(ips, podIP.IP)
+	}
+	if len(ips) == 0 && pod.Status.PodIP != "" {
+		ips = append(ips, pod.Status.PodIP)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("Pod %s/%s has no IP", namespace, name)
+	}
+	return ips, nil
+}
+
+func resolveVM(ctx context.Context, client k8sclient.Client, namespace, name string) ([]string, error) {
+	subnetPorts := &v1alpha1.SubnetPortList{}
+	if err := client.List(ctx, subnetPorts, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list SubnetPorts in Namespace %s: %w", namespace, err)
+	}
+	var ips []string
+	for i := range subnetPorts.Items {
+		vmName, _, err := controllercommon.GetVirtualMachineNameForSubnetPort(&subnetPorts.Items[i])
+		if err != nil || vmName != name {
+			continue
+		}
+		for _, address := range subnetPorts.Items[i].Status.NetworkInterfaceConfig.IPAddresses {
+			ip, _, _ := strings.Cut(address.IPAddress, "/")
+			if net.ParseIP(ip) != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("VM %s/%s has no IP on its SubnetPorts", namespace, name)
+	}
+	return ips, nil
+}
+
+// pickIPPair returns the first source and destination IPs of the same IP family.
+func pickIPPair(srcIPs, dstIPs []string) (string, string, error) {
+	for _, src := range srcIPs {
+		for _, dst := range dstIPs {
+			if (net.ParseIP(src).To4() == nil) == (net.ParseIP(dst).To4() == nil) {
+				return src, dst, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("no source IP in %v and destination IP in %v of the same IP family", srcIPs, dstIPs)
+}
@@ -0,0 +1,146 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package policysim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+func newFakeClient(objs ...k8sclient.Object) k8sclient.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-a", Namespace: "ns1"},
+		Status: corev1.PodStatus{
+			PodIP:  "10.0.0.1",
+			PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+		},
+	}
+	pendingPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-pending", Namespace: "ns1"}}
+	subnetPort := &v1alpha1.SubnetPort{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "vm-a-nic0",
+			Namespace:   "ns1",
+			Annotations: map[string]string{common.AnnotationAttachmentRef: "virtualmachine/vm-a/nic0"},
+		},
+		Status: v1alpha1.SubnetPortStatus{
+			NetworkInterfaceConfig: v1alpha1.NetworkInterfaceConfig{
+				IPAddresses: []v1alpha1.NetworkInterfaceIPAddress{{IPAddress: "10.0.1.5/24"}},
+			},
+		},
+	}
+	client := newFakeClient(pod, pendingPod, subnetPort)
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		expectedIPs []string
+		expectedErr string
+	}{
+		{name: "bare IP", endpoint: "10.0.0.9", expectedIPs: []string{"10.0.0.9"}},
+		{name: "bare IPv6", endpoint: "fd00::9", expectedIPs: []string{"fd00::9"}},
+		{name: "IP", endpoint: "ip:10.0.0.9", expectedIPs: []string{"10.0.0.9"}},
+		{name: "invalid IP", endpoint: "ip:pod-a", expectedErr: "invalid IP address"},
+		{name: "Pod", endpoint: "pod:ns1/pod-a", expectedIPs: []string{"10.0.0.1", "fd00::1"}},
+		{name: "Pod without IP", endpoint: "pod:ns1/pod-pending", expectedErr: "has no IP"},
+		{name: "Pod not found", endpoint: "pod:ns1/pod-b", expectedErr: "failed to get Pod ns1/pod-b"},
+		{name: "VM", endpoint: "vm:ns1/vm-a", expectedIPs: []string{"10.0.1.5"}},
+		{name: "VM without SubnetPort", endpoint: "vm:ns1/vm-b", expectedErr: "has no IP on its SubnetPorts"},
+		{name: "missing namespace", endpoint: "pod:pod-a", expectedErr: "expected pod:<namespace>/<name>"},
+		{name: "unsupported kind", endpoint: "svc:ns1/svc-a", expectedErr: "unsupported endpoint kind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ips, err := ResolveEndpoint(context.TODO(), client, tt.endpoint)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIPs, ips)
+		})
+	}
+}
+
+func TestPickIPPair(t *testing.T) {
+	src, dst, err := pickIPPair([]string{"fd00::1", "10.0.0.1"}, []string{"10.0.0.2"})
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", src)
+	assert.Equal(t, "10.0.0.2", dst)
+
+	_, _, err = pickIPPair([]string{"fd00::1"}, []string{"10.0.0.2"})
+	assert.ErrorContains(t, err, "same IP family")
+}
+
+func TestRun(t *testing.T) {
+	nsxClient := &nsx.Client{NsxConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}}}
+	spPath := "/orgs/default/projects/p1/vpcs/vpc1/security-policies/sp1"
+	dstGroup := "/orgs/default/projects/p1/vpcs/vpc1/groups/dst"
+	resources := &securitypolicy.EffectiveResources{
+		SecurityPolicies: []*model.SecurityPolicy{{Id: common.String("sp1"), Path: common.String(spPath)}},
+		Rules: []*model.Rule{{
+			Id:             common.String("drop"),
+			Path:           common.String(spPath + "/rules/drop"),
+			Direction:      common.String(model.Rule_DIRECTION_IN),
+			Action:         common.String(model.Rule_ACTION_DROP),
+			Scope:          []string{dstGroup},
+			SequenceNumber: common.Int64(1),
+		}},
+	}
+
+	t.Run("traffic dropped", func(t *testing.T) {
+		patches := gomonkey.ApplyFuncReturn(securitypolicy.SearchClusterResources, resources, nil)
+		defer patches.Reset()
+		patches.ApplyFuncReturn(securitypolicy.GetGroupMemberIPs, []string{"10.0.0.2"}, nil)
+
+		result, err := Run(context.TODO(), nsxClient, newFakeClient(), Request{
+			Source: "10.0.0.1", Destination: "ip:10.0.0.2", Protocol: "TCP", Port: 80,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1", result.SourceIP)
+		assert.Equal(t, "10.0.0.2", result.DestinationIP)
+		assert.Equal(t, model.Rule_ACTION_DROP, result.Verdict)
+		assert.Nil(t, result.Egress)
+		require.NotNil(t, result.Ingress)
+		assert.Equal(t, spPath+"/rules/drop", result.Ingress.RulePath)
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		patches := gomonkey.ApplyFunc(securitypolicy.SearchClusterResources, func(common.Service) (*securitypolicy.EffectiveResources, error) {
+			return nil, errors.New("search error")
+		})
+		defer patches.Reset()
+
+		_, err := Run(context.TODO(), nsxClient, newFakeClient(), Request{Source: "10.0.0.1", Destination: "10.0.0.2"})
+		assert.ErrorContains(t, err, "search error")
+	})
+
+	t.Run("endpoint not found", func(t *testing.T) {
+		_, err := Run(context.TODO(), nsxClient, newFakeClient(), Request{Source: "pod:ns1/pod-a", Destination: "10.0.0.2"})
+		assert.ErrorContains(t, err, "failed to get Pod ns1/pod-a")
+	})
+}
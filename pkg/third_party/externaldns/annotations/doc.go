@@ -1,6 +1,6 @@
 // Package annotations provides a small subset of ExternalDNS source annotation helpers.
-// Upstream: sigs.k8s.io/external-dns/source/annotations (notably processors.go for hostname splitting/list parsing;
-// upstream also defines fixed annotation key constants used by the full Gateway/Ingress sources).
+// Upstream: sigs.k8s.io/external-dns/source/annotations (notably processors.go for hostname splitting/list parsing
+// and TTL parsing; upstream also defines fixed annotation key constants used by the full Gateway/Ingress sources).
 //
 // # Direct copy from external-dns (same logic; same exported names where applicable)
 //
@@ -12,6 +12,8 @@
 //
 //	HostnamesFromAnnotations — returns nil if hostnameKey is "" or input is nil (defensive); upstream resolves
 //	from a fixed key and may not short-circuit the same way.
+//	TTLFromAnnotations(input, ttlKey) — same seconds / Go duration parsing and [1, MaxInt32] range as upstream;
+//	returns the parse error to the caller instead of logging it, the TTL is unconfigured (0) on error.
 //
 // # nsx-operator / subset
 //
@@ -0,0 +1,58 @@
+// Copyright 2025 The Kubernetes Authors.
+// Copyright 2026 Broadcom, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Derived from sigs.k8s.io/external-dns/source/annotations/processors.go (TTLFromAnnotations, parseTTL).
+// Attribution: see package doc.go.
+
+package annotations
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	ttlMinimum = 1
+	ttlMaximum = math.MaxInt32
+)
+
+// TTLFromAnnotations returns the TTL from the annotation identified by ttlKey, the value is either a number of
+// seconds or a Go duration such as "5m". An unconfigured TTL (0) is returned if the annotation is not set or invalid,
+// with an error for the invalid value.
+func TTLFromAnnotations(input map[string]string, ttlKey string) (endpoint.TTL, error) {
+	ttlNotConfigured := endpoint.TTL(0)
+	if ttlKey == "" || input == nil {
+		return ttlNotConfigured, nil
+	}
+	ttlAnnotation, ok := input[ttlKey]
+	if !ok {
+		return ttlNotConfigured, nil
+	}
+	ttlValue, err := parseTTL(ttlAnnotation)
+	if err != nil {
+		return ttlNotConfigured, fmt.Errorf("%q is not a valid TTL value: %w", ttlAnnotation, err)
+	}
+	if ttlValue < ttlMinimum || ttlValue > ttlMaximum {
+		return ttlNotConfigured, fmt.Errorf("TTL value %d must be between [%d, %d]", ttlValue, ttlMinimum, ttlMaximum)
+	}
+	return endpoint.TTL(ttlValue), nil
+}
+
+// parseTTL parses a TTL value with the unit of second or a duration, the duration is rounded down to seconds.
+func parseTTL(s string) (int64, error) {
+	ttlDuration, errDuration := time.ParseDuration(s)
+	if errDuration != nil {
+		ttlInt, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, errDuration
+		}
+		return ttlInt, nil
+	}
+	return int64(ttlDuration.Seconds()), nil
+}
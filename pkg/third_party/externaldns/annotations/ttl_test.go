@@ -0,0 +1,37 @@
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func TestTTLFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]string
+		want    endpoint.TTL
+		wantErr bool
+	}{
+		{name: "not set", input: map[string]string{}, want: 0},
+		{name: "nil annotations", input: nil, want: 0},
+		{name: "seconds", input: map[string]string{"ttl": "60"}, want: 60},
+		{name: "duration", input: map[string]string{"ttl": "5m"}, want: 300},
+		{name: "invalid", input: map[string]string{"ttl": "abc"}, want: 0, wantErr: true},
+		{name: "zero", input: map[string]string{"ttl": "0"}, want: 0, wantErr: true},
+		{name: "too large", input: map[string]string{"ttl": "2147483648"}, want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TTLFromAnnotations(tt.input, "ttl")
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+
+	got, err := TTLFromAnnotations(map[string]string{"ttl": "60"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, endpoint.TTL(0), got)
+}
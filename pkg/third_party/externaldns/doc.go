@@ -8,12 +8,12 @@
 //   - source/gateway.go — Gateway/HTTPRoute/GRPCRoute/TLSRoute/ListenerSet DNS source: host merging
 //     (hosts / gateway-hostname-source), gwMatchingHost / gwHost, matchRouteToListener-style admission.
 //   - source/gateway_hostname.go — ASCII lower-case helper for gateway hostnames.
-//   - source/annotations/processors.go — SplitHostnameAnnotation and hostname list extraction.
+//   - source/annotations/processors.go — SplitHostnameAnnotation, hostname list extraction and TTLFromAnnotations.
 //   - endpoint/* — Endpoint model, Targets, EndpointsForHostname, SuitableType.
 //
 // Subpackages in this repo:
 //
-//   - [github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations]: hostname parsing
+//   - [github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations]: hostname and TTL parsing
 //     aligned with external-dns/source/annotations; **annotation keys are caller-supplied strings**
 //     (nsx-operator uses pkg/nsx/services/common constants at the gateway controller), not fixed upstream key constants.
 //   - [github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint]: subset of external-dns/endpoint
@@ -18,11 +18,18 @@
 //	(Endpoint).RetainProviderProperties
 //	supportsAlias, isAlias — unexported; same as upstream.
 //	EndpointsForHostname
+//	GwMatchingHost — upstream gwMatchingHost in source/gateway.go, exported.
 //
 // # Modified from external-dns
 //
 //	SuitableType — same A/AAAA/CNAME rules; implementation uses net/netip.ParseAddr instead of upstream net.ParseIP.
 //	NewTargets — dedupe + sort uses slices.Sort (stdlib) instead of sort.Strings; semantics unchanged (sorted unique targets).
 //	NewEndpointWithTTL — omits upstream logging when DNS label length > 63 (still returns nil).
+//	GatewayCanonicalHost — upstream gwHost in source/gateway.go, exported; also trims spaces and lower-cases with
+//	toLowerCaseASCII from source/gateway_hostname.go.
 //	endpoint.go — many registry/TXT/serialization helpers from upstream endpoint package are omitted (trimmed subset).
+//
+// # nsx-operator only
+//
+//	ClaimGwMatchingDNSName — first-wins dedupe of overlapping hosts when building the Gateway direct DNS batch.
 package endpoint
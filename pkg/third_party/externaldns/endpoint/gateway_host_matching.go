@@ -0,0 +1,81 @@
+// Copyright 2021 The Kubernetes Authors.
+// Copyright 2026 Broadcom, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Derived from sigs.k8s.io/external-dns/source/gateway.go (gwHost, gwMatchingHost) and
+// sigs.k8s.io/external-dns/source/gateway_hostname.go (toLowerCaseASCII).
+// Attribution: see package doc.go.
+
+package endpoint
+
+import (
+	"strings"
+)
+
+// GatewayCanonicalHost returns the canonical form of a Gateway API hostname: lower-cased, without the trailing dot,
+// and "*" (any host) is returned as "".
+func GatewayCanonicalHost(host string) string {
+	host = strings.TrimSuffix(toLowerCaseASCII(strings.TrimSpace(host)), ".")
+	if host == "*" {
+		return ""
+	}
+	return host
+}
+
+// GwMatchingHost returns the most specific overlapping host of a and b, and false if they don't overlap. An empty
+// host matches any host, and a wildcard host "*.example.com" matches the hosts under example.com.
+func GwMatchingHost(a, b string) (string, bool) {
+	a, b = GatewayCanonicalHost(a), GatewayCanonicalHost(b)
+	if a == "" {
+		return b, true
+	}
+	if b == "" || a == b {
+		return a, true
+	}
+	if na, nb := len(a), len(b); nb < na || (na == nb && strings.HasPrefix(b, "*.")) {
+		a, b = b, a
+	}
+	if strings.HasPrefix(a, "*.") && strings.HasSuffix(b, a[1:]) {
+		return b, true
+	}
+	return "", false
+}
+
+// ClaimGwMatchingDNSName records host in claimed and returns true if no host claimed before matches it, so that the
+// first of the overlapping hosts wins when building the endpoints of a Gateway in order. It is nsx-operator only.
+func ClaimGwMatchingDNSName(claimed map[string]struct{}, host string) bool {
+	host = GatewayCanonicalHost(host)
+	if host == "" {
+		return false
+	}
+	for c := range claimed {
+		if _, ok := GwMatchingHost(c, host); ok {
+			return false
+		}
+	}
+	claimed[host] = struct{}{}
+	return true
+}
+
+// toLowerCaseASCII lower-cases the ASCII letters only, the hostnames of Gateway API are validated to be ASCII or
+// IDNA encoded.
+func toLowerCaseASCII(in string) string {
+	isAlreadyLowerCase := true
+	for _, c := range in {
+		if 'A' <= c && c <= 'Z' {
+			isAlreadyLowerCase = false
+			break
+		}
+	}
+	if isAlreadyLowerCase {
+		return in
+	}
+	out := []byte(in)
+	for i, b := range out {
+		if 'A' <= b && b <= 'Z' {
+			out[i] += 'a' - 'A'
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Broadcom, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayCanonicalHost(t *testing.T) {
+	assert.Equal(t, "", GatewayCanonicalHost("*"))
+	assert.Equal(t, "", GatewayCanonicalHost(" "))
+	assert.Equal(t, "foo.example.com", GatewayCanonicalHost("Foo.Example.COM."))
+	assert.Equal(t, "*.example.com", GatewayCanonicalHost("*.example.com"))
+}
+
+func TestGwMatchingHost(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		want  string
+		match bool
+	}{
+		{a: "", b: "foo.example.com", want: "foo.example.com", match: true},
+		{a: "*", b: "foo.example.com", want: "foo.example.com", match: true},
+		{a: "foo.example.com", b: "", want: "foo.example.com", match: true},
+		{a: "foo.example.com", b: "FOO.example.com.", want: "foo.example.com", match: true},
+		{a: "foo.example.com", b: "bar.example.com", match: false},
+		{a: "*.example.com", b: "foo.example.com", want: "foo.example.com", match: true},
+		{a: "foo.example.com", b: "*.example.com", want: "foo.example.com", match: true},
+		{a: "*.example.com", b: "*.foo.example.com", want: "*.foo.example.com", match: true},
+		{a: "*.example.com", b: "example.com", match: false},
+		{a: "*.example.com", b: "foo.example.org", match: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"|"+tt.b, func(t *testing.T) {
+			got, ok := GwMatchingHost(tt.a, tt.b)
+			assert.Equal(t, tt.match, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClaimGwMatchingDNSName(t *testing.T) {
+	claimed := map[string]struct{}{}
+	assert.True(t, ClaimGwMatchingDNSName(claimed, "foo.example.com"))
+	assert.False(t, ClaimGwMatchingDNSName(claimed, "Foo.example.com."))
+	assert.True(t, ClaimGwMatchingDNSName(claimed, "bar.example.com"))
+	assert.False(t, ClaimGwMatchingDNSName(claimed, "*"))
+	assert.Len(t, claimed, 2)
+}
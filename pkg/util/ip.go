@@ -67,6 +67,19 @@ func ContainsIPv4CIDR(cidrs []string) bool {
 	return false
 }
 
+// IPv4CIDRSize returns the number of the addresses in the first IPv4 CIDR in the slice, or 0 if there is no IPv4 CIDR.
+func IPv4CIDRSize(cidrs []string) int {
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil || ipNet.IP.To4() == nil {
+			continue
+		}
+		ones, length := ipNet.Mask.Size()
+		return 1 << (length - ones)
+	}
+	return 0
+}
+
 // ContainsIPv6CIDR returns true if any CIDR in the slice is an IPv6 CIDR.
 func ContainsIPv6CIDR(cidrs []string) bool {
 	for _, c := range cidrs {
@@ -253,6 +266,58 @@ func GetCIDRRangesWithExcept(cidr string, excepts []string) ([]string, error) {
 	return resultRanges, nil
 }
 
+// ParseIPRange parses an IP address, a dash-separated IP range or a CIDR, which are the formats of the Subnet static
+// IP allocation pool ranges and the SubnetIPReservation IPs, and returns the first and the last IP of it.
+func ParseIPRange(value string) (net.IP, net.IP, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		return parseCIDRRange(value)
+	}
+	startStr, endStr, isRange := strings.Cut(value, "-")
+	startIP := net.ParseIP(strings.TrimSpace(startStr))
+	endIP := startIP
+	if isRange {
+		endIP = net.ParseIP(strings.TrimSpace(endStr))
+	}
+	if startIP == nil || endIP == nil || (startIP.To4() == nil) != (endIP.To4() == nil) || compareIP(endIP, startIP) {
+		return nil, nil, fmt.Errorf("invalid IP range %q", value)
+	}
+	return normalizeIP(startIP), normalizeIP(endIP), nil
+}
+
+// IPInRanges checks if the IP is in any of the IP addresses, IP ranges or CIDRs.
+func IPInRanges(ip net.IP, ranges []string) (bool, error) {
+	ip = normalizeIP(ip)
+	for _, r := range ranges {
+		startIP, endIP, err := ParseIPRange(r)
+		if err != nil {
+			return false, err
+		}
+		if len(ip) == len(startIP) && !compareIP(ip, startIP) && !compareIP(endIP, ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NthIPInRanges returns the n-th (0-based) IP of the IP addresses, IP ranges or CIDRs in their order.
+func NthIPInRanges(ranges []string, n int) (net.IP, error) {
+	offset := big.NewInt(int64(n))
+	for _, r := range ranges {
+		startIP, endIP, err := ParseIPRange(r)
+		if err != nil {
+			return nil, err
+		}
+		size := new(big.Int).Sub(ipToBigInt(endIP), ipToBigInt(startIP))
+		size.Add(size, big.NewInt(1))
+		if offset.Cmp(size) < 0 {
+			return bigIntToIP(offset.Add(offset, ipToBigInt(startIP)), len(startIP)), nil
+		}
+		offset.Sub(offset, size)
+	}
+	return nil, fmt.Errorf("no IP at index %d in %v", n, ranges)
+}
+
 // IPAddressTypeIncludesIPv6 reports whether the given IPAddressType allocates IPv6 addresses
 // (i.e. IPv6-only or dual-stack).
 func IPAddressTypeIncludesIPv6(ipType v1alpha1.IPAddressType) bool {
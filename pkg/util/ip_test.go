@@ -520,3 +520,67 @@ func TestIPAddressTypeIncludesIPv6(t *testing.T) {
 	assert.True(t, IPAddressTypeIncludesIPv6(v1alpha1.IPAddressTypeIPv4IPv6)) // dual-stack
 	assert.True(t, IPAddressTypeIncludesIPv6(v1alpha1.IPAddressTypeIPv6))     // IPv6-only
 }
+
+func TestParseIPRange(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantStart string
+		wantEnd   string
+		wantErr   bool
+	}{
+		{value: "192.168.1.5", wantStart: "192.168.1.5", wantEnd: "192.168.1.5"},
+		{value: " 192.168.1.10-192.168.1.20 ", wantStart: "192.168.1.10", wantEnd: "192.168.1.20"},
+		{value: "192.168.2.0/28", wantStart: "192.168.2.0", wantEnd: "192.168.2.15"},
+		{value: "2001:db8::1-2001:db8::ff", wantStart: "2001:db8::1", wantEnd: "2001:db8::ff"},
+		{value: "192.168.1.20-192.168.1.10", wantErr: true},
+		{value: "192.168.1.1-2001:db8::1", wantErr: true},
+		{value: "invalid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			startIP, endIP, err := ParseIPRange(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStart, startIP.String())
+			assert.Equal(t, tt.wantEnd, endIP.String())
+		})
+	}
+}
+
+func TestIPInRanges(t *testing.T) {
+	ranges := []string{"192.168.1.5", "192.168.1.10-192.168.1.20", "2001:db8::/120"}
+	for ip, want := range map[string]bool{
+		"192.168.1.5":   true,
+		"192.168.1.6":   false,
+		"192.168.1.20":  true,
+		"192.168.1.21":  false,
+		"2001:db8::ff":  true,
+		"2001:db8::100": false,
+	} {
+		got, err := IPInRanges(net.ParseIP(ip), ranges)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, ip)
+	}
+	_, err := IPInRanges(net.ParseIP("192.168.1.5"), []string{"invalid"})
+	assert.Error(t, err)
+}
+
+func TestNthIPInRanges(t *testing.T) {
+	ranges := []string{"192.168.1.5", "192.168.1.10-192.168.1.11", "192.168.2.0/30"}
+	for n, want := range map[int]string{0: "192.168.1.5", 1: "192.168.1.10", 2: "192.168.1.11", 3: "192.168.2.0", 6: "192.168.2.3"} {
+		ip, err := NthIPInRanges(ranges, n)
+		assert.NoError(t, err)
+		assert.Equal(t, want, ip.String())
+	}
+	_, err := NthIPInRanges(ranges, 7)
+	assert.ErrorContains(t, err, "no IP at index 7")
+}
+
+func TestIPv4CIDRSize(t *testing.T) {
+	assert.Equal(t, 32, IPv4CIDRSize([]string{"2001:db8::/64", "10.0.0.0/27"}))
+	assert.Equal(t, 0, IPv4CIDRSize([]string{"2001:db8::/64", "invalid"}))
+	assert.Equal(t, 0, IPv4CIDRSize(nil))
+}